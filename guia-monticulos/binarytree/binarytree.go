@@ -0,0 +1,345 @@
+// Package binarytree provee un árbol binario general, sin la restricción de
+// orden de un árbol binario de búsqueda, junto con su reconstrucción a partir
+// de recorridos y su serialización.
+package binarytree
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// nullMarker representa un subárbol vacío en la serialización.
+const nullMarker = "#"
+
+// escaper protege en la serialización los caracteres con significado
+// especial: el separador, el marcador de vacío y la propia barra invertida.
+var escaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, nullMarker, `\`+nullMarker)
+
+// BinaryNode es un nodo de un árbol binario.
+type BinaryNode[T comparable] struct {
+	data  T
+	left  *BinaryNode[T]
+	right *BinaryNode[T]
+}
+
+// NewBinaryNode crea un nodo con el dato y los hijos indicados.
+//
+// Uso:
+//
+//	n := binarytree.NewBinaryNode(1, binarytree.NewBinaryNode(2, nil, nil), nil)
+//
+// Parámetros:
+//   - `data` dato del nodo.
+//   - `left` hijo izquierdo, puede ser nil.
+//   - `right` hijo derecho, puede ser nil.
+//
+// Retorna:
+//   - un puntero al nodo.
+func NewBinaryNode[T comparable](data T, left, right *BinaryNode[T]) *BinaryNode[T] {
+	return &BinaryNode[T]{data: data, left: left, right: right}
+}
+
+// GetData retorna el dato del nodo.
+func (n *BinaryNode[T]) GetData() T {
+	return n.data
+}
+
+// GetLeft retorna el hijo izquierdo del nodo.
+func (n *BinaryNode[T]) GetLeft() *BinaryNode[T] {
+	return n.left
+}
+
+// GetRight retorna el hijo derecho del nodo.
+func (n *BinaryNode[T]) GetRight() *BinaryNode[T] {
+	return n.right
+}
+
+func (n *BinaryNode[T]) size() int {
+	if n == nil {
+		return 0
+	}
+
+	return 1 + n.left.size() + n.right.size()
+}
+
+// serialize agrega a tokens el recorrido preorder del subárbol.
+func (n *BinaryNode[T]) serialize(tokens *[]string) {
+	if n == nil {
+		*tokens = append(*tokens, nullMarker)
+
+		return
+	}
+	*tokens = append(*tokens, escaper.Replace(fmt.Sprintf("%v", n.data)))
+	n.left.serialize(tokens)
+	n.right.serialize(tokens)
+}
+
+// token es un elemento de una serialización ya sin escapes.
+type token struct {
+	texto string
+	vacio bool // el subárbol es vacío
+}
+
+// tokenizar separa una serialización por las comas que no están escapadas y
+// quita los escapes de cada elemento.
+func tokenizar(s string) ([]token, error) {
+	var tokens []token
+	var actual strings.Builder
+	crudo := 0 // largo del elemento sin quitar escapes
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 == len(s) {
+				return nil, errors.New("serialización con un escape incompleto")
+			}
+			i++
+			actual.WriteByte(s[i])
+			crudo += 2
+		case ',':
+			tokens = append(tokens, token{texto: actual.String(), vacio: crudo == 1 && actual.String() == nullMarker})
+			actual.Reset()
+			crudo = 0
+		default:
+			actual.WriteByte(s[i])
+			crudo++
+		}
+	}
+
+	return append(tokens, token{texto: actual.String(), vacio: crudo == 1 && actual.String() == nullMarker}), nil
+}
+
+// BinaryTree es un árbol binario.
+type BinaryTree[T comparable] struct {
+	root *BinaryNode[T]
+}
+
+// NewBinaryTree crea un árbol con la raíz indicada.
+//
+// Uso:
+//
+//	tree := binarytree.NewBinaryTree(binarytree.NewBinaryNode(1, nil, nil))
+//
+// Parámetros:
+//   - `root` raíz del árbol. Si es nil el árbol está vacío.
+//
+// Retorna:
+//   - un puntero al árbol.
+func NewBinaryTree[T comparable](root *BinaryNode[T]) *BinaryTree[T] {
+	return &BinaryTree[T]{root: root}
+}
+
+// GetRoot retorna la raíz del árbol.
+func (t *BinaryTree[T]) GetRoot() *BinaryNode[T] {
+	return t.root
+}
+
+// IsEmpty indica si el árbol no tiene nodos.
+func (t *BinaryTree[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Size retorna la cantidad de nodos del árbol.
+func (t *BinaryTree[T]) Size() int {
+	return t.root.size()
+}
+
+// FromPreInOrder reconstruye un árbol a partir de sus recorridos preorder e
+// inorder. Los datos no pueden repetirse, ya que en ese caso el árbol no
+// queda determinado. O(n)
+//
+// Uso:
+//
+//	tree, err := binarytree.FromPreInOrder([]int{1, 2, 3}, []int{2, 1, 3})
+//
+// Parámetros:
+//   - `pre` recorrido preorder.
+//   - `in` recorrido inorder.
+//
+// Retorna:
+//   - un puntero al árbol reconstruido.
+//   - un error si los recorridos no corresponden a un mismo árbol.
+func FromPreInOrder[T comparable](pre, in []T) (*BinaryTree[T], error) {
+	positions, err := inOrderPositions(pre, in)
+	if err != nil {
+		return nil, err
+	}
+	next := 0
+	var build func(lo, hi int) (*BinaryNode[T], error)
+	build = func(lo, hi int) (*BinaryNode[T], error) {
+		if lo > hi {
+			return nil, nil
+		}
+		data := pre[next]
+		next++
+		pos := positions[data]
+		if pos < lo || pos > hi {
+			return nil, errors.New("recorridos inconsistentes")
+		}
+		left, err := build(lo, pos-1)
+		if err != nil {
+			return nil, err
+		}
+		right, err := build(pos+1, hi)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewBinaryNode(data, left, right), nil
+	}
+
+	root, err := build(0, len(in)-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBinaryTree(root), nil
+}
+
+// FromPostInOrder reconstruye un árbol a partir de sus recorridos posorder e
+// inorder. Los datos no pueden repetirse. O(n)
+//
+// Uso:
+//
+//	tree, err := binarytree.FromPostInOrder([]int{2, 3, 1}, []int{2, 1, 3})
+//
+// Parámetros:
+//   - `post` recorrido posorder.
+//   - `in` recorrido inorder.
+//
+// Retorna:
+//   - un puntero al árbol reconstruido.
+//   - un error si los recorridos no corresponden a un mismo árbol.
+func FromPostInOrder[T comparable](post, in []T) (*BinaryTree[T], error) {
+	positions, err := inOrderPositions(post, in)
+	if err != nil {
+		return nil, err
+	}
+	next := len(post) - 1
+	var build func(lo, hi int) (*BinaryNode[T], error)
+	build = func(lo, hi int) (*BinaryNode[T], error) {
+		if lo > hi {
+			return nil, nil
+		}
+		data := post[next]
+		next--
+		pos := positions[data]
+		if pos < lo || pos > hi {
+			return nil, errors.New("recorridos inconsistentes")
+		}
+		// en posorden, leído de atrás para adelante, el subárbol derecho va primero
+		right, err := build(pos+1, hi)
+		if err != nil {
+			return nil, err
+		}
+		left, err := build(lo, pos-1)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewBinaryNode(data, left, right), nil
+	}
+
+	root, err := build(0, len(in)-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBinaryTree(root), nil
+}
+
+// inOrderPositions valida que ambos recorridos tengan los mismos datos sin
+// repetir y retorna la posición de cada dato en el inorder.
+func inOrderPositions[T comparable](other, in []T) (map[T]int, error) {
+	if len(other) != len(in) {
+		return nil, errors.New("recorridos de distinto tamaño")
+	}
+	positions := make(map[T]int, len(in))
+	for i, data := range in {
+		if _, ok := positions[data]; ok {
+			return nil, errors.New("datos repetidos")
+		}
+		positions[data] = i
+	}
+	for _, data := range other {
+		if _, ok := positions[data]; !ok {
+			return nil, errors.New("recorridos inconsistentes")
+		}
+	}
+
+	return positions, nil
+}
+
+// Serialize convierte el árbol en un texto con su recorrido preorder, donde
+// cada subárbol vacío se representa con "#". En los datos, las comas, los "#"
+// y las barras invertidas se escapan con una barra invertida, así que
+// cualquier texto, incluso el vacío, se recupera con Deserialize.
+//
+// Uso:
+//
+//	s := tree.Serialize() // "1,2,#,#,3,#,#"
+//
+// Retorna:
+//   - la representación del árbol.
+func (t *BinaryTree[T]) Serialize() string {
+	tokens := make([]string, 0)
+	t.root.serialize(&tokens)
+
+	return strings.Join(tokens, ",")
+}
+
+// Deserialize reconstruye un árbol a partir del texto generado por Serialize.
+//
+// Uso:
+//
+//	tree, err := binarytree.Deserialize("1,2,#,#,3,#,#", strconv.Atoi)
+//
+// Parámetros:
+//   - `s` representación del árbol.
+//   - `parse` función que convierte cada dato desde su texto, ya sin escapes.
+//
+// Retorna:
+//   - un puntero al árbol reconstruido.
+//   - un error si el texto está mal formado o algún dato no puede convertirse.
+func Deserialize[T comparable](s string, parse func(string) (T, error)) (*BinaryTree[T], error) {
+	tokens, err := tokenizar(s)
+	if err != nil {
+		return nil, err
+	}
+	next := 0
+	var build func() (*BinaryNode[T], error)
+	build = func() (*BinaryNode[T], error) {
+		if next >= len(tokens) {
+			return nil, errors.New("serialización incompleta")
+		}
+		tok := tokens[next]
+		next++
+		if tok.vacio {
+			return nil, nil
+		}
+		data, err := parse(tok.texto)
+		if err != nil {
+			return nil, err
+		}
+		left, err := build()
+		if err != nil {
+			return nil, err
+		}
+		right, err := build()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewBinaryNode(data, left, right), nil
+	}
+
+	root, err := build()
+	if err != nil {
+		return nil, err
+	}
+	if next != len(tokens) {
+		return nil, errors.New("serialización con datos sobrantes")
+	}
+
+	return NewBinaryTree(root), nil
+}
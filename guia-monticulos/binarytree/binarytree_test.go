@@ -0,0 +1,145 @@
+package binarytree
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// nuevoArbolDeEjemplo arma el árbol:
+//
+//	[1]
+//	├── [2]
+//	│   ├── [4]
+//	│   └── [5]
+//	│       └── [7]
+//	└── [3]
+//	    └── [6] (derecho)
+func nuevoArbolDeEjemplo() *BinaryTree[int] {
+	return NewBinaryTree(
+		NewBinaryNode(1,
+			NewBinaryNode(2,
+				NewBinaryNode(4, nil, nil),
+				NewBinaryNode(5, NewBinaryNode(7, nil, nil), nil)),
+			NewBinaryNode(3, nil, NewBinaryNode(6, nil, nil))))
+}
+
+// iterador es la interfaz común de los iteradores del árbol.
+type iterador[T any] interface {
+	HasNext() bool
+	Next() (T, error)
+}
+
+func recorrer[T comparable](it iterador[T]) []T {
+	result := make([]T, 0)
+	for it.HasNext() {
+		v, _ := it.Next()
+		result = append(result, v)
+	}
+
+	return result
+}
+
+func TestBinaryTreeVacio(t *testing.T) {
+	tree := NewBinaryTree[int](nil)
+
+	assert.True(t, tree.IsEmpty())
+	assert.Equal(t, 0, tree.Size())
+	assert.Equal(t, "#", tree.Serialize())
+	assert.Empty(t, recorrer[int](tree.InOrderIterator()))
+	assert.Empty(t, recorrer[int](tree.PreOrderIterator()))
+	assert.Empty(t, recorrer[int](tree.PostOrderIterator()))
+	assert.Empty(t, recorrer[int](tree.LevelOrderIterator()))
+}
+
+func TestBinaryTreeIteradores(t *testing.T) {
+	tree := nuevoArbolDeEjemplo()
+
+	assert.Equal(t, 7, tree.Size())
+	assert.Equal(t, []int{4, 2, 7, 5, 1, 3, 6}, recorrer[int](tree.InOrderIterator()))
+	assert.Equal(t, []int{1, 2, 4, 5, 7, 3, 6}, recorrer[int](tree.PreOrderIterator()))
+	assert.Equal(t, []int{4, 7, 5, 2, 6, 3, 1}, recorrer[int](tree.PostOrderIterator()))
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, recorrer[int](tree.LevelOrderIterator()))
+}
+
+func TestBinaryTreeIteradorSinMasElementos(t *testing.T) {
+	it := nuevoArbolDeEjemplo().PostOrderIterator()
+	recorrer[int](it)
+
+	_, err := it.Next()
+	assert.EqualError(t, err, "no hay más elementos")
+}
+
+func TestFromPreInOrder(t *testing.T) {
+	tree, err := FromPreInOrder([]int{1, 2, 4, 5, 7, 3, 6}, []int{4, 2, 7, 5, 1, 3, 6})
+
+	assert.NoError(t, err)
+	assert.Equal(t, nuevoArbolDeEjemplo().Serialize(), tree.Serialize())
+}
+
+func TestFromPostInOrder(t *testing.T) {
+	tree, err := FromPostInOrder([]int{4, 7, 5, 2, 6, 3, 1}, []int{4, 2, 7, 5, 1, 3, 6})
+
+	assert.NoError(t, err)
+	assert.Equal(t, nuevoArbolDeEjemplo().Serialize(), tree.Serialize())
+}
+
+func TestFromPreInOrderInvalido(t *testing.T) {
+	_, err := FromPreInOrder([]int{1, 2}, []int{1})
+	assert.EqualError(t, err, "recorridos de distinto tamaño")
+
+	_, err = FromPreInOrder([]int{1, 1}, []int{1, 1})
+	assert.EqualError(t, err, "datos repetidos")
+
+	_, err = FromPreInOrder([]int{1, 2, 3}, []int{2, 3, 1})
+	assert.NoError(t, err)
+
+	_, err = FromPreInOrder([]int{1, 2, 3}, []int{3, 1, 2})
+	assert.EqualError(t, err, "recorridos inconsistentes")
+}
+
+func TestBinaryTreeSerializeYDeserialize(t *testing.T) {
+	tree := nuevoArbolDeEjemplo()
+	s := tree.Serialize()
+	assert.Equal(t, "1,2,4,#,#,5,7,#,#,#,3,#,6,#,#", s)
+
+	copia, err := Deserialize(s, strconv.Atoi)
+	assert.NoError(t, err)
+	assert.Equal(t, s, copia.Serialize())
+}
+
+func identidad(s string) (string, error) {
+	return s, nil
+}
+
+func TestBinaryTreeSerializeConTextosEspeciales(t *testing.T) {
+	casos := []*BinaryTree[string]{
+		NewBinaryTree(NewBinaryNode("", nil, nil)),
+		NewBinaryTree(NewBinaryNode("a,b", NewBinaryNode("", nil, nil), NewBinaryNode("#", nil, nil))),
+		NewBinaryTree(NewBinaryNode(`c:\dir`, nil, NewBinaryNode("#,#", nil, nil))),
+	}
+	for _, tree := range casos {
+		s := tree.Serialize()
+		copia, err := Deserialize(s, identidad)
+		assert.NoError(t, err, s)
+		assert.Equal(t, tree, copia, s)
+	}
+
+	assert.Equal(t, ",#,#", NewBinaryTree(NewBinaryNode("", nil, nil)).Serialize())
+	assert.Equal(t, `a\,b,,#,#,\#,#,#`, casos[1].Serialize())
+}
+
+func TestDeserializeInvalido(t *testing.T) {
+	_, err := Deserialize("1,#", strconv.Atoi)
+	assert.EqualError(t, err, "serialización incompleta")
+
+	_, err = Deserialize("1,#,#,#", strconv.Atoi)
+	assert.EqualError(t, err, "serialización con datos sobrantes")
+
+	_, err = Deserialize("x,#,#", strconv.Atoi)
+	assert.Error(t, err)
+
+	_, err = Deserialize(`1\`, strconv.Atoi)
+	assert.EqualError(t, err, "serialización con un escape incompleto")
+}
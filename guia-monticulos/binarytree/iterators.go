@@ -0,0 +1,164 @@
+package binarytree
+
+import "errors"
+
+// InOrderIterator recorre el árbol en inorder.
+type InOrderIterator[T comparable] struct {
+	stack []*BinaryNode[T] // pila de nodos pendientes, con el tope al final
+}
+
+// InOrderIterator retorna un iterador inorder del árbol.
+func (t *BinaryTree[T]) InOrderIterator() *InOrderIterator[T] {
+	it := &InOrderIterator[T]{}
+	it.stackLeftChildren(t.root)
+
+	return it
+}
+
+func (it *InOrderIterator[T]) stackLeftChildren(node *BinaryNode[T]) {
+	for node != nil {
+		it.stack = append(it.stack, node)
+		node = node.left
+	}
+}
+
+// HasNext indica si quedan elementos por recorrer.
+func (it *InOrderIterator[T]) HasNext() bool {
+	return len(it.stack) > 0
+}
+
+// Next retorna el siguiente elemento del recorrido.
+func (it *InOrderIterator[T]) Next() (T, error) {
+	var data T
+	if len(it.stack) == 0 {
+		return data, errors.New("no hay más elementos")
+	}
+	next := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	it.stackLeftChildren(next.right)
+
+	return next.data, nil
+}
+
+// PreOrderIterator recorre el árbol en preorder.
+type PreOrderIterator[T comparable] struct {
+	stack []*BinaryNode[T] // pila de nodos pendientes, con el tope al final
+}
+
+// PreOrderIterator retorna un iterador preorder del árbol.
+func (t *BinaryTree[T]) PreOrderIterator() *PreOrderIterator[T] {
+	it := &PreOrderIterator[T]{}
+	if t.root != nil {
+		it.stack = append(it.stack, t.root)
+	}
+
+	return it
+}
+
+// HasNext indica si quedan elementos por recorrer.
+func (it *PreOrderIterator[T]) HasNext() bool {
+	return len(it.stack) > 0
+}
+
+// Next retorna el siguiente elemento del recorrido.
+func (it *PreOrderIterator[T]) Next() (T, error) {
+	var data T
+	if len(it.stack) == 0 {
+		return data, errors.New("no hay más elementos")
+	}
+	next := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	if next.right != nil {
+		it.stack = append(it.stack, next.right)
+	}
+	if next.left != nil {
+		it.stack = append(it.stack, next.left)
+	}
+
+	return next.data, nil
+}
+
+// PostOrderIterator recorre el árbol en posorder.
+type PostOrderIterator[T comparable] struct {
+	stack []*BinaryNode[T] // pila de nodos pendientes, con el tope al final
+}
+
+// PostOrderIterator retorna un iterador posorder del árbol.
+func (t *BinaryTree[T]) PostOrderIterator() *PostOrderIterator[T] {
+	it := &PostOrderIterator[T]{}
+	it.stackFirstLeaf(t.root)
+
+	return it
+}
+
+// stackFirstLeaf apila el camino hasta la primera hoja en posorden,
+// prefiriendo siempre el hijo izquierdo.
+func (it *PostOrderIterator[T]) stackFirstLeaf(node *BinaryNode[T]) {
+	for node != nil {
+		it.stack = append(it.stack, node)
+		if node.left != nil {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+}
+
+// HasNext indica si quedan elementos por recorrer.
+func (it *PostOrderIterator[T]) HasNext() bool {
+	return len(it.stack) > 0
+}
+
+// Next retorna el siguiente elemento del recorrido.
+func (it *PostOrderIterator[T]) Next() (T, error) {
+	var data T
+	if len(it.stack) == 0 {
+		return data, errors.New("no hay más elementos")
+	}
+	next := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	// si se terminó el subárbol izquierdo del padre, falta recorrer el derecho
+	if len(it.stack) > 0 && it.stack[len(it.stack)-1].left == next {
+		it.stackFirstLeaf(it.stack[len(it.stack)-1].right)
+	}
+
+	return next.data, nil
+}
+
+// LevelOrderIterator recorre el árbol por niveles.
+type LevelOrderIterator[T comparable] struct {
+	queue []*BinaryNode[T] // cola de nodos pendientes, con el primero al principio
+}
+
+// LevelOrderIterator retorna un iterador por niveles del árbol.
+func (t *BinaryTree[T]) LevelOrderIterator() *LevelOrderIterator[T] {
+	it := &LevelOrderIterator[T]{}
+	if t.root != nil {
+		it.queue = append(it.queue, t.root)
+	}
+
+	return it
+}
+
+// HasNext indica si quedan elementos por recorrer.
+func (it *LevelOrderIterator[T]) HasNext() bool {
+	return len(it.queue) > 0
+}
+
+// Next retorna el siguiente elemento del recorrido.
+func (it *LevelOrderIterator[T]) Next() (T, error) {
+	var data T
+	if len(it.queue) == 0 {
+		return data, errors.New("no hay más elementos")
+	}
+	next := it.queue[0]
+	it.queue = it.queue[1:]
+	if next.left != nil {
+		it.queue = append(it.queue, next.left)
+	}
+	if next.right != nil {
+		it.queue = append(it.queue, next.right)
+	}
+
+	return next.data, nil
+}
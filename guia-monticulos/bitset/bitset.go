@@ -0,0 +1,182 @@
+// Package bitset provee un conjunto de bits de tamaño dinámico.
+//
+// A diferencia de bitmap.BitMap, que está limitado a 32 bits, un BitSet crece
+// automáticamente al encender bits en posiciones mayores a su capacidad.
+package bitset
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+const wordSize = 64
+
+// BitSet es un conjunto de bits almacenado en palabras de 64 bits.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet crea un conjunto de bits vacío con lugar para al menos n bits.
+//
+// Uso:
+//
+//	visitados := bitset.NewBitSet(1_000_000)
+//
+// Parámetros:
+//   - `n` cantidad de bits a reservar. Es sólo una sugerencia: el conjunto crece
+//     si hace falta.
+//
+// Retorna:
+//   - un puntero a un conjunto de bits con todos los bits apagados.
+func NewBitSet(n uint) *BitSet {
+	return &BitSet{words: make([]uint64, (n+wordSize-1)/wordSize)}
+}
+
+// Len retorna la cantidad de bits reservados.
+func (b *BitSet) Len() uint {
+	return uint(len(b.words)) * wordSize
+}
+
+// Set enciende el bit de la posición indicada, creciendo si hace falta.
+//
+// Uso:
+//
+//	b.Set(42)
+//
+// Parámetros:
+//   - `i` posición del bit.
+func (b *BitSet) Set(i uint) {
+	word := i / wordSize
+	if word >= uint(len(b.words)) {
+		b.grow(word + 1)
+	}
+	b.words[word] |= 1 << (i % wordSize)
+}
+
+// Clear apaga el bit de la posición indicada.
+//
+// Uso:
+//
+//	b.Clear(42)
+//
+// Parámetros:
+//   - `i` posición del bit.
+func (b *BitSet) Clear(i uint) {
+	if word := i / wordSize; word < uint(len(b.words)) {
+		b.words[word] &^= 1 << (i % wordSize)
+	}
+}
+
+// Test indica si el bit de la posición indicada está encendido. Las posiciones
+// fuera de la capacidad se consideran apagadas.
+//
+// Uso:
+//
+//	if b.Test(42) {
+//		fmt.Println("visitado")
+//	}
+//
+// Parámetros:
+//   - `i` posición del bit.
+//
+// Retorna:
+//   - true si el bit está encendido.
+func (b *BitSet) Test(i uint) bool {
+	word := i / wordSize
+	if word >= uint(len(b.words)) {
+		return false
+	}
+
+	return b.words[word]&(1<<(i%wordSize)) != 0
+}
+
+// Count retorna la cantidad de bits encendidos.
+func (b *BitSet) Count() int {
+	count := 0
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+
+	return count
+}
+
+// And retorna un conjunto nuevo con los bits encendidos en ambos conjuntos.
+func (b *BitSet) And(other *BitSet) *BitSet {
+	n := len(b.words)
+	if len(other.words) < n {
+		n = len(other.words)
+	}
+	result := &BitSet{words: make([]uint64, n)}
+	for i := range result.words {
+		result.words[i] = b.words[i] & other.words[i]
+	}
+
+	return result
+}
+
+// Or retorna un conjunto nuevo con los bits encendidos en alguno de los conjuntos.
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Xor retorna un conjunto nuevo con los bits encendidos en exactamente uno de
+// los conjuntos.
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// String retorna los bits encendidos, por ejemplo "{1, 5, 64}".
+func (b *BitSet) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	first := true
+	for i, w := range b.words {
+		for w != 0 {
+			bit := bits.TrailingZeros64(w)
+			if !first {
+				sb.WriteString(", ")
+			}
+			first = false
+			sb.WriteString(strconv.Itoa(i*wordSize + bit))
+			w &= w - 1
+		}
+	}
+	sb.WriteString("}")
+
+	return sb.String()
+}
+
+// combine aplica una operación palabra a palabra; el conjunto más corto se
+// completa con ceros.
+func (b *BitSet) combine(other *BitSet, op func(x, y uint64) uint64) *BitSet {
+	n := len(b.words)
+	if len(other.words) > n {
+		n = len(other.words)
+	}
+	result := &BitSet{words: make([]uint64, n)}
+	for i := range result.words {
+		var x, y uint64
+		if i < len(b.words) {
+			x = b.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		result.words[i] = op(x, y)
+	}
+
+	return result
+}
+
+// grow amplía el conjunto a al menos n palabras, duplicando la capacidad para
+// que el crecimiento sea O(1) amortizado.
+func (b *BitSet) grow(n uint) {
+	capacity := uint(2 * len(b.words))
+	if capacity < n {
+		capacity = n
+	}
+	words := make([]uint64, capacity)
+	copy(words, b.words)
+	b.words = words
+}
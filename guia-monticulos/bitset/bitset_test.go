@@ -0,0 +1,61 @@
+package bitset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func nuevo(posiciones ...uint) *BitSet {
+	b := NewBitSet(0)
+	for _, p := range posiciones {
+		b.Set(p)
+	}
+
+	return b
+}
+
+func TestNewBitSet(t *testing.T) {
+	b := NewBitSet(100)
+
+	assert.Equal(t, uint(128), b.Len())
+	assert.Equal(t, 0, b.Count())
+	assert.False(t, b.Test(5))
+	assert.False(t, b.Test(10_000))
+}
+
+func TestBitSetSetCreceAutomaticamente(t *testing.T) {
+	b := NewBitSet(0)
+	b.Set(1000)
+
+	assert.True(t, b.Test(1000))
+	assert.False(t, b.Test(999))
+	assert.GreaterOrEqual(t, b.Len(), uint(1001))
+	assert.Equal(t, 1, b.Count())
+}
+
+func TestBitSetClear(t *testing.T) {
+	b := nuevo(3, 64, 65)
+	b.Clear(64)
+	b.Clear(5000)
+
+	assert.False(t, b.Test(64))
+	assert.Equal(t, 2, b.Count())
+	assert.Equal(t, "{3, 65}", b.String())
+}
+
+func TestBitSetOperaciones(t *testing.T) {
+	a := nuevo(1, 2, 3, 200)
+	b := nuevo(2, 3, 4)
+
+	assert.Equal(t, "{2, 3}", a.And(b).String())
+	assert.Equal(t, "{1, 2, 3, 4, 200}", a.Or(b).String())
+	assert.Equal(t, "{1, 4, 200}", a.Xor(b).String())
+	assert.Equal(t, "{1, 4, 200}", b.Xor(a).String())
+	assert.Equal(t, "{1, 2, 3, 200}", a.String())
+}
+
+func TestBitSetStringVacio(t *testing.T) {
+	assert.Equal(t, "{}", NewBitSet(64).String())
+	assert.Equal(t, "{0}", nuevo(0).String())
+}
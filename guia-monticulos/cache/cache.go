@@ -0,0 +1,173 @@
+// Package cache provee un cache genérico de capacidad fija cuya política de
+// expulsión es intercambiable. Sirve para comparar políticas (LRU, LFU, TTL,
+// aleatoria) sobre una misma secuencia de accesos; para un cache LRU o LFU
+// puntual conviene usar directamente los paquetes lru o lfu.
+package cache
+
+import "errors"
+
+// Politica decide qué clave expulsar cuando el cache se llena. El cache le
+// informa cada alta, acceso y baja de una clave.
+type Politica[K comparable] interface {
+	// Agregar registra una clave nueva.
+	Agregar(clave K)
+	// Acceder registra una lectura o actualización de una clave existente.
+	Acceder(clave K)
+	// Quitar olvida una clave que el cache eliminó.
+	Quitar(clave K)
+	// Victima elige la clave a expulsar y la olvida. Retorna false si no
+	// conoce ninguna clave.
+	Victima() (K, bool)
+}
+
+// ConVencimiento la implementan las políticas en las que las entradas
+// vencen. El cache trata una entrada vencida como ausente.
+type ConVencimiento[K comparable] interface {
+	Vencida(clave K) bool
+}
+
+// Estadisticas cuenta los resultados de los accesos al cache.
+type Estadisticas struct {
+	Hits        int
+	Misses      int
+	Expulsiones int
+}
+
+// HitRate retorna la fracción de accesos que encontraron la clave.
+func (e Estadisticas) HitRate() float64 {
+	if e.Hits+e.Misses == 0 {
+		return 0
+	}
+
+	return float64(e.Hits) / float64(e.Hits+e.Misses)
+}
+
+// Cache es un cache de capacidad fija. No es seguro usarlo desde varias
+// goroutines.
+type Cache[K comparable, V any] struct {
+	capacidad    int
+	datos        map[K]V
+	politica     Politica[K]
+	estadisticas Estadisticas
+}
+
+// New crea un cache vacío.
+//
+// Uso:
+//
+//	c, err := cache.New[string, []byte](1000, cache.NewLFU[string]())
+//
+// Parámetros:
+//   - `capacidad` cantidad máxima de entradas.
+//   - `politica` política de expulsión, sin claves registradas.
+//
+// Retorna:
+//   - un puntero al cache.
+//   - un error si la capacidad no es positiva o la política es nil.
+func New[K comparable, V any](capacidad int, politica Politica[K]) (*Cache[K, V], error) {
+	if capacidad < 1 {
+		return nil, errors.New("capacidad inválida")
+	}
+	if politica == nil {
+		return nil, errors.New("falta la política de expulsión")
+	}
+
+	return &Cache[K, V]{capacidad: capacidad, datos: make(map[K]V, capacidad), politica: politica}, nil
+}
+
+// vencida indica si la política considera vencida a la clave.
+func (c *Cache[K, V]) vencida(clave K) bool {
+	v, ok := c.politica.(ConVencimiento[K])
+	return ok && v.Vencida(clave)
+}
+
+// Get retorna el valor asociado a la clave.
+//
+// Retorna:
+//   - el valor.
+//   - false si la clave no está o venció.
+func (c *Cache[K, V]) Get(clave K) (V, bool) {
+	v, ok := c.datos[clave]
+	if ok && c.vencida(clave) {
+		c.Remove(clave)
+		ok = false
+	}
+	if !ok {
+		c.estadisticas.Misses++
+		var cero V
+		return cero, false
+	}
+	c.estadisticas.Hits++
+	c.politica.Acceder(clave)
+
+	return v, true
+}
+
+// Put agrega o actualiza una entrada. Si el cache está lleno expulsa
+// primero la clave que elige la política.
+func (c *Cache[K, V]) Put(clave K, valor V) {
+	if _, ok := c.datos[clave]; ok {
+		c.datos[clave] = valor
+		c.politica.Acceder(clave)
+		return
+	}
+	if len(c.datos) >= c.capacidad {
+		if victima, ok := c.politica.Victima(); ok {
+			delete(c.datos, victima)
+			c.estadisticas.Expulsiones++
+		}
+	}
+	c.datos[clave] = valor
+	c.politica.Agregar(clave)
+}
+
+// Remove elimina una entrada.
+//
+// Retorna:
+//   - true si la clave estaba en el cache.
+func (c *Cache[K, V]) Remove(clave K) bool {
+	if _, ok := c.datos[clave]; !ok {
+		return false
+	}
+	delete(c.datos, clave)
+	c.politica.Quitar(clave)
+
+	return true
+}
+
+// Len retorna la cantidad de entradas.
+func (c *Cache[K, V]) Len() int {
+	return len(c.datos)
+}
+
+// Estadisticas retorna los hits, misses y expulsiones acumulados.
+func (c *Cache[K, V]) Estadisticas() Estadisticas {
+	return c.estadisticas
+}
+
+// Simular recorre una secuencia de accesos sobre un cache nuevo: cada clave
+// se busca y, si no está, se agrega. Sirve para comparar políticas con el
+// mismo workload.
+//
+// Uso:
+//
+//	lru, _ := cache.Simular(100, cache.NewLRU[int](), accesos)
+//	lfu, _ := cache.Simular(100, cache.NewLFU[int](), accesos)
+//	fmt.Println(lru.HitRate(), lfu.HitRate())
+//
+// Retorna:
+//   - las estadísticas del cache al final.
+//   - un error si la capacidad o la política son inválidas.
+func Simular[K comparable](capacidad int, politica Politica[K], accesos []K) (Estadisticas, error) {
+	c, err := New[K, struct{}](capacidad, politica)
+	if err != nil {
+		return Estadisticas{}, err
+	}
+	for _, clave := range accesos {
+		if _, ok := c.Get(clave); !ok {
+			c.Put(clave, struct{}{})
+		}
+	}
+
+	return c.Estadisticas(), nil
+}
@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheLRU(t *testing.T) {
+	c, err := New[string, int](2, NewLRU[string]())
+	assert.NoError(t, err)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, Estadisticas{Hits: 2, Misses: 1, Expulsiones: 1}, c.Estadisticas())
+}
+
+func TestCacheLFU(t *testing.T) {
+	c, _ := New[string, int](2, NewLFU[string]())
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Put("c", 3)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok, "b tiene menos accesos que a")
+	c.Put("d", 4)
+	_, ok = c.Get("c")
+	assert.False(t, ok, "c y d empatan, se expulsa el usado hace más tiempo")
+	_, ok = c.Get("a")
+	assert.True(t, ok)
+}
+
+func TestCacheTTL(t *testing.T) {
+	ahora := time.Date(2024, 5, 10, 10, 0, 0, 0, time.UTC)
+	reloj := func() time.Time { return ahora }
+	c, _ := New[string, int](2, NewTTL[string](time.Minute, reloj))
+	c.Put("a", 1)
+	ahora = ahora.Add(30 * time.Second)
+	c.Put("b", 2)
+
+	ahora = ahora.Add(40 * time.Second)
+	_, ok := c.Get("a")
+	assert.False(t, ok, "a venció")
+	assert.Equal(t, 1, c.Len())
+	_, ok = c.Get("b")
+	assert.True(t, ok)
+
+	c.Put("c", 3)
+	ahora = ahora.Add(5 * time.Second)
+	c.Get("b")
+	c.Put("d", 4)
+	_, ok = c.Get("c")
+	assert.False(t, ok, "se expulsa la más próxima a vencer")
+	_, ok = c.Get("b")
+	assert.True(t, ok)
+}
+
+func TestCacheAleatoria(t *testing.T) {
+	c, _ := New[int, int](3, NewAleatoria[int](1))
+	for i := 0; i < 10; i++ {
+		c.Put(i, i)
+	}
+
+	assert.Equal(t, 3, c.Len())
+	assert.Equal(t, 7, c.Estadisticas().Expulsiones)
+	assert.True(t, c.Remove(9) || c.Len() == 3)
+}
+
+func TestCacheRemoveYUpdate(t *testing.T) {
+	c, _ := New[string, int](2, NewLRU[string]())
+	c.Put("a", 1)
+	c.Put("a", 10)
+	assert.Equal(t, 1, c.Len())
+	v, _ := c.Get("a")
+	assert.Equal(t, 10, v)
+
+	assert.True(t, c.Remove("a"))
+	assert.False(t, c.Remove("a"))
+	c.Put("b", 2)
+	c.Put("c", 3)
+	assert.Zero(t, c.Estadisticas().Expulsiones)
+}
+
+func TestNewInvalido(t *testing.T) {
+	_, err := New[string, int](0, NewLRU[string]())
+	assert.EqualError(t, err, "capacidad inválida")
+	_, err = New[string, int](1, nil)
+	assert.EqualError(t, err, "falta la política de expulsión")
+}
+
+// Con accesos sesgados (pocas claves muy populares) LFU y LRU superan a la
+// expulsión aleatoria sobre el mismo workload.
+func TestSimularComparaPoliticas(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	zipf := rand.NewZipf(r, 1.2, 1, 999)
+	accesos := make([]uint64, 20000)
+	for i := range accesos {
+		accesos[i] = zipf.Uint64()
+	}
+
+	lru, err := Simular(50, NewLRU[uint64](), accesos)
+	assert.NoError(t, err)
+	lfu, _ := Simular(50, NewLFU[uint64](), accesos)
+	aleatoria, _ := Simular(50, NewAleatoria[uint64](1), accesos)
+
+	assert.Equal(t, len(accesos), lru.Hits+lru.Misses)
+	assert.Greater(t, lfu.HitRate(), aleatoria.HitRate())
+	assert.Greater(t, lru.HitRate(), 0.3)
+	assert.Zero(t, Estadisticas{}.HitRate())
+}
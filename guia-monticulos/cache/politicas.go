@@ -0,0 +1,309 @@
+package cache
+
+import (
+	"math/rand"
+	"time"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// LRU expulsa la clave usada hace más tiempo. Guarda las claves en un heap
+// indexado de mínimos ordenado por el instante del último acceso, por lo que
+// cada acceso cuesta O(log n) (el paquete lru lo hace en O(1) con una lista).
+type LRU[K comparable] struct {
+	accesos *heap.HeapIndexado[K, int]
+	reloj   int
+}
+
+// NewLRU crea una política LRU.
+func NewLRU[K comparable]() *LRU[K] {
+	return &LRU[K]{accesos: heap.NewHeapIndexado[K](func(a, b int) int { return a - b })}
+}
+
+// Agregar registra una clave nueva como la usada más recientemente. O(log n)
+//
+// Uso:
+//
+//	politica.Agregar("a")
+//
+// Parámetros:
+//   - `clave` clave que el cache acaba de dar de alta.
+func (p *LRU[K]) Agregar(clave K) {
+	p.reloj++
+	_ = p.accesos.Insert(clave, p.reloj)
+}
+
+// Acceder marca la clave como la usada más recientemente, lo que la aleja de
+// ser la próxima víctima. O(log n)
+//
+// Uso:
+//
+//	politica.Acceder("a")
+//
+// Parámetros:
+//   - `clave` clave leída o actualizada.
+func (p *LRU[K]) Acceder(clave K) {
+	p.reloj++
+	_ = p.accesos.Update(clave, p.reloj)
+}
+
+// Quitar olvida la clave. Si no estaba registrada no hace nada. O(log n)
+//
+// Uso:
+//
+//	politica.Quitar("a")
+//
+// Parámetros:
+//   - `clave` clave que el cache eliminó.
+func (p *LRU[K]) Quitar(clave K) {
+	_, _ = p.accesos.Delete(clave)
+}
+
+// Victima retira la clave usada hace más tiempo. O(log n)
+//
+// Uso:
+//
+//	if clave, ok := politica.Victima(); ok {
+//		fmt.Println("se expulsa", clave)
+//	}
+//
+// Retorna:
+//   - la clave a expulsar.
+//   - false si no hay claves registradas.
+func (p *LRU[K]) Victima() (K, bool) {
+	clave, _, err := p.accesos.Remove()
+	return clave, err == nil
+}
+
+// uso es la frecuencia de una clave y su último acceso, para desempatar.
+type uso struct {
+	frecuencia int
+	ultimo     int
+}
+
+// LFU expulsa la clave usada menos veces y, entre ellas, la usada hace más
+// tiempo. Usa un heap indexado de mínimos por frecuencia.
+type LFU[K comparable] struct {
+	usos  *heap.HeapIndexado[K, uso]
+	reloj int
+}
+
+// NewLFU crea una política LFU.
+func NewLFU[K comparable]() *LFU[K] {
+	return &LFU[K]{usos: heap.NewHeapIndexado[K](func(a, b uso) int {
+		if a.frecuencia != b.frecuencia {
+			return a.frecuencia - b.frecuencia
+		}
+		return a.ultimo - b.ultimo
+	})}
+}
+
+// Agregar registra una clave nueva con frecuencia 1. O(log n)
+//
+// Uso:
+//
+//	politica.Agregar("a")
+//
+// Parámetros:
+//   - `clave` clave que el cache acaba de dar de alta.
+func (p *LFU[K]) Agregar(clave K) {
+	p.reloj++
+	_ = p.usos.Insert(clave, uso{frecuencia: 1, ultimo: p.reloj})
+}
+
+// Acceder suma uno a la frecuencia de la clave y actualiza su último acceso,
+// que desempata entre claves de igual frecuencia. O(log n)
+//
+// Uso:
+//
+//	politica.Acceder("a")
+//
+// Parámetros:
+//   - `clave` clave leída o actualizada.
+func (p *LFU[K]) Acceder(clave K) {
+	p.reloj++
+	u, _ := p.usos.Get(clave)
+	_ = p.usos.Update(clave, uso{frecuencia: u.frecuencia + 1, ultimo: p.reloj})
+}
+
+// Quitar olvida la clave junto con su frecuencia. O(log n)
+//
+// Uso:
+//
+//	politica.Quitar("a")
+//
+// Parámetros:
+//   - `clave` clave que el cache eliminó.
+func (p *LFU[K]) Quitar(clave K) {
+	_, _ = p.usos.Delete(clave)
+}
+
+// Victima retira la clave de menor frecuencia y, si hay varias, la usada
+// hace más tiempo. O(log n)
+//
+// Uso:
+//
+//	clave, ok := politica.Victima()
+//
+// Retorna:
+//   - la clave a expulsar.
+//   - false si no hay claves registradas.
+func (p *LFU[K]) Victima() (K, bool) {
+	clave, _, err := p.usos.Remove()
+	return clave, err == nil
+}
+
+// TTL hace vencer cada entrada un tiempo fijo después de su último acceso,
+// y si el cache se llena expulsa la más próxima a vencer. Los vencimientos
+// se guardan en un heap indexado de mínimos.
+type TTL[K comparable] struct {
+	ttl          time.Duration
+	ahora        func() time.Time
+	vencimientos *heap.HeapIndexado[K, time.Time]
+}
+
+// NewTTL crea una política TTL.
+//
+// Parámetros:
+//   - `ttl` tiempo de vida de cada entrada.
+//   - `ahora` reloj a usar; si es nil se usa time.Now.
+func NewTTL[K comparable](ttl time.Duration, ahora func() time.Time) *TTL[K] {
+	if ahora == nil {
+		ahora = time.Now
+	}
+
+	return &TTL[K]{
+		ttl:          ttl,
+		ahora:        ahora,
+		vencimientos: heap.NewHeapIndexado[K](func(a, b time.Time) int { return a.Compare(b) }),
+	}
+}
+
+// Agregar registra una clave nueva que vence dentro de un tiempo de vida.
+// O(log n)
+//
+// Uso:
+//
+//	politica.Agregar("sesion-1")
+//
+// Parámetros:
+//   - `clave` clave que el cache acaba de dar de alta.
+func (p *TTL[K]) Agregar(clave K) {
+	_ = p.vencimientos.Insert(clave, p.ahora().Add(p.ttl))
+}
+
+// Acceder renueva el vencimiento (TTL deslizante): una entrada vence si pasa
+// el tiempo de vida sin que se la lea ni se la actualice. O(log n)
+//
+// Uso:
+//
+//	politica.Acceder("sesion-1")
+//
+// Parámetros:
+//   - `clave` clave leída o actualizada.
+func (p *TTL[K]) Acceder(clave K) {
+	_ = p.vencimientos.Update(clave, p.ahora().Add(p.ttl))
+}
+
+// Quitar olvida la clave y su vencimiento. O(log n)
+//
+// Uso:
+//
+//	politica.Quitar("sesion-1")
+//
+// Parámetros:
+//   - `clave` clave que el cache eliminó.
+func (p *TTL[K]) Quitar(clave K) {
+	_, _ = p.vencimientos.Delete(clave)
+}
+
+// Victima retira la clave más próxima a vencer, aunque todavía no haya
+// vencido. O(log n)
+//
+// Uso:
+//
+//	clave, ok := politica.Victima()
+//
+// Retorna:
+//   - la clave a expulsar.
+//   - false si no hay claves registradas.
+func (p *TTL[K]) Victima() (K, bool) {
+	clave, _, err := p.vencimientos.Remove()
+	return clave, err == nil
+}
+
+// Vencida indica si pasó el tiempo de vida de la clave.
+func (p *TTL[K]) Vencida(clave K) bool {
+	v, ok := p.vencimientos.Get(clave)
+	return ok && !p.ahora().Before(v)
+}
+
+// Aleatoria expulsa una clave elegida al azar. Es la referencia contra la
+// que se comparan las demás políticas.
+type Aleatoria[K comparable] struct {
+	claves     []K
+	posiciones map[K]int
+	rnd        *rand.Rand
+}
+
+// NewAleatoria crea una política aleatoria reproducible a partir de la semilla.
+func NewAleatoria[K comparable](semilla int64) *Aleatoria[K] {
+	return &Aleatoria[K]{posiciones: make(map[K]int), rnd: rand.New(rand.NewSource(semilla))}
+}
+
+// Agregar registra una clave nueva como candidata a víctima. O(1)
+//
+// Uso:
+//
+//	politica.Agregar("a")
+//
+// Parámetros:
+//   - `clave` clave que el cache acaba de dar de alta.
+func (p *Aleatoria[K]) Agregar(clave K) {
+	p.posiciones[clave] = len(p.claves)
+	p.claves = append(p.claves, clave)
+}
+
+// Acceder no hace nada: la política aleatoria no tiene en cuenta los accesos.
+func (p *Aleatoria[K]) Acceder(K) {}
+
+// Quitar olvida la clave moviendo la última a su lugar. Si no estaba
+// registrada no hace nada. O(1)
+//
+// Uso:
+//
+//	politica.Quitar("a")
+//
+// Parámetros:
+//   - `clave` clave que el cache eliminó.
+func (p *Aleatoria[K]) Quitar(clave K) {
+	i, ok := p.posiciones[clave]
+	if !ok {
+		return
+	}
+	ultima := p.claves[len(p.claves)-1]
+	p.claves[i] = ultima
+	p.posiciones[ultima] = i
+	p.claves = p.claves[:len(p.claves)-1]
+	delete(p.posiciones, clave)
+}
+
+// Victima retira una clave elegida al azar entre las registradas. O(1)
+//
+// Uso:
+//
+//	clave, ok := politica.Victima()
+//
+// Retorna:
+//   - la clave a expulsar.
+//   - false si no hay claves registradas.
+func (p *Aleatoria[K]) Victima() (K, bool) {
+	if len(p.claves) == 0 {
+		var cero K
+		return cero, false
+	}
+	clave := p.claves[p.rnd.Intn(len(p.claves))]
+	p.Quitar(clave)
+
+	return clave, true
+}
@@ -0,0 +1,124 @@
+// Package cartesiantree provee la construcción de un árbol cartesiano a partir
+// de un arreglo.
+//
+// Un árbol cartesiano es a la vez un montículo de mínimo respecto de los valores
+// y un árbol binario de búsqueda respecto de los índices: su recorrido inorder
+// devuelve el arreglo original.
+package cartesiantree
+
+import "cmp"
+
+// CartesianNode es un nodo del árbol cartesiano.
+type CartesianNode[T cmp.Ordered] struct {
+	data  T                 // dato
+	index int               // posición del dato en el arreglo original
+	left  *CartesianNode[T] // hijo izquierdo
+	right *CartesianNode[T] // hijo derecho
+}
+
+// GetData retorna el dato del nodo.
+func (n *CartesianNode[T]) GetData() T {
+	return n.data
+}
+
+// GetIndex retorna la posición del dato en el arreglo original.
+func (n *CartesianNode[T]) GetIndex() int {
+	return n.index
+}
+
+// GetLeft retorna el hijo izquierdo del nodo.
+func (n *CartesianNode[T]) GetLeft() *CartesianNode[T] {
+	return n.left
+}
+
+// GetRight retorna el hijo derecho del nodo.
+func (n *CartesianNode[T]) GetRight() *CartesianNode[T] {
+	return n.right
+}
+
+// CartesianTree es un árbol cartesiano de mínimo.
+type CartesianTree[T cmp.Ordered] struct {
+	root *CartesianNode[T]
+	size int
+}
+
+// BuildCartesianTree construye el árbol cartesiano de un arreglo en O(n).
+//
+// Se recorre el arreglo de izquierda a derecha manteniendo en una pila la rama
+// derecha del árbol. Cada elemento nuevo desapila los nodos mayores que él, que
+// pasan a ser su subárbol izquierdo, y se cuelga como hijo derecho del tope.
+// Cada índice se apila y desapila a lo sumo una vez.
+//
+// Uso:
+//
+//	tree := cartesiantree.BuildCartesianTree([]int{9, 3, 7, 1, 8})
+//
+// Parámetros:
+//   - `arr` arreglo a partir del cual se construye el árbol.
+//
+// Retorna:
+//   - un puntero al árbol cartesiano.
+func BuildCartesianTree[T cmp.Ordered](arr []T) *CartesianTree[T] {
+	// rama derecha del árbol construido hasta el momento, con la hoja al final
+	rightBranch := make([]*CartesianNode[T], 0)
+
+	for i, value := range arr {
+		node := &CartesianNode[T]{data: value, index: i}
+
+		var last *CartesianNode[T]
+		for len(rightBranch) > 0 && rightBranch[len(rightBranch)-1].data > value {
+			last = rightBranch[len(rightBranch)-1]
+			rightBranch = rightBranch[:len(rightBranch)-1]
+		}
+		node.left = last
+
+		if len(rightBranch) > 0 {
+			rightBranch[len(rightBranch)-1].right = node
+		}
+		rightBranch = append(rightBranch, node)
+	}
+
+	var root *CartesianNode[T]
+	if len(rightBranch) > 0 {
+		root = rightBranch[0]
+	}
+
+	return &CartesianTree[T]{root: root, size: len(arr)}
+}
+
+// GetRoot retorna la raíz del árbol, que contiene el mínimo del arreglo.
+func (t *CartesianTree[T]) GetRoot() *CartesianNode[T] {
+	return t.root
+}
+
+// Size retorna la cantidad de nodos del árbol.
+func (t *CartesianTree[T]) Size() int {
+	return t.size
+}
+
+// InOrder recorre el árbol en inorder, reconstruyendo el arreglo original.
+//
+// Uso:
+//
+//	arr := tree.InOrder()
+//
+// Retorna:
+//   - los datos del árbol en el orden del arreglo original.
+func (t *CartesianTree[T]) InOrder() []T {
+	result := make([]T, 0, t.size)
+	pending := make([]*CartesianNode[T], 0) // pila, con el tope al final
+
+	node := t.root
+	for node != nil || len(pending) > 0 {
+		for node != nil {
+			pending = append(pending, node)
+			node = node.left
+		}
+		node = pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		result = append(result, node.data)
+		node = node.right
+	}
+
+	return result
+}
@@ -0,0 +1,75 @@
+package cartesiantree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// verificarPropiedades comprueba que cada nodo sea menor o igual que sus hijos
+// (propiedad de montículo) y que los índices respeten el orden de un BST.
+func verificarPropiedades[T int | string](t *testing.T, n *CartesianNode[T], minIndex, maxIndex int) {
+	if n == nil {
+		return
+	}
+	assert.True(t, n.index >= minIndex && n.index <= maxIndex)
+	if n.left != nil {
+		assert.LessOrEqual(t, n.data, n.left.data)
+	}
+	if n.right != nil {
+		assert.LessOrEqual(t, n.data, n.right.data)
+	}
+	verificarPropiedades(t, n.left, minIndex, n.index-1)
+	verificarPropiedades(t, n.right, n.index+1, maxIndex)
+}
+
+func TestBuildCartesianTreeVacio(t *testing.T) {
+	tree := BuildCartesianTree([]int{})
+
+	assert.Nil(t, tree.GetRoot())
+	assert.Equal(t, 0, tree.Size())
+	assert.Empty(t, tree.InOrder())
+}
+
+// El arreglo [9, 3, 7, 1, 8, 12, 10] genera el árbol:
+//
+//	[1]
+//	├── [3]
+//	│   ├── [9]
+//	│   └── [7]
+//	└── [8]
+//	    └── [10]
+//	        └── [12]
+func TestBuildCartesianTree(t *testing.T) {
+	tree := BuildCartesianTree([]int{9, 3, 7, 1, 8, 12, 10})
+
+	root := tree.GetRoot()
+	assert.Equal(t, 1, root.GetData())
+	assert.Equal(t, 3, root.GetIndex())
+	assert.Equal(t, 3, root.GetLeft().GetData())
+	assert.Equal(t, 9, root.GetLeft().GetLeft().GetData())
+	assert.Equal(t, 7, root.GetLeft().GetRight().GetData())
+	assert.Equal(t, 8, root.GetRight().GetData())
+	assert.Nil(t, root.GetRight().GetLeft())
+	assert.Equal(t, 10, root.GetRight().GetRight().GetData())
+	assert.Equal(t, 12, root.GetRight().GetRight().GetLeft().GetData())
+
+	verificarPropiedades(t, root, 0, tree.Size()-1)
+}
+
+func TestCartesianTreeInOrderReconstruyeElArreglo(t *testing.T) {
+	arr := []int{5, 10, 40, 30, 28, 2, 2, 7, 15}
+	tree := BuildCartesianTree(arr)
+
+	assert.Equal(t, arr, tree.InOrder())
+	verificarPropiedades(t, tree.GetRoot(), 0, len(arr)-1)
+}
+
+func TestCartesianTreeArregloOrdenado(t *testing.T) {
+	arr := []string{"a", "b", "c", "d"}
+	tree := BuildCartesianTree(arr)
+
+	assert.Equal(t, "a", tree.GetRoot().GetData())
+	assert.Nil(t, tree.GetRoot().GetLeft())
+	assert.Equal(t, arr, tree.InOrder())
+}
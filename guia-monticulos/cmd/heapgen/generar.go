@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"text/template"
+)
+
+// Config describe el heap especializado a generar.
+type Config struct {
+	// Paquete es el nombre del paquete del archivo generado.
+	Paquete string
+	// Tipo es el tipo de los elementos; debe admitir los operadores < y >.
+	Tipo string
+	// Nombre es el nombre del tipo de heap generado, por ejemplo IntMinHeap.
+	Nombre string
+	// Orden es "min" o "max".
+	Orden string
+}
+
+// Operador retorna el operador con el que un hijo desplaza a su padre.
+func (c Config) Operador() string {
+	if c.Orden == "max" {
+		return ">"
+	}
+
+	return "<"
+}
+
+// Validar verifica que la configuración produzca código válido.
+func (c Config) Validar() error {
+	if !token.IsIdentifier(c.Paquete) {
+		return fmt.Errorf("paquete inválido: %q", c.Paquete)
+	}
+	if c.Tipo == "" {
+		return fmt.Errorf("falta el tipo de los elementos")
+	}
+	if !token.IsIdentifier(c.Nombre) {
+		return fmt.Errorf("nombre inválido: %q", c.Nombre)
+	}
+	if c.Orden != "min" && c.Orden != "max" {
+		return fmt.Errorf("orden inválido: %q (debe ser min o max)", c.Orden)
+	}
+
+	return nil
+}
+
+var plantilla = template.Must(template.New("heap").Parse(`// Code generated by heapgen. DO NOT EDIT.
+
+package {{.Paquete}}
+
+import "errors"
+
+// Err{{.Nombre}}Vacio indica que se intentó extraer de un {{.Nombre}} vacío.
+var Err{{.Nombre}}Vacio = errors.New("heap vacío")
+
+// {{.Nombre}} es un heap binario de {{if eq .Orden "max"}}máximos{{else}}mínimos{{end}} de {{.Tipo}} que compara con
+// el operador {{.Operador}} en lugar de una función de comparación.
+type {{.Nombre}} struct {
+	elements []{{.Tipo}}
+}
+
+// New{{.Nombre}} crea un {{.Nombre}} vacío.
+func New{{.Nombre}}() *{{.Nombre}} {
+	return &{{.Nombre}}{elements: make([]{{.Tipo}}, 0)}
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (m *{{.Nombre}}) Size() int {
+	return len(m.elements)
+}
+
+// Insert agrega un elemento al heap.
+func (m *{{.Nombre}}) Insert(element {{.Tipo}}) {
+	m.elements = append(m.elements, element)
+	i := len(m.elements) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !(m.elements[i] {{.Operador}} m.elements[parent]) {
+			break
+		}
+		m.elements[i], m.elements[parent] = m.elements[parent], m.elements[i]
+		i = parent
+	}
+}
+
+// Remove elimina y retorna el elemento en la cima del heap.
+func (m *{{.Nombre}}) Remove() ({{.Tipo}}, error) {
+	var element {{.Tipo}}
+	n := len(m.elements)
+	if n == 0 {
+		return element, Err{{.Nombre}}Vacio
+	}
+	element = m.elements[0]
+	m.elements[0] = m.elements[n-1]
+	m.elements = m.elements[:n-1]
+	n--
+
+	i := 0
+	for {
+		left := 2*i + 1
+		right := 2*i + 2
+		top := i
+		if left < n && m.elements[left] {{.Operador}} m.elements[top] {
+			top = left
+		}
+		if right < n && m.elements[right] {{.Operador}} m.elements[top] {
+			top = right
+		}
+		if top == i {
+			break
+		}
+		m.elements[i], m.elements[top] = m.elements[top], m.elements[i]
+		i = top
+	}
+
+	return element, nil
+}
+`))
+
+// Generar produce el código fuente, ya formateado, de un heap especializado.
+//
+// Uso:
+//
+//	src, err := Generar(Config{Paquete: "colas", Tipo: "int", Nombre: "IntMinHeap", Orden: "min"})
+//
+// Parámetros:
+//   - `c` configuración del heap a generar.
+//
+// Retorna:
+//   - el código fuente generado.
+//   - un error si la configuración es inválida o el código no compila
+//     sintácticamente (por ejemplo, si el tipo no es una expresión válida).
+func Generar(c Config) ([]byte, error) {
+	if err := c.Validar(); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := plantilla.Execute(&buf, c); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("código generado inválido para el tipo %q: %w", c.Tipo, err)
+	}
+
+	return src, nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerarCoincideConLosArchivosDelEjemplo(t *testing.T) {
+	casos := []struct {
+		config  Config
+		archivo string
+	}{
+		{Config{Paquete: "ejemplo", Tipo: "int", Nombre: "IntMinHeap", Orden: "min"}, "int_min_heap.go"},
+		{Config{Paquete: "ejemplo", Tipo: "float64", Nombre: "Float64MaxHeap", Orden: "max"}, "float64_max_heap.go"},
+	}
+	for _, c := range casos {
+		src, err := Generar(c.config)
+		assert.NoError(t, err)
+		esperado, err := os.ReadFile(filepath.Join("internal", "ejemplo", c.archivo))
+		assert.NoError(t, err)
+		assert.Equal(t, string(esperado), string(src), "regenerar con go generate ./...")
+	}
+}
+
+func TestGenerarConfigInvalida(t *testing.T) {
+	base := Config{Paquete: "p", Tipo: "int", Nombre: "H", Orden: "min"}
+
+	c := base
+	c.Orden = "medio"
+	_, err := Generar(c)
+	assert.EqualError(t, err, `orden inválido: "medio" (debe ser min o max)`)
+
+	c = base
+	c.Nombre = "mi heap"
+	_, err = Generar(c)
+	assert.EqualError(t, err, `nombre inválido: "mi heap"`)
+
+	c = base
+	c.Paquete = ""
+	_, err = Generar(c)
+	assert.EqualError(t, err, `paquete inválido: ""`)
+
+	c = base
+	c.Tipo = "[]["
+	_, err = Generar(c)
+	assert.ErrorContains(t, err, `código generado inválido para el tipo "[]["`)
+}
+
+func TestEjecutarEscribeArchivo(t *testing.T) {
+	salida := filepath.Join(t.TempDir(), "heap.go")
+
+	err := ejecutar(Config{Paquete: "p", Tipo: "string", Nombre: "StringMinHeap", Orden: "min"}, salida)
+	assert.NoError(t, err)
+	src, err := os.ReadFile(salida)
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), "func (m *StringMinHeap) Insert(element string)")
+}
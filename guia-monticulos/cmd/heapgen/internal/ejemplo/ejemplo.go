@@ -0,0 +1,7 @@
+// Package ejemplo contiene heaps generados con heapgen. Sirve de ejemplo de
+// uso con go:generate y permite verificar el código generado contra el
+// oráculo de heaptest.
+package ejemplo
+
+//go:generate go run untref/ayp2/monticulo/cmd/heapgen -tipo int -orden min -nombre IntMinHeap -o int_min_heap.go
+//go:generate go run untref/ayp2/monticulo/cmd/heapgen -tipo float64 -orden max -nombre Float64MaxHeap -o float64_max_heap.go
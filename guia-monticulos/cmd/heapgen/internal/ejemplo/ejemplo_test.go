@@ -0,0 +1,30 @@
+package ejemplo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"untref/ayp2/monticulo/heap/heaptest"
+)
+
+func TestIntMinHeapContraOraculo(t *testing.T) {
+	for seed := int64(1); seed <= 3; seed++ {
+		assert.NoError(t, heaptest.StressTest(NewIntMinHeap(), 2000, seed))
+	}
+}
+
+func TestFloat64MaxHeap(t *testing.T) {
+	h := NewFloat64MaxHeap()
+	for _, v := range []float64{2.5, -1, 7.25, 3} {
+		h.Insert(v)
+	}
+
+	for _, esperado := range []float64{7.25, 3, 2.5, -1} {
+		v, err := h.Remove()
+		assert.NoError(t, err)
+		assert.Equal(t, esperado, v)
+	}
+	_, err := h.Remove()
+	assert.ErrorIs(t, err, ErrFloat64MaxHeapVacio)
+}
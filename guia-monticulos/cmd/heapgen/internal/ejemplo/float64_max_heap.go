@@ -0,0 +1,71 @@
+// Code generated by heapgen. DO NOT EDIT.
+
+package ejemplo
+
+import "errors"
+
+// ErrFloat64MaxHeapVacio indica que se intentó extraer de un Float64MaxHeap vacío.
+var ErrFloat64MaxHeapVacio = errors.New("heap vacío")
+
+// Float64MaxHeap es un heap binario de máximos de float64 que compara con
+// el operador > en lugar de una función de comparación.
+type Float64MaxHeap struct {
+	elements []float64
+}
+
+// NewFloat64MaxHeap crea un Float64MaxHeap vacío.
+func NewFloat64MaxHeap() *Float64MaxHeap {
+	return &Float64MaxHeap{elements: make([]float64, 0)}
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (m *Float64MaxHeap) Size() int {
+	return len(m.elements)
+}
+
+// Insert agrega un elemento al heap.
+func (m *Float64MaxHeap) Insert(element float64) {
+	m.elements = append(m.elements, element)
+	i := len(m.elements) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !(m.elements[i] > m.elements[parent]) {
+			break
+		}
+		m.elements[i], m.elements[parent] = m.elements[parent], m.elements[i]
+		i = parent
+	}
+}
+
+// Remove elimina y retorna el elemento en la cima del heap.
+func (m *Float64MaxHeap) Remove() (float64, error) {
+	var element float64
+	n := len(m.elements)
+	if n == 0 {
+		return element, ErrFloat64MaxHeapVacio
+	}
+	element = m.elements[0]
+	m.elements[0] = m.elements[n-1]
+	m.elements = m.elements[:n-1]
+	n--
+
+	i := 0
+	for {
+		left := 2*i + 1
+		right := 2*i + 2
+		top := i
+		if left < n && m.elements[left] > m.elements[top] {
+			top = left
+		}
+		if right < n && m.elements[right] > m.elements[top] {
+			top = right
+		}
+		if top == i {
+			break
+		}
+		m.elements[i], m.elements[top] = m.elements[top], m.elements[i]
+		i = top
+	}
+
+	return element, nil
+}
@@ -0,0 +1,71 @@
+// Code generated by heapgen. DO NOT EDIT.
+
+package ejemplo
+
+import "errors"
+
+// ErrIntMinHeapVacio indica que se intentó extraer de un IntMinHeap vacío.
+var ErrIntMinHeapVacio = errors.New("heap vacío")
+
+// IntMinHeap es un heap binario de mínimos de int que compara con
+// el operador < en lugar de una función de comparación.
+type IntMinHeap struct {
+	elements []int
+}
+
+// NewIntMinHeap crea un IntMinHeap vacío.
+func NewIntMinHeap() *IntMinHeap {
+	return &IntMinHeap{elements: make([]int, 0)}
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (m *IntMinHeap) Size() int {
+	return len(m.elements)
+}
+
+// Insert agrega un elemento al heap.
+func (m *IntMinHeap) Insert(element int) {
+	m.elements = append(m.elements, element)
+	i := len(m.elements) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !(m.elements[i] < m.elements[parent]) {
+			break
+		}
+		m.elements[i], m.elements[parent] = m.elements[parent], m.elements[i]
+		i = parent
+	}
+}
+
+// Remove elimina y retorna el elemento en la cima del heap.
+func (m *IntMinHeap) Remove() (int, error) {
+	var element int
+	n := len(m.elements)
+	if n == 0 {
+		return element, ErrIntMinHeapVacio
+	}
+	element = m.elements[0]
+	m.elements[0] = m.elements[n-1]
+	m.elements = m.elements[:n-1]
+	n--
+
+	i := 0
+	for {
+		left := 2*i + 1
+		right := 2*i + 2
+		top := i
+		if left < n && m.elements[left] < m.elements[top] {
+			top = left
+		}
+		if right < n && m.elements[right] < m.elements[top] {
+			top = right
+		}
+		if top == i {
+			break
+		}
+		m.elements[i], m.elements[top] = m.elements[top], m.elements[i]
+		i = top
+	}
+
+	return element, nil
+}
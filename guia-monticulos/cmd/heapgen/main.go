@@ -0,0 +1,50 @@
+// Heapgen genera heaps binarios especializados para un tipo y un orden,
+// comparando directamente con < o > en lugar de llamar a una función de
+// comparación. El archivo generado no depende de este módulo, por lo que se
+// puede copiar a cualquier proyecto.
+//
+// Uso:
+//
+//	heapgen -tipo int -orden min -nombre IntMinHeap -paquete colas -o int_min_heap.go
+//
+// o desde un archivo del paquete destino:
+//
+//	//go:generate go run untref/ayp2/monticulo/cmd/heapgen -tipo float64 -orden max -nombre Float64MaxHeap
+//
+// Si no se indica -paquete se usa el de la variable GOPACKAGE que define
+// go generate. Si no se indica -o el código se escribe en la salida estándar.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	c := Config{}
+	flag.StringVar(&c.Tipo, "tipo", "", "tipo de los elementos (debe admitir < y >)")
+	flag.StringVar(&c.Orden, "orden", "min", "orden del heap: min o max")
+	flag.StringVar(&c.Nombre, "nombre", "", "nombre del tipo generado")
+	flag.StringVar(&c.Paquete, "paquete", os.Getenv("GOPACKAGE"), "paquete del archivo generado")
+	salida := flag.String("o", "", "archivo de salida (por defecto la salida estándar)")
+	flag.Parse()
+
+	if err := ejecutar(c, *salida); err != nil {
+		fmt.Fprintln(os.Stderr, "heapgen:", err)
+		os.Exit(1)
+	}
+}
+
+func ejecutar(c Config, salida string) error {
+	src, err := Generar(c)
+	if err != nil {
+		return err
+	}
+	if salida == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+
+	return os.WriteFile(salida, src, 0o644)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// Extractor obtiene el timestamp de una línea de log.
+type Extractor struct {
+	patron *regexp.Regexp
+	layout string
+}
+
+// NewExtractor crea un extractor que busca el timestamp con una expresión
+// regular (el primer grupo si lo tiene, o toda la coincidencia) y lo
+// interpreta con un layout de time.Parse.
+//
+// Parámetros:
+//   - `patron` expresión regular que ubica el timestamp en la línea.
+//   - `layout` formato del timestamp, por ejemplo time.RFC3339.
+//
+// Retorna:
+//   - el extractor.
+//   - un error si la expresión regular es inválida.
+func NewExtractor(patron, layout string) (*Extractor, error) {
+	re, err := regexp.Compile(patron)
+	if err != nil {
+		return nil, fmt.Errorf("patrón inválido: %w", err)
+	}
+
+	return &Extractor{patron: re, layout: layout}, nil
+}
+
+// Tiempo retorna el timestamp de la línea, o false si no tiene uno válido.
+func (e *Extractor) Tiempo(linea string) (time.Time, bool) {
+	m := e.patron.FindStringSubmatch(linea)
+	if m == nil {
+		return time.Time{}, false
+	}
+	texto := m[0]
+	if len(m) > 1 {
+		texto = m[1]
+	}
+	t, err := time.Parse(e.layout, texto)
+
+	return t, err == nil
+}
+
+// entrada es un mensaje de log: una línea con timestamp y las líneas sin
+// timestamp que la siguen (por ejemplo, un stack trace).
+type entrada struct {
+	tiempo time.Time
+	texto  string
+}
+
+// lector recorre las entradas de un archivo de log. Implementa heap.Iterator.
+type lector struct {
+	nombre    string
+	scanner   *bufio.Scanner
+	extractor *Extractor
+	// línea ya leída que inicia la próxima entrada
+	siguiente *entrada
+	linea     int
+	err       error
+}
+
+func nuevoLector(nombre string, r io.Reader, extractor *Extractor) *lector {
+	l := &lector{nombre: nombre, scanner: bufio.NewScanner(r), extractor: extractor}
+	l.scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	l.leerInicio()
+
+	return l
+}
+
+// leerInicio busca la primera línea con timestamp. Las líneas anteriores no
+// se pueden ubicar en el tiempo y se informan como error.
+func (l *lector) leerInicio() {
+	if !l.scanner.Scan() {
+		l.err = l.scanner.Err()
+		return
+	}
+	l.linea++
+	t, ok := l.extractor.Tiempo(l.scanner.Text())
+	if !ok {
+		l.err = fmt.Errorf("%s:%d: la línea no tiene un timestamp válido", l.nombre, l.linea)
+		return
+	}
+	l.siguiente = &entrada{tiempo: t, texto: l.scanner.Text()}
+}
+
+func (l *lector) HasNext() bool {
+	return l.siguiente != nil || l.err != nil
+}
+
+func (l *lector) Next() (entrada, error) {
+	if l.siguiente == nil {
+		if l.err != nil {
+			return entrada{}, l.err
+		}
+		return entrada{}, io.EOF
+	}
+	actual := *l.siguiente
+	l.siguiente = nil
+	for l.scanner.Scan() {
+		l.linea++
+		linea := l.scanner.Text()
+		if t, ok := l.extractor.Tiempo(linea); ok {
+			if t.Before(actual.tiempo) {
+				l.err = fmt.Errorf("%s:%d: el archivo no está ordenado por tiempo", l.nombre, l.linea)
+				return actual, nil
+			}
+			l.siguiente = &entrada{tiempo: t, texto: linea}
+			return actual, nil
+		}
+		actual.texto += "\n" + linea
+	}
+	l.err = l.scanner.Err()
+
+	return actual, nil
+}
+
+// Fusionar escribe en `w` las entradas de todos los archivos ordenadas por
+// timestamp, leyéndolos en paralelo con un merge de k vías. A igual
+// timestamp se respeta el orden de los archivos.
+//
+// Parámetros:
+//   - `w` destino.
+//   - `nombres` nombres de los archivos, para los mensajes de error.
+//   - `archivos` contenidos, cada uno ordenado por tiempo.
+//   - `extractor` forma de obtener el timestamp de cada línea.
+//
+// Retorna:
+//   - la cantidad de entradas escritas.
+//   - un error si algún archivo no se puede leer, no está ordenado o empieza
+//     con una línea sin timestamp.
+func Fusionar(w io.Writer, nombres []string, archivos []io.Reader, extractor *Extractor) (int, error) {
+	fuentes := make([]heap.Iterator[entrada], len(archivos))
+	for i, r := range archivos {
+		fuentes[i] = nuevoLector(nombres[i], r, extractor)
+	}
+	it := heap.Merge(func(a, b entrada) int { return a.tiempo.Compare(b.tiempo) }, fuentes...)
+
+	salida := bufio.NewWriter(w)
+	escritas := 0
+	for it.HasNext() {
+		e, err := it.Next()
+		if err != nil {
+			salida.Flush()
+			return escritas, err
+		}
+		if _, err := fmt.Fprintln(salida, e.texto); err != nil {
+			return escritas, err
+		}
+		escritas++
+	}
+
+	return escritas, salida.Flush()
+}
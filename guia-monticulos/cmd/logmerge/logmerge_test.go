@@ -0,0 +1,84 @@
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fusionar(t *testing.T, extractor *Extractor, contenidos ...string) (string, error) {
+	t.Helper()
+	nombres := make([]string, len(contenidos))
+	archivos := make([]io.Reader, len(contenidos))
+	for i, c := range contenidos {
+		nombres[i] = string(rune('a'+i)) + ".log"
+		archivos[i] = strings.NewReader(c)
+	}
+	var sb strings.Builder
+	_, err := Fusionar(&sb, nombres, archivos, extractor)
+
+	return sb.String(), err
+}
+
+func extractorRFC3339(t *testing.T) *Extractor {
+	e, err := NewExtractor(`^\S+`, time.RFC3339)
+	assert.NoError(t, err)
+
+	return e
+}
+
+func TestFusionarPorTimestamp(t *testing.T) {
+	a := "2024-05-10T10:00:00Z api inicio\n2024-05-10T10:00:05Z api pedido\n"
+	b := "2024-05-10T10:00:01Z db conexión\n2024-05-10T10:00:05Z db consulta\n2024-05-10T10:00:09Z db cierre\n"
+
+	salida, err := fusionar(t, extractorRFC3339(t), a, b)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-05-10T10:00:00Z api inicio\n"+
+		"2024-05-10T10:00:01Z db conexión\n"+
+		"2024-05-10T10:00:05Z api pedido\n"+
+		"2024-05-10T10:00:05Z db consulta\n"+
+		"2024-05-10T10:00:09Z db cierre\n", salida)
+}
+
+func TestFusionarMantieneLineasDeContinuacion(t *testing.T) {
+	a := "2024-05-10T10:00:02Z panic: algo\n\tgoroutine 1\n\tmain.go:10\n"
+	b := "2024-05-10T10:00:01Z ok\n2024-05-10T10:00:03Z ok\n"
+
+	salida, err := fusionar(t, extractorRFC3339(t), a, b)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-05-10T10:00:01Z ok\n"+
+		"2024-05-10T10:00:02Z panic: algo\n\tgoroutine 1\n\tmain.go:10\n"+
+		"2024-05-10T10:00:03Z ok\n", salida)
+}
+
+func TestFusionarConFormatoConfigurable(t *testing.T) {
+	e, err := NewExtractor(`\[([^]]+)\]`, "02/Jan/2006:15:04:05 -0700")
+	assert.NoError(t, err)
+	a := `1.1.1.1 - - [10/Oct/2024:13:55:36 -0300] "GET /"` + "\n"
+	b := `2.2.2.2 - - [10/Oct/2024:16:55:35 +0000] "GET /a"` + "\n"
+
+	salida, err := fusionar(t, e, a, b)
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(salida, "2.2.2.2"), salida)
+}
+
+func TestFusionarErrores(t *testing.T) {
+	_, err := fusionar(t, extractorRFC3339(t), "sin fecha\n")
+	assert.EqualError(t, err, "fusionar: fuente 0: a.log:1: la línea no tiene un timestamp válido")
+
+	_, err = fusionar(t, extractorRFC3339(t), "2024-05-10T10:00:00Z x\n", "2024-05-10T10:00:02Z y\n2024-05-10T10:00:01Z z\n")
+	assert.EqualError(t, err, "fusionar: fuente 1: b.log:2: el archivo no está ordenado por tiempo")
+
+	_, err = NewExtractor("(", time.RFC3339)
+	assert.Error(t, err)
+
+	salida, err := fusionar(t, extractorRFC3339(t), "", "")
+	assert.NoError(t, err)
+	assert.Empty(t, salida)
+}
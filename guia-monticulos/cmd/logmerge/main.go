@@ -0,0 +1,58 @@
+// Logmerge fusiona archivos de log ordenados por tiempo en uno solo, también
+// ordenado, sin cargarlos en memoria: mantiene en un heap solo la próxima
+// entrada de cada archivo.
+//
+// Uso:
+//
+//	logmerge [-patron regexp] [-formato layout] archivo1.log archivo2.log ...
+//
+// El timestamp de cada línea se busca con -patron (por defecto, la primera
+// palabra) y se interpreta con -formato, un layout de time.Parse (por defecto
+// RFC 3339). Las líneas sin timestamp, como los stack traces, se mantienen
+// junto a la línea anterior.
+//
+// Ejemplo para logs de nginx ("[10/Oct/2024:13:55:36 -0300]"):
+//
+//	logmerge -patron '\[([^]]+)\]' -formato '02/Jan/2006:15:04:05 -0700' a.log b.log
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+func main() {
+	patron := flag.String("patron", `^\S+`, "expresión regular que ubica el timestamp (se usa el primer grupo si lo hay)")
+	formato := flag.String("formato", time.RFC3339, "layout de time.Parse del timestamp")
+	flag.Parse()
+
+	if err := ejecutar(*patron, *formato, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "logmerge:", err)
+		os.Exit(1)
+	}
+}
+
+func ejecutar(patron, formato string, rutas []string) error {
+	if len(rutas) == 0 {
+		return fmt.Errorf("no se indicaron archivos")
+	}
+	extractor, err := NewExtractor(patron, formato)
+	if err != nil {
+		return err
+	}
+	archivos := make([]io.Reader, len(rutas))
+	for i, ruta := range rutas {
+		f, err := os.Open(ruta)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		archivos[i] = f
+	}
+	_, err = Fusionar(os.Stdout, rutas, archivos, extractor)
+
+	return err
+}
@@ -0,0 +1,42 @@
+// Pathfind busca el camino más corto en un mapa ASCII con A* y lo dibuja.
+//
+// Uso:
+//
+//	pathfind testdata/laberinto.txt
+//
+// En el mapa '#' es pared, '~' pantano (cuesta 5 atravesarlo), 'S' el inicio
+// y 'G' la meta; el resto de los caracteres es terreno libre. Si no se indica
+// un archivo, el mapa se lee de la entrada estándar.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	var entrada io.Reader = os.Stdin
+	if len(os.Args) > 1 {
+		f, err := os.Open(os.Args[1])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "pathfind:", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		entrada = f
+	}
+
+	mapa, err := LeerMapa(entrada)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "pathfind:", err)
+		os.Exit(1)
+	}
+	camino, costo, expandidas, err := mapa.AStar()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "pathfind: %v (%d celdas exploradas)\n", err, expandidas)
+		os.Exit(1)
+	}
+	fmt.Print(mapa.Dibujar(camino))
+	fmt.Printf("costo: %d, pasos: %d, celdas exploradas: %d\n", costo, len(camino)-1, expandidas)
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// Celdas del mapa.
+const (
+	Pared   = '#'
+	Pantano = '~'
+	Inicio  = 'S'
+	Meta    = 'G'
+	Camino  = '*'
+)
+
+// costoPantano es lo que cuesta entrar a una celda de pantano; el resto de
+// las celdas transitables cuesta 1.
+const costoPantano = 5
+
+// ErrSinCamino indica que la meta no es alcanzable desde el inicio.
+var ErrSinCamino = errors.New("no hay camino")
+
+// Punto es una celda del mapa.
+type Punto struct {
+	Fila, Col int
+}
+
+// Mapa es una grilla leída de un archivo de texto.
+type Mapa struct {
+	celdas       [][]rune
+	inicio, meta Punto
+}
+
+// LeerMapa interpreta un mapa ASCII: '#' es pared, '~' pantano, 'S' el
+// inicio, 'G' la meta y cualquier otro carácter es terreno libre. Las filas
+// pueden tener distinto largo.
+//
+// Retorna:
+//   - el mapa.
+//   - un error si falta el inicio o la meta, o si alguno está repetido.
+func LeerMapa(r io.Reader) (*Mapa, error) {
+	m := &Mapa{}
+	encontrados := map[rune]int{}
+	scanner := bufio.NewScanner(r)
+	for fila := 0; scanner.Scan(); fila++ {
+		linea := []rune(strings.TrimRight(scanner.Text(), "\r"))
+		for col, c := range linea {
+			switch c {
+			case Inicio:
+				m.inicio = Punto{fila, col}
+				encontrados[c]++
+			case Meta:
+				m.meta = Punto{fila, col}
+				encontrados[c]++
+			}
+		}
+		m.celdas = append(m.celdas, linea)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for _, c := range []rune{Inicio, Meta} {
+		if encontrados[c] != 1 {
+			return nil, fmt.Errorf("el mapa debe tener exactamente una celda %q y tiene %d", c, encontrados[c])
+		}
+	}
+
+	return m, nil
+}
+
+func (m *Mapa) transitable(p Punto) bool {
+	return p.Fila >= 0 && p.Fila < len(m.celdas) &&
+		p.Col >= 0 && p.Col < len(m.celdas[p.Fila]) &&
+		m.celdas[p.Fila][p.Col] != Pared
+}
+
+func (m *Mapa) costo(p Punto) int {
+	if m.celdas[p.Fila][p.Col] == Pantano {
+		return costoPantano
+	}
+
+	return 1
+}
+
+func distancia(a, b Punto) int {
+	return abs(a.Fila-b.Fila) + abs(a.Col-b.Col)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
+
+// estimacion es la prioridad de una celda en la frontera de A*.
+type estimacion struct {
+	// g es el costo conocido desde el inicio y h la heurística hasta la meta
+	g, h int
+}
+
+// AStar busca el camino de menor costo entre el inicio y la meta moviéndose
+// en las cuatro direcciones. La frontera es un heap indexado de mínimos por
+// g + h, y cuando se encuentra un camino más corto a una celda que ya está en
+// la frontera se actualiza su prioridad (decrease-key). La heurística es la
+// distancia Manhattan, que nunca sobreestima porque el costo mínimo de una
+// celda es 1, así que el camino encontrado es óptimo.
+//
+// Retorna:
+//   - las celdas del camino, del inicio a la meta.
+//   - su costo.
+//   - la cantidad de celdas expandidas.
+//   - ErrSinCamino si la meta no es alcanzable.
+func (m *Mapa) AStar() ([]Punto, int, int, error) {
+	frontera := heap.NewHeapIndexado[Punto](func(a, b estimacion) int {
+		if fa, fb := a.g+a.h, b.g+b.h; fa != fb {
+			return fa - fb
+		}
+		// a igual estimación, primero la más cercana a la meta
+		return a.h - b.h
+	})
+	_ = frontera.Insert(m.inicio, estimacion{g: 0, h: distancia(m.inicio, m.meta)})
+	anterior := map[Punto]Punto{}
+	cerrados := map[Punto]bool{}
+
+	for frontera.Size() > 0 {
+		actual, e, _ := frontera.Remove()
+		if actual == m.meta {
+			return m.reconstruir(anterior), e.g, len(cerrados), nil
+		}
+		cerrados[actual] = true
+
+		for _, d := range []Punto{{-1, 0}, {0, 1}, {1, 0}, {0, -1}} {
+			vecino := Punto{actual.Fila + d.Fila, actual.Col + d.Col}
+			if !m.transitable(vecino) || cerrados[vecino] {
+				continue
+			}
+			g := e.g + m.costo(vecino)
+			nueva := estimacion{g: g, h: distancia(vecino, m.meta)}
+			if previa, ok := frontera.Get(vecino); !ok {
+				_ = frontera.Insert(vecino, nueva)
+			} else if g < previa.g {
+				_ = frontera.Update(vecino, nueva)
+			} else {
+				continue
+			}
+			anterior[vecino] = actual
+		}
+	}
+
+	return nil, 0, len(cerrados), ErrSinCamino
+}
+
+func (m *Mapa) reconstruir(anterior map[Punto]Punto) []Punto {
+	camino := []Punto{m.meta}
+	for p := m.meta; p != m.inicio; {
+		p = anterior[p]
+		camino = append(camino, p)
+	}
+	for i, j := 0, len(camino)-1; i < j; i, j = i+1, j-1 {
+		camino[i], camino[j] = camino[j], camino[i]
+	}
+
+	return camino
+}
+
+// Dibujar retorna el mapa con el camino marcado con '*', sin tapar el
+// inicio ni la meta.
+func (m *Mapa) Dibujar(camino []Punto) string {
+	celdas := make([][]rune, len(m.celdas))
+	for i, fila := range m.celdas {
+		celdas[i] = append([]rune(nil), fila...)
+	}
+	for _, p := range camino {
+		if p != m.inicio && p != m.meta {
+			celdas[p.Fila][p.Col] = Camino
+		}
+	}
+	var sb strings.Builder
+	for _, fila := range celdas {
+		sb.WriteString(string(fila))
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
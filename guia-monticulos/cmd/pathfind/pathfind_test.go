@@ -0,0 +1,83 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func leer(t *testing.T, texto string) *Mapa {
+	t.Helper()
+	m, err := LeerMapa(strings.NewReader(texto))
+	assert.NoError(t, err)
+
+	return m
+}
+
+func TestLaberintoCoincideConElDibujoEsperado(t *testing.T) {
+	f, err := os.Open("testdata/laberinto.txt")
+	assert.NoError(t, err)
+	defer f.Close()
+	esperado, err := os.ReadFile("testdata/laberinto.golden")
+	assert.NoError(t, err)
+
+	m, err := LeerMapa(f)
+	assert.NoError(t, err)
+	camino, costo, _, err := m.AStar()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 53, costo)
+	assert.Equal(t, string(esperado), m.Dibujar(camino))
+}
+
+func TestAStarEsquivaElPantanoSiConviene(t *testing.T) {
+	m := leer(t, ""+
+		"#######\n"+
+		"#.....#\n"+
+		"#S~~~G#\n"+
+		"#######\n")
+
+	camino, costo, _, err := m.AStar()
+
+	assert.NoError(t, err)
+	// rodear cuesta 6; cruzar el pantano costaría 5*3+1
+	assert.Equal(t, 6, costo)
+	assert.Equal(t, ""+
+		"#######\n"+
+		"#*****#\n"+
+		"#S~~~G#\n"+
+		"#######\n", m.Dibujar(camino))
+}
+
+func TestAStarCaminoContiguo(t *testing.T) {
+	m := leer(t, "S...\n.##.\n...G\n")
+
+	camino, costo, _, err := m.AStar()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, costo)
+	assert.Equal(t, Punto{0, 0}, camino[0])
+	assert.Equal(t, Punto{2, 3}, camino[len(camino)-1])
+	for i := 1; i < len(camino); i++ {
+		assert.Equal(t, 1, distancia(camino[i-1], camino[i]))
+	}
+}
+
+func TestAStarSinCamino(t *testing.T) {
+	m := leer(t, "S.#..\n..#.G\n")
+
+	_, _, expandidas, err := m.AStar()
+
+	assert.ErrorIs(t, err, ErrSinCamino)
+	assert.Equal(t, 4, expandidas)
+}
+
+func TestLeerMapaInvalido(t *testing.T) {
+	_, err := LeerMapa(strings.NewReader("...\n..G\n"))
+	assert.ErrorContains(t, err, "'S'")
+
+	_, err = LeerMapa(strings.NewReader("S.G\nG..\n"))
+	assert.ErrorContains(t, err, "'G' y tiene 2")
+}
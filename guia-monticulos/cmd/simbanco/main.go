@@ -0,0 +1,55 @@
+// Simbanco ejecuta la simulación de la fila de un banco y muestra los tiempos
+// de espera.
+//
+// Uso:
+//
+//	simbanco -tasa 0.8 -cajas 2 -atencion exponencial -media 2 -duracion 480 -semilla 1
+//
+// La distribución de atención puede ser exponencial (con -media), uniforme
+// (entre -min y -max) o constante (igual a -media).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"untref/ayp2/monticulo/heap/simbanco"
+)
+
+func main() {
+	c := simbanco.Config{}
+	flag.Float64Var(&c.TasaArribos, "tasa", 1, "clientes que llegan por unidad de tiempo")
+	flag.IntVar(&c.Cajas, "cajas", 1, "cantidad de cajas")
+	flag.Float64Var(&c.Duracion, "duracion", 480, "tiempo que el banco recibe clientes")
+	flag.Int64Var(&c.Semilla, "semilla", 1, "semilla del generador aleatorio")
+	distribucion := flag.String("atencion", "exponencial", "distribución de atención: exponencial, uniforme o constante")
+	media := flag.Float64("media", 1, "media de la atención (exponencial o constante)")
+	minimo := flag.Float64("min", 0, "mínimo de la atención uniforme")
+	maximo := flag.Float64("max", 2, "máximo de la atención uniforme")
+	flag.Parse()
+
+	switch *distribucion {
+	case "exponencial":
+		c.Atencion = simbanco.Exponencial{Media: *media}
+	case "uniforme":
+		c.Atencion = simbanco.Uniforme{Min: *minimo, Max: *maximo}
+	case "constante":
+		c.Atencion = simbanco.Constante{Valor: *media}
+	default:
+		fmt.Fprintf(os.Stderr, "simbanco: distribución desconocida: %q\n", *distribucion)
+		os.Exit(2)
+	}
+
+	res, err := simbanco.Simular(c)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "simbanco:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("clientes atendidos:  %d\n", res.Clientes)
+	fmt.Printf("espera promedio:     %.2f\n", res.EsperaPromedio)
+	fmt.Printf("espera máxima:       %.2f\n", res.EsperaMaxima)
+	fmt.Printf("largo máximo fila:   %d\n", res.LargoMaximoFila)
+	fmt.Printf("ocupación de cajas:  %.1f%%\n", res.Ocupacion*100)
+	fmt.Printf("fin de la atención:  %.2f\n", res.Fin)
+}
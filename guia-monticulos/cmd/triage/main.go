@@ -0,0 +1,57 @@
+// Triage muestra el orden de atención de una guardia con y sin envejecimiento
+// de prioridades, para ver cómo el envejecimiento evita que los pacientes
+// leves esperen indefinidamente cuando siguen llegando casos más graves.
+//
+// Uso:
+//
+//	triage -duracion 15 -envejecimiento 0.1
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"untref/ayp2/monticulo/heap/triage"
+)
+
+var pacientes = []triage.Paciente{
+	{Nombre: "Ana", Gravedad: 1, Llegada: 0},
+	{Nombre: "Bruno", Gravedad: 3, Llegada: 2},
+	{Nombre: "Carla", Gravedad: 2, Llegada: 5},
+	{Nombre: "Diego", Gravedad: 4, Llegada: 12},
+	{Nombre: "Elena", Gravedad: 3, Llegada: 20},
+	{Nombre: "Fede", Gravedad: 5, Llegada: 31},
+	{Nombre: "Gabi", Gravedad: 3, Llegada: 40},
+	{Nombre: "Hugo", Gravedad: 4, Llegada: 48},
+	{Nombre: "Inés", Gravedad: 3, Llegada: 60},
+	{Nombre: "Juan", Gravedad: 4, Llegada: 75},
+}
+
+func main() {
+	duracion := flag.Int("duracion", 15, "minutos de cada atención")
+	envejecimiento := flag.Float64("envejecimiento", 0.1, "puntos de gravedad por minuto de espera")
+	flag.Parse()
+
+	mostrar("Sin envejecimiento", triage.Simular(pacientes, *duracion, 0))
+	fmt.Println()
+	mostrar(fmt.Sprintf("Con envejecimiento de %g por minuto", *envejecimiento),
+		triage.Simular(pacientes, *duracion, *envejecimiento))
+}
+
+func mostrar(titulo string, atenciones []triage.Atencion) {
+	fmt.Println(titulo)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "turno\tpaciente\tgravedad\tllegada\tinicio\tespera")
+	total, maxima := 0, 0
+	for i, a := range atenciones {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%d\t%d\n",
+			i+1, a.Paciente.Nombre, a.Paciente.Gravedad, a.Paciente.Llegada, a.Inicio, a.Espera())
+		total += a.Espera()
+		maxima = max(maxima, a.Espera())
+	}
+	w.Flush()
+	fmt.Printf("espera promedio: %.1f minutos, espera máxima: %d minutos\n",
+		float64(total)/float64(len(atenciones)), maxima)
+}
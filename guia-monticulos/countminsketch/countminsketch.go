@@ -0,0 +1,120 @@
+// Package countminsketch provee un Count-Min Sketch para estimar frecuencias
+// en streams de datos usando memoria sublineal.
+package countminsketch
+
+import (
+	"errors"
+	"hash/fnv"
+	"math"
+)
+
+// CountMinSketch estima la frecuencia de cada elemento de un stream.
+//
+// La estimación nunca es menor que la frecuencia real y, con probabilidad
+// 1 - δ, la sobreestima en a lo sumo ε veces la cantidad total de elementos
+// agregados.
+type CountMinSketch struct {
+	// counters[i][j] es el contador j de la fila i
+	counters [][]uint64
+	width    uint64
+	total    uint64
+}
+
+// NewCountMinSketch crea un sketch vacío con error relativo ε y probabilidad
+// de falla δ. Usa ⌈e/ε⌉ contadores por fila y ⌈ln(1/δ)⌉ filas.
+//
+// Uso:
+//
+//	cms, _ := countminsketch.NewCountMinSketch(0.001, 0.01)
+//
+// Parámetros:
+//   - `epsilon` error relativo admitido, en (0, 1).
+//   - `delta` probabilidad de exceder el error, en (0, 1).
+//
+// Retorna:
+//   - un puntero a un sketch vacío.
+//   - un error si alguno de los parámetros está fuera de rango.
+func NewCountMinSketch(epsilon, delta float64) (*CountMinSketch, error) {
+	if epsilon <= 0 || epsilon >= 1 || delta <= 0 || delta >= 1 {
+		return nil, errors.New("parámetros fuera de rango")
+	}
+	width := uint64(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+
+	counters := make([][]uint64, depth)
+	for i := range counters {
+		counters[i] = make([]uint64, width)
+	}
+
+	return &CountMinSketch{counters: counters, width: width}, nil
+}
+
+// Width retorna la cantidad de contadores por fila.
+func (s *CountMinSketch) Width() int {
+	return int(s.width)
+}
+
+// Depth retorna la cantidad de filas.
+func (s *CountMinSketch) Depth() int {
+	return len(s.counters)
+}
+
+// Total retorna la suma de todas las apariciones agregadas.
+func (s *CountMinSketch) Total() uint64 {
+	return s.total
+}
+
+// Add registra `count` apariciones del elemento. O(d)
+//
+// Uso:
+//
+//	cms.Add("golang", 1)
+//
+// Parámetros:
+//   - `item` elemento observado.
+//   - `count` cantidad de apariciones.
+func (s *CountMinSketch) Add(item string, count uint64) {
+	h1, h2 := hashes(item)
+	for i := range s.counters {
+		s.counters[i][s.index(h1, h2, i)] += count
+	}
+	s.total += count
+}
+
+// EstimateCount retorna la frecuencia estimada del elemento. O(d)
+//
+// Uso:
+//
+//	n := cms.EstimateCount("golang")
+//
+// Parámetros:
+//   - `item` elemento a consultar.
+//
+// Retorna:
+//   - una cota superior de la cantidad de apariciones del elemento.
+func (s *CountMinSketch) EstimateCount(item string) uint64 {
+	h1, h2 := hashes(item)
+	estimate := uint64(math.MaxUint64)
+	for i := range s.counters {
+		if c := s.counters[i][s.index(h1, h2, i)]; c < estimate {
+			estimate = c
+		}
+	}
+
+	return estimate
+}
+
+// index calcula la columna de la fila i usando doble hashing.
+func (s *CountMinSketch) index(h1, h2 uint64, i int) uint64 {
+	return (h1 + uint64(i)*h2) % s.width
+}
+
+func hashes(item string) (uint64, uint64) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(item))
+	h1 := h.Sum64()
+	_, _ = h.Write([]byte{0xff})
+	h2 := h.Sum64() | 1
+
+	return h1, h2
+}
@@ -0,0 +1,71 @@
+package countminsketch
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCountMinSketchParametrosInvalidos(t *testing.T) {
+	_, err := NewCountMinSketch(0, 0.01)
+	assert.EqualError(t, err, "parámetros fuera de rango")
+
+	_, err = NewCountMinSketch(0.01, 1)
+	assert.Error(t, err)
+}
+
+func TestNewCountMinSketchDimensiones(t *testing.T) {
+	cms, err := NewCountMinSketch(0.01, 0.01)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 272, cms.Width())
+	assert.Equal(t, 5, cms.Depth())
+}
+
+func TestCountMinSketchNuncaSubestima(t *testing.T) {
+	cms, _ := NewCountMinSketch(0.01, 0.001)
+	reales := make(map[string]uint64)
+	for i := 0; i < 5000; i++ {
+		item := fmt.Sprintf("item-%d", (i*i)%331)
+		cms.Add(item, 1)
+		reales[item]++
+	}
+
+	assert.Equal(t, uint64(5000), cms.Total())
+	for item, real := range reales {
+		estimado := cms.EstimateCount(item)
+		assert.GreaterOrEqual(t, estimado, real)
+		assert.LessOrEqual(t, estimado, real+uint64(0.01*5000))
+	}
+	assert.Equal(t, uint64(0), cms.EstimateCount("ausente"))
+}
+
+func TestNewTopKInvalido(t *testing.T) {
+	_, err := NewTopK(0, 0.01, 0.01)
+	assert.EqualError(t, err, "k debe ser positivo")
+}
+
+func TestTopK(t *testing.T) {
+	top, _ := NewTopK(3, 0.001, 0.001)
+	frecuencias := map[string]int{"a": 50, "b": 40, "c": 30, "d": 5, "e": 3, "f": 1}
+	// se intercalan los elementos para que los frecuentes no lleguen primero
+	for ronda := 0; ronda < 50; ronda++ {
+		for _, item := range []string{"f", "e", "d", "c", "b", "a"} {
+			if ronda < frecuencias[item] {
+				top.Add(item)
+			}
+		}
+	}
+
+	assert.Equal(t, []Frecuencia{{"a", 50}, {"b", 40}, {"c", 30}}, top.Top())
+}
+
+func TestTopKConMenosElementosQueK(t *testing.T) {
+	top, _ := NewTopK(5, 0.01, 0.01)
+	top.Add("x")
+	top.Add("y")
+	top.Add("x")
+
+	assert.Equal(t, []Frecuencia{{"x", 2}, {"y", 1}}, top.Top())
+}
@@ -0,0 +1,154 @@
+package countminsketch
+
+import (
+	"errors"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// Frecuencia asocia un elemento con su cantidad estimada de apariciones.
+type Frecuencia struct {
+	Item  string
+	Count uint64
+}
+
+// TopK estima los k elementos más frecuentes de un stream.
+//
+// Las frecuencias se estiman con un Count-Min Sketch y los candidatos se
+// mantienen en un heap de mínimo por frecuencia, de modo que el candidato
+// menos frecuente es el primero en ser desplazado.
+type TopK struct {
+	sketch *CountMinSketch
+	k      int
+	// frecuencia vigente de cada candidato
+	candidatos map[string]uint64
+	// heap de mínimo con las frecuencias de los candidatos. Puede contener
+	// entradas desactualizadas, que se descartan al llegar a la cima.
+	minimos *heap.Heap[Frecuencia]
+}
+
+// NewTopK crea un estimador de los k elementos más frecuentes.
+//
+// Uso:
+//
+//	top, _ := countminsketch.NewTopK(10, 0.001, 0.01)
+//
+// Parámetros:
+//   - `k` cantidad de elementos a retener.
+//   - `epsilon` y `delta` parámetros del Count-Min Sketch subyacente.
+//
+// Retorna:
+//   - un puntero a un estimador vacío.
+//   - un error si k no es positivo o los parámetros del sketch son inválidos.
+func NewTopK(k int, epsilon, delta float64) (*TopK, error) {
+	if k < 1 {
+		return nil, errors.New("k debe ser positivo")
+	}
+	sketch, err := NewCountMinSketch(epsilon, delta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TopK{
+		sketch:     sketch,
+		k:          k,
+		candidatos: make(map[string]uint64),
+		minimos:    heap.NewGenericHeap[Frecuencia](porMenorFrecuencia),
+	}, nil
+}
+
+// Add registra una aparición del elemento y actualiza los candidatos.
+// O(d + log k) amortizado.
+//
+// Uso:
+//
+//	top.Add("golang")
+//
+// Parámetros:
+//   - `item` elemento observado.
+func (t *TopK) Add(item string) {
+	t.sketch.Add(item, 1)
+	estimate := t.sketch.EstimateCount(item)
+
+	if _, ok := t.candidatos[item]; !ok && len(t.candidatos) == t.k {
+		min, _ := t.menorCandidato()
+		if estimate <= min.Count {
+			return
+		}
+		delete(t.candidatos, min.Item)
+	}
+	t.candidatos[item] = estimate
+	t.minimos.Insert(Frecuencia{Item: item, Count: estimate})
+
+	// cada candidato tiene a lo sumo una entrada vigente
+	if t.minimos.Size() > 2*t.k {
+		t.compactar()
+	}
+}
+
+// Top retorna los candidatos ordenados de mayor a menor frecuencia estimada.
+//
+// Uso:
+//
+//	for _, f := range top.Top() {
+//		fmt.Println(f.Item, f.Count)
+//	}
+//
+// Retorna:
+//   - a lo sumo k elementos con su frecuencia estimada.
+func (t *TopK) Top() []Frecuencia {
+	maximos := heap.NewGenericHeap[Frecuencia](func(a, b Frecuencia) int {
+		return porMenorFrecuencia(b, a)
+	})
+	for item, count := range t.candidatos {
+		maximos.Insert(Frecuencia{Item: item, Count: count})
+	}
+
+	result := make([]Frecuencia, 0, maximos.Size())
+	for maximos.Size() > 0 {
+		f, _ := maximos.Remove()
+		result = append(result, f)
+	}
+
+	return result
+}
+
+// menorCandidato retorna el candidato vigente de menor frecuencia, descartando
+// las entradas desactualizadas de la cima del heap.
+func (t *TopK) menorCandidato() (Frecuencia, error) {
+	for t.minimos.Size() > 0 {
+		f, _ := t.minimos.Remove()
+		if count, ok := t.candidatos[f.Item]; ok && count == f.Count {
+			t.minimos.Insert(f)
+
+			return f, nil
+		}
+	}
+
+	return Frecuencia{}, errors.New("sin candidatos")
+}
+
+// compactar reconstruye el heap sólo con las entradas vigentes.
+func (t *TopK) compactar() {
+	t.minimos = heap.NewGenericHeap[Frecuencia](porMenorFrecuencia)
+	for item, count := range t.candidatos {
+		t.minimos.Insert(Frecuencia{Item: item, Count: count})
+	}
+}
+
+// porMenorFrecuencia ordena por frecuencia y desempata por elemento para que
+// el resultado sea determinístico.
+func porMenorFrecuencia(a, b Frecuencia) int {
+	switch {
+	case a.Count < b.Count:
+		return -1
+	case a.Count > b.Count:
+		return 1
+	case a.Item < b.Item:
+		return 1
+	case a.Item > b.Item:
+		return -1
+	}
+
+	return 0
+}
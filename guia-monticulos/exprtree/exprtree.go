@@ -0,0 +1,341 @@
+// Package exprtree provee árboles de expresiones aritméticas: su construcción
+// a partir de una expresión infija, su evaluación y su impresión en notación
+// prefija, infija y posfija.
+package exprtree
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ExprNode es un nodo de un árbol de expresiones. Las hojas son números y los
+// nodos internos operadores binarios.
+type ExprNode struct {
+	operator rune    // operador, 0 en las hojas
+	value    float64 // valor numérico, sólo en las hojas
+	left     *ExprNode
+	right    *ExprNode
+}
+
+// IsLeaf indica si el nodo es un número.
+func (n *ExprNode) IsLeaf() bool {
+	return n.operator == 0
+}
+
+// GetLeft retorna el operando izquierdo.
+func (n *ExprNode) GetLeft() *ExprNode {
+	return n.left
+}
+
+// GetRight retorna el operando derecho.
+func (n *ExprNode) GetRight() *ExprNode {
+	return n.right
+}
+
+func (n *ExprNode) token() string {
+	if n.IsLeaf() {
+		return strconv.FormatFloat(n.value, 'g', -1, 64)
+	}
+
+	return string(n.operator)
+}
+
+// ExprTree es un árbol de expresiones aritméticas.
+type ExprTree struct {
+	root *ExprNode
+}
+
+// precedence retorna la precedencia de cada operador binario.
+var precedence = map[rune]int{'+': 1, '-': 1, '*': 2, '/': 2, '^': 3}
+
+// Parse construye el árbol de una expresión infija usando el algoritmo
+// shunting-yard. Admite números, los operadores + - * / ^ y paréntesis. La
+// potencia es asociativa a derecha y el resto a izquierda.
+//
+// Uso:
+//
+//	tree, err := exprtree.Parse("3 + 4 * (2 - 1)")
+//
+// Parámetros:
+//   - `expression` expresión en notación infija.
+//
+// Retorna:
+//   - un puntero al árbol de la expresión.
+//   - un error si la expresión está mal formada.
+func Parse(expression string) (*ExprTree, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	operands := &pila[*ExprNode]{}
+	operators := &pila[rune]{}
+	expectOperand := true
+
+	for _, tok := range tokens {
+		switch {
+		case tok == "(":
+			if !expectOperand {
+				return nil, errors.New("expresión inválida")
+			}
+			operators.push('(')
+		case tok == ")":
+			if expectOperand {
+				return nil, errors.New("expresión inválida")
+			}
+			if err := reduceUntilParen(operands, operators); err != nil {
+				return nil, err
+			}
+		case isOperator(tok):
+			if expectOperand {
+				return nil, errors.New("expresión inválida")
+			}
+			op := rune(tok[0])
+			for {
+				top, ok := operators.top()
+				if !ok || top == '(' || !shouldReduce(top, op) {
+					break
+				}
+				operators.pop()
+				if err := reduce(operands, top); err != nil {
+					return nil, err
+				}
+			}
+			operators.push(op)
+			expectOperand = true
+
+			continue
+		default:
+			if !expectOperand {
+				return nil, errors.New("expresión inválida")
+			}
+			value, err := strconv.ParseFloat(tok, 64)
+			if err != nil {
+				return nil, errors.New("número inválido: " + tok)
+			}
+			operands.push(&ExprNode{value: value})
+		}
+		expectOperand = tok == "("
+	}
+
+	if expectOperand {
+		return nil, errors.New("expresión inválida")
+	}
+	for len(*operators) > 0 {
+		op, _ := operators.pop()
+		if op == '(' {
+			return nil, errors.New("paréntesis desbalanceados")
+		}
+		if err := reduce(operands, op); err != nil {
+			return nil, err
+		}
+	}
+
+	root, _ := operands.pop()
+
+	return &ExprTree{root: root}, nil
+}
+
+// shouldReduce indica si el operador del tope de la pila debe aplicarse antes
+// de apilar el nuevo operador.
+func shouldReduce(top, op rune) bool {
+	if op == '^' {
+		return precedence[top] > precedence[op]
+	}
+
+	return precedence[top] >= precedence[op]
+}
+
+func reduceUntilParen(operands *pila[*ExprNode], operators *pila[rune]) error {
+	for {
+		op, ok := operators.pop()
+		if !ok {
+			return errors.New("paréntesis desbalanceados")
+		}
+		if op == '(' {
+			return nil
+		}
+		if err := reduce(operands, op); err != nil {
+			return err
+		}
+	}
+}
+
+// reduce combina los dos operandos del tope de la pila con el operador.
+func reduce(operands *pila[*ExprNode], op rune) error {
+	right, ok := operands.pop()
+	if !ok {
+		return errors.New("expresión inválida")
+	}
+	left, ok := operands.pop()
+	if !ok {
+		return errors.New("expresión inválida")
+	}
+	operands.push(&ExprNode{operator: op, left: left, right: right})
+
+	return nil
+}
+
+// pila es una pila sobre un slice, con el tope al final.
+type pila[T any] []T
+
+func (p *pila[T]) push(v T) {
+	*p = append(*p, v)
+}
+
+// pop quita y retorna el tope, o false si la pila está vacía.
+func (p *pila[T]) pop() (T, bool) {
+	v, ok := p.top()
+	if ok {
+		*p = (*p)[:len(*p)-1]
+	}
+
+	return v, ok
+}
+
+// top retorna el tope sin quitarlo, o false si la pila está vacía.
+func (p *pila[T]) top() (T, bool) {
+	if len(*p) == 0 {
+		var cero T
+
+		return cero, false
+	}
+
+	return (*p)[len(*p)-1], true
+}
+
+func isOperator(tok string) bool {
+	_, ok := precedence[rune(tok[0])]
+
+	return len(tok) == 1 && ok
+}
+
+func tokenize(expression string) ([]string, error) {
+	tokens := make([]string, 0)
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		case r == '(' || r == ')' || precedence[r] > 0:
+			tokens = append(tokens, string(r))
+			i++
+		default:
+			return nil, errors.New("carácter inválido: " + string(r))
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("expresión vacía")
+	}
+
+	return tokens, nil
+}
+
+// GetRoot retorna la raíz del árbol.
+func (t *ExprTree) GetRoot() *ExprNode {
+	return t.root
+}
+
+// Evaluate calcula el valor de la expresión.
+//
+// Uso:
+//
+//	v, err := tree.Evaluate()
+//
+// Retorna:
+//   - el valor de la expresión.
+//   - un error si hay una división por cero.
+func (t *ExprTree) Evaluate() (float64, error) {
+	return t.root.evaluate()
+}
+
+func (n *ExprNode) evaluate() (float64, error) {
+	if n.IsLeaf() {
+		return n.value, nil
+	}
+	left, err := n.left.evaluate()
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.evaluate()
+	if err != nil {
+		return 0, err
+	}
+
+	switch n.operator {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	case '/':
+		if right == 0 {
+			return 0, errors.New("división por cero")
+		}
+
+		return left / right, nil
+	}
+
+	return math.Pow(left, right), nil
+}
+
+// PreFix retorna la expresión en notación prefija, con los elementos
+// separados por espacios.
+func (t *ExprTree) PreFix() string {
+	tokens := make([]string, 0)
+	t.root.preOrder(&tokens)
+
+	return strings.Join(tokens, " ")
+}
+
+// PostFix retorna la expresión en notación posfija, con los elementos
+// separados por espacios.
+func (t *ExprTree) PostFix() string {
+	tokens := make([]string, 0)
+	t.root.postOrder(&tokens)
+
+	return strings.Join(tokens, " ")
+}
+
+// InFix retorna la expresión en notación infija, con cada operación entre
+// paréntesis para que no dependa de la precedencia de los operadores.
+func (t *ExprTree) InFix() string {
+	return t.root.inFix()
+}
+
+func (n *ExprNode) preOrder(tokens *[]string) {
+	if n == nil {
+		return
+	}
+	*tokens = append(*tokens, n.token())
+	n.left.preOrder(tokens)
+	n.right.preOrder(tokens)
+}
+
+func (n *ExprNode) postOrder(tokens *[]string) {
+	if n == nil {
+		return
+	}
+	n.left.postOrder(tokens)
+	n.right.postOrder(tokens)
+	*tokens = append(*tokens, n.token())
+}
+
+func (n *ExprNode) inFix() string {
+	if n.IsLeaf() {
+		return n.token()
+	}
+
+	return "(" + n.left.inFix() + " " + n.token() + " " + n.right.inFix() + ")"
+}
@@ -0,0 +1,76 @@
+package exprtree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseYRecorridos(t *testing.T) {
+	tree, err := Parse("3 + 4 * (2 - 1)")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "+ 3 * 4 - 2 1", tree.PreFix())
+	assert.Equal(t, "3 4 2 1 - * +", tree.PostFix())
+	assert.Equal(t, "(3 + (4 * (2 - 1)))", tree.InFix())
+	assert.False(t, tree.GetRoot().IsLeaf())
+	assert.True(t, tree.GetRoot().GetLeft().IsLeaf())
+}
+
+func TestParseAsociatividad(t *testing.T) {
+	resta, _ := Parse("10 - 4 - 3")
+	assert.Equal(t, "((10 - 4) - 3)", resta.InFix())
+
+	potencia, _ := Parse("2 ^ 3 ^ 2")
+	assert.Equal(t, "(2 ^ (3 ^ 2))", potencia.InFix())
+}
+
+func TestEvaluate(t *testing.T) {
+	casos := map[string]float64{
+		"42":                42,
+		"1.5 * 4":           6,
+		"3 + 4 * (2 - 1)":   7,
+		"(1 + 2) * (3 + 4)": 21,
+		"2 ^ 3 ^ 2":         512,
+		"100 / 8 / 5":       2.5,
+		"((((7))))":         7,
+		"18 / (3 - 1) ^ 2":  4.5,
+	}
+	for expresion, esperado := range casos {
+		tree, err := Parse(expresion)
+		assert.NoError(t, err, expresion)
+		v, err := tree.Evaluate()
+		assert.NoError(t, err, expresion)
+		assert.Equal(t, esperado, v, expresion)
+	}
+}
+
+func TestEvaluateDivisionPorCero(t *testing.T) {
+	tree, _ := Parse("1 / (2 - 2)")
+
+	_, err := tree.Evaluate()
+	assert.EqualError(t, err, "división por cero")
+}
+
+func TestParseInvalido(t *testing.T) {
+	_, err := Parse("")
+	assert.EqualError(t, err, "expresión vacía")
+
+	_, err = Parse("(1 + 2")
+	assert.EqualError(t, err, "paréntesis desbalanceados")
+
+	_, err = Parse("1 + 2)")
+	assert.EqualError(t, err, "paréntesis desbalanceados")
+
+	_, err = Parse("1 + * 2")
+	assert.EqualError(t, err, "expresión inválida")
+
+	_, err = Parse("1 2")
+	assert.EqualError(t, err, "expresión inválida")
+
+	_, err = Parse("1 + x")
+	assert.EqualError(t, err, "carácter inválido: x")
+
+	_, err = Parse("1..2 + 3")
+	assert.EqualError(t, err, "número inválido: 1..2")
+}
@@ -1,11 +1,8 @@
 module untref/ayp2/monticulo
 
-go 1.20
+go 1.23
 
-require (
-	github.com/stretchr/testify v1.9.0
-	github.com/untref-ayp2/data-structures v0.11.4
-)
+require github.com/stretchr/testify v1.9.0
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
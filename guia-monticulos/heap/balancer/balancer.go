@@ -0,0 +1,169 @@
+// Package balancer reparte trabajo entre servidores eligiendo siempre el
+// menos ocupado. Las cargas se guardan en un heap de mínimos indexado por
+// servidor, así que tomar y liberar un servidor cuesta O(log n).
+package balancer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+var (
+	// ErrSinServidores indica que no hay servidores registrados.
+	ErrSinServidores = errors.New("no hay servidores")
+	// ErrServidorDesconocido indica un servidor que no está registrado.
+	ErrServidorDesconocido = errors.New("servidor desconocido")
+	// ErrServidorDuplicado indica que el servidor ya está registrado.
+	ErrServidorDuplicado = errors.New("servidor duplicado")
+	// ErrServidorLibre indica que se liberó un servidor sin carga.
+	ErrServidorLibre = errors.New("el servidor no tiene carga")
+)
+
+// servidor es la entrada del heap: el nombre desempata a igual carga para
+// que la elección sea determinística.
+type servidor struct {
+	nombre string
+	carga  int
+}
+
+func compararServidores(a, b servidor) int {
+	if a.carga != b.carga {
+		return a.carga - b.carga
+	}
+	if a.nombre < b.nombre {
+		return -1
+	}
+	if a.nombre > b.nombre {
+		return 1
+	}
+
+	return 0
+}
+
+// LeastLoadedBalancer asigna cada pedido al servidor con menos pedidos en
+// curso. Es seguro usarlo desde varias goroutines.
+type LeastLoadedBalancer struct {
+	mu         sync.Mutex
+	servidores *heap.HeapIndexado[string, servidor]
+}
+
+// NewLeastLoadedBalancer crea un balanceador con los servidores dados, todos
+// sin carga.
+//
+// Uso:
+//
+//	b, err := balancer.NewLeastLoadedBalancer("api-1", "api-2", "api-3")
+//
+// Retorna:
+//   - un puntero al balanceador.
+//   - un error que envuelve a ErrServidorDuplicado si un nombre se repite.
+func NewLeastLoadedBalancer(servidores ...string) (*LeastLoadedBalancer, error) {
+	b := &LeastLoadedBalancer{servidores: heap.NewHeapIndexado[string](compararServidores)}
+	for _, s := range servidores {
+		if err := b.Add(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// Add registra un servidor sin carga.
+//
+// Retorna:
+//   - un error que envuelve a ErrServidorDuplicado si ya estaba registrado.
+func (b *LeastLoadedBalancer) Add(nombre string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.servidores.Insert(nombre, servidor{nombre: nombre}) != nil {
+		return fmt.Errorf("%w: %s", ErrServidorDuplicado, nombre)
+	}
+
+	return nil
+}
+
+// Remove da de baja un servidor, aunque tenga pedidos en curso.
+//
+// Retorna:
+//   - la carga que tenía el servidor.
+//   - un error que envuelve a ErrServidorDesconocido si no estaba registrado.
+func (b *LeastLoadedBalancer) Remove(nombre string) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, err := b.servidores.Delete(nombre)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrServidorDesconocido, nombre)
+	}
+
+	return s.carga, nil
+}
+
+// Acquire elige el servidor menos ocupado y le suma un pedido
+// (increase-key). A igual carga elige el de menor nombre.
+//
+// Uso:
+//
+//	s, err := b.Acquire()
+//	defer b.Release(s)
+//
+// Retorna:
+//   - el nombre del servidor elegido.
+//   - ErrSinServidores si no hay servidores registrados.
+func (b *LeastLoadedBalancer) Acquire() (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	nombre, s, err := b.servidores.Peek()
+	if err != nil {
+		return "", ErrSinServidores
+	}
+	s.carga++
+	_ = b.servidores.Update(nombre, s)
+
+	return nombre, nil
+}
+
+// Release indica que terminó un pedido del servidor y le resta uno a su
+// carga (decrease-key).
+//
+// Retorna:
+//   - un error que envuelve a ErrServidorDesconocido si el servidor no está
+//     registrado, o a ErrServidorLibre si no tenía pedidos en curso.
+func (b *LeastLoadedBalancer) Release(nombre string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.servidores.Get(nombre)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrServidorDesconocido, nombre)
+	}
+	if s.carga == 0 {
+		return fmt.Errorf("%w: %s", ErrServidorLibre, nombre)
+	}
+	s.carga--
+	_ = b.servidores.Update(nombre, s)
+
+	return nil
+}
+
+// Carga retorna la cantidad de pedidos en curso de un servidor.
+//
+// Retorna:
+//   - la carga.
+//   - false si el servidor no está registrado.
+func (b *LeastLoadedBalancer) Carga(nombre string) (int, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.servidores.Get(nombre)
+
+	return s.carga, ok
+}
+
+// Len retorna la cantidad de servidores registrados.
+func (b *LeastLoadedBalancer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.servidores.Size()
+}
@@ -0,0 +1,80 @@
+package balancer
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcquireEligeElMenosOcupado(t *testing.T) {
+	b, err := NewLeastLoadedBalancer("c", "a", "b")
+	assert.NoError(t, err)
+
+	var elegidos []string
+	for i := 0; i < 4; i++ {
+		s, err := b.Acquire()
+		assert.NoError(t, err)
+		elegidos = append(elegidos, s)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "a"}, elegidos)
+
+	assert.NoError(t, b.Release("c"))
+	s, _ := b.Acquire()
+	assert.Equal(t, "c", s)
+	carga, ok := b.Carga("a")
+	assert.True(t, ok)
+	assert.Equal(t, 2, carga)
+}
+
+func TestAddYRemove(t *testing.T) {
+	b, _ := NewLeastLoadedBalancer("a")
+	_, _ = b.Acquire()
+
+	assert.NoError(t, b.Add("b"))
+	assert.ErrorIs(t, b.Add("b"), ErrServidorDuplicado)
+	s, _ := b.Acquire()
+	assert.Equal(t, "b", s)
+
+	carga, err := b.Remove("a")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, carga)
+	assert.Equal(t, 1, b.Len())
+	_, err = b.Remove("a")
+	assert.ErrorIs(t, err, ErrServidorDesconocido)
+}
+
+func TestErrores(t *testing.T) {
+	_, err := NewLeastLoadedBalancer("a", "a")
+	assert.EqualError(t, err, "servidor duplicado: a")
+
+	b, _ := NewLeastLoadedBalancer()
+	_, err = b.Acquire()
+	assert.ErrorIs(t, err, ErrSinServidores)
+
+	assert.NoError(t, b.Add("a"))
+	assert.ErrorIs(t, b.Release("a"), ErrServidorLibre)
+	assert.ErrorIs(t, b.Release("x"), ErrServidorDesconocido)
+	_, ok := b.Carga("x")
+	assert.False(t, ok)
+}
+
+func TestUsoConcurrente(t *testing.T) {
+	b, _ := NewLeastLoadedBalancer("a", "b", "c", "d")
+	var wg sync.WaitGroup
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s, err := b.Acquire()
+			assert.NoError(t, err)
+			assert.NoError(t, b.Release(s))
+		}()
+	}
+	wg.Wait()
+
+	for _, s := range []string{"a", "b", "c", "d"} {
+		carga, _ := b.Carga(s)
+		assert.Zero(t, carga)
+	}
+}
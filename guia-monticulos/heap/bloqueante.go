@@ -0,0 +1,129 @@
+package heap
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ErrColaCerrada indica que se operó sobre una cola bloqueante cerrada.
+var ErrColaCerrada error = &errorLocalizado{es: "cola cerrada", en: "closed queue"}
+
+// ColaBloqueante es una cola de prioridad segura para usar desde varias
+// goroutines, en la que Take espera hasta que haya un elemento. Sirve para
+// armar productores y consumidores que procesan primero lo más prioritario.
+type ColaBloqueante[T any] struct {
+	mu       sync.Mutex
+	elements *Heap[T]
+	cerrada  bool
+	// se cierra y se reemplaza cada vez que cambia el estado de la cola, para
+	// despertar a los Take que esperan
+	aviso chan struct{}
+}
+
+// NewColaBloqueante crea una cola bloqueante vacía.
+//
+// Uso:
+//
+//	cola := heap.NewColaBloqueante(func(a, b Tarea) int { return b.Prioridad - a.Prioridad })
+//
+// Parámetros:
+//   - `comp` función de comparación, con la misma convención que NewGenericHeap.
+//
+// Retorna:
+//   - un puntero a la cola.
+func NewColaBloqueante[T any](comp func(a T, b T) int) *ColaBloqueante[T] {
+	return &ColaBloqueante[T]{elements: NewGenericHeap(comp), aviso: make(chan struct{})}
+}
+
+// avisar despierta a los Take que esperan. Debe llamarse con el mutex tomado.
+func (c *ColaBloqueante[T]) avisar() {
+	close(c.aviso)
+	c.aviso = make(chan struct{})
+}
+
+// Put agrega un elemento y despierta a quienes esperan en Take.
+//
+// Retorna:
+//   - un error que envuelve a ErrColaCerrada si la cola está cerrada.
+func (c *ColaBloqueante[T]) Put(element T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cerrada {
+		return fmt.Errorf(Localizar("poner: %w", "put: %w"), ErrColaCerrada)
+	}
+	c.elements.Insert(element)
+	c.avisar()
+
+	return nil
+}
+
+// Take retira el elemento más prioritario, esperando si la cola está vacía.
+//
+// Uso:
+//
+//	tarea, err := cola.Take(ctx)
+//
+// Parámetros:
+//   - `ctx` contexto para dejar de esperar.
+//
+// Retorna:
+//   - el elemento.
+//   - el error del contexto si se canceló antes de que hubiera un elemento,
+//     o un error que envuelve a ErrColaCerrada si la cola se cerró y ya no
+//     quedan elementos.
+func (c *ColaBloqueante[T]) Take(ctx context.Context) (T, error) {
+	for {
+		c.mu.Lock()
+		if c.elements.Size() > 0 {
+			element, err := c.elements.Remove()
+			c.mu.Unlock()
+
+			return element, err
+		}
+		cerrada, aviso := c.cerrada, c.aviso
+		c.mu.Unlock()
+
+		var cero T
+		if cerrada {
+			return cero, fmt.Errorf(Localizar("tomar: %w", "take: %w"), ErrColaCerrada)
+		}
+		select {
+		case <-aviso:
+		case <-ctx.Done():
+			return cero, ctx.Err()
+		}
+	}
+}
+
+// TryTake retira el elemento más prioritario sin esperar.
+//
+// Retorna:
+//   - el elemento.
+//   - false si la cola está vacía.
+func (c *ColaBloqueante[T]) TryTake() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, err := c.elements.Remove()
+
+	return element, err == nil
+}
+
+// Size retorna la cantidad de elementos en la cola.
+func (c *ColaBloqueante[T]) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.elements.Size()
+}
+
+// Close cierra la cola: Put falla y Take entrega los elementos que quedan y
+// después falla en lugar de esperar. Cerrar una cola cerrada no tiene efecto.
+func (c *ColaBloqueante[T]) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.cerrada {
+		c.cerrada = true
+		c.avisar()
+	}
+}
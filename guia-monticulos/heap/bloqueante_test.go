@@ -0,0 +1,107 @@
+package heap
+
+import (
+	"cmp"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColaBloqueanteEntregaPorPrioridad(t *testing.T) {
+	c := NewColaBloqueante(cmp.Compare[int])
+	for _, v := range []int{5, 1, 3} {
+		assert.NoError(t, c.Put(v))
+	}
+	assert.Equal(t, 3, c.Size())
+
+	for _, esperado := range []int{1, 3, 5} {
+		v, err := c.Take(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, esperado, v)
+	}
+	_, ok := c.TryTake()
+	assert.False(t, ok)
+}
+
+func TestColaBloqueanteTakeEspera(t *testing.T) {
+	c := NewColaBloqueante(cmp.Compare[int])
+	recibido := make(chan int)
+	go func() {
+		v, _ := c.Take(context.Background())
+		recibido <- v
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	assert.NoError(t, c.Put(7))
+	assert.Equal(t, 7, <-recibido)
+}
+
+func TestColaBloqueanteTakeCancelado(t *testing.T) {
+	c := NewColaBloqueante(cmp.Compare[int])
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Take(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestColaBloqueanteClose(t *testing.T) {
+	c := NewColaBloqueante(cmp.Compare[int])
+	assert.NoError(t, c.Put(1))
+	esperando := make(chan error)
+	otra := NewColaBloqueante(cmp.Compare[int])
+	go func() {
+		_, err := otra.Take(context.Background())
+		esperando <- err
+	}()
+
+	c.Close()
+	otra.Close()
+	c.Close()
+
+	assert.ErrorIs(t, <-esperando, ErrColaCerrada)
+	assert.ErrorIs(t, c.Put(2), ErrColaCerrada)
+	v, err := c.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+	_, err = c.Take(context.Background())
+	assert.EqualError(t, err, "tomar: cola cerrada")
+}
+
+func TestColaBloqueanteProductoresYConsumidores(t *testing.T) {
+	c := NewColaBloqueante(cmp.Compare[int])
+	var productores, consumidores sync.WaitGroup
+	var mu sync.Mutex
+	total := 0
+	for p := 0; p < 4; p++ {
+		productores.Add(1)
+		go func(p int) {
+			defer productores.Done()
+			for i := 0; i < 250; i++ {
+				assert.NoError(t, c.Put(p*1000+i))
+			}
+		}(p)
+	}
+	for i := 0; i < 4; i++ {
+		consumidores.Add(1)
+		go func() {
+			defer consumidores.Done()
+			for {
+				if _, err := c.Take(context.Background()); err != nil {
+					return
+				}
+				mu.Lock()
+				total++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	productores.Wait()
+	c.Close()
+	consumidores.Wait()
+	assert.Equal(t, 1000, total)
+}
@@ -0,0 +1,246 @@
+// Package broker implementa un broker de mensajes en memoria. Cada tópico es
+// una cola de prioridad bloqueante: los consumidores reciben primero los
+// mensajes de mayor prioridad y, a igual prioridad, los más antiguos. Los
+// mensajes entregados quedan pendientes hasta que se confirman con Ack o se
+// devuelven a la cola con Requeue.
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+var (
+	// ErrBrokerCerrado indica que se operó sobre un broker cerrado.
+	ErrBrokerCerrado = errors.New("broker cerrado")
+	// ErrMensajeDesconocido indica un ack o requeue de un mensaje que no está
+	// pendiente de confirmación.
+	ErrMensajeDesconocido = errors.New("mensaje desconocido")
+)
+
+// Mensaje es un mensaje publicado en un tópico.
+type Mensaje struct {
+	ID        uint64
+	Topico    string
+	Prioridad int
+	Cuerpo    []byte
+	// Intentos es la cantidad de veces que se entregó el mensaje.
+	Intentos int
+}
+
+// compararMensajes ordena primero por mayor prioridad y después por orden
+// de publicación, que es el orden de los ID.
+func compararMensajes(a, b *Mensaje) int {
+	if a.Prioridad != b.Prioridad {
+		return b.Prioridad - a.Prioridad
+	}
+	if a.ID < b.ID {
+		return -1
+	}
+	if a.ID > b.ID {
+		return 1
+	}
+
+	return 0
+}
+
+type topico struct {
+	cola *heap.ColaBloqueante[*Mensaje]
+	// mensajes entregados que esperan ack
+	pendientes map[uint64]*Mensaje
+	// mensajes que superaron la cantidad máxima de intentos
+	descartados []Mensaje
+}
+
+// Broker administra tópicos. Es seguro usarlo desde varias goroutines.
+type Broker struct {
+	mu          sync.Mutex
+	topicos     map[string]*topico
+	siguienteID uint64
+	maxIntentos int
+	cerrado     bool
+}
+
+// New crea un broker sin tópicos.
+//
+// Uso:
+//
+//	b := broker.New(3)
+//
+// Parámetros:
+//   - `maxIntentos` cantidad de entregas después de la cual un mensaje
+//     devuelto con Requeue se descarta en lugar de volver a la cola. Con 0
+//     los mensajes se reintentan indefinidamente.
+//
+// Retorna:
+//   - un puntero al broker.
+func New(maxIntentos int) *Broker {
+	return &Broker{topicos: make(map[string]*topico), maxIntentos: maxIntentos}
+}
+
+// topico retorna el tópico con ese nombre, creándolo si no existe. Debe
+// llamarse con el mutex tomado.
+func (b *Broker) topico(nombre string) *topico {
+	t, ok := b.topicos[nombre]
+	if !ok {
+		t = &topico{
+			cola:       heap.NewColaBloqueante(compararMensajes),
+			pendientes: make(map[uint64]*Mensaje),
+		}
+		b.topicos[nombre] = t
+	}
+
+	return t
+}
+
+// Publish publica un mensaje en un tópico, que se crea si no existe.
+//
+// Uso:
+//
+//	id, err := b.Publish("pedidos", 10, []byte("urgente"))
+//
+// Retorna:
+//   - el ID del mensaje.
+//   - ErrBrokerCerrado si el broker está cerrado.
+func (b *Broker) Publish(nombre string, prioridad int, cuerpo []byte) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.cerrado {
+		return 0, ErrBrokerCerrado
+	}
+	b.siguienteID++
+	m := &Mensaje{ID: b.siguienteID, Topico: nombre, Prioridad: prioridad, Cuerpo: cuerpo}
+	if err := b.topico(nombre).cola.Put(m); err != nil {
+		return 0, ErrBrokerCerrado
+	}
+
+	return m.ID, nil
+}
+
+// Receive espera el próximo mensaje de un tópico. El mensaje queda pendiente
+// hasta que se llame a Ack o a Requeue con su ID.
+//
+// Uso:
+//
+//	m, err := b.Receive(ctx, "pedidos")
+//
+// Retorna:
+//   - una copia del mensaje.
+//   - el error del contexto si se canceló, o ErrBrokerCerrado si el broker
+//     se cerró y el tópico no tiene más mensajes.
+func (b *Broker) Receive(ctx context.Context, nombre string) (Mensaje, error) {
+	b.mu.Lock()
+	if b.cerrado && b.topicos[nombre] == nil {
+		b.mu.Unlock()
+		return Mensaje{}, ErrBrokerCerrado
+	}
+	t := b.topico(nombre)
+	b.mu.Unlock()
+
+	m, err := t.cola.Take(ctx)
+	if errors.Is(err, heap.ErrColaCerrada) {
+		return Mensaje{}, ErrBrokerCerrado
+	}
+	if err != nil {
+		return Mensaje{}, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	m.Intentos++
+	t.pendientes[m.ID] = m
+
+	return *m, nil
+}
+
+// pendiente quita un mensaje de los pendientes de confirmación. Debe
+// llamarse con el mutex tomado.
+func (b *Broker) pendiente(nombre string, id uint64) (*topico, *Mensaje, error) {
+	t, ok := b.topicos[nombre]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s/%d", ErrMensajeDesconocido, nombre, id)
+	}
+	m, ok := t.pendientes[id]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s/%d", ErrMensajeDesconocido, nombre, id)
+	}
+	delete(t.pendientes, id)
+
+	return t, m, nil
+}
+
+// Ack confirma que un mensaje se procesó y lo descarta.
+//
+// Retorna:
+//   - un error que envuelve a ErrMensajeDesconocido si el mensaje no estaba
+//     pendiente de confirmación.
+func (b *Broker) Ack(nombre string, id uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, _, err := b.pendiente(nombre, id)
+
+	return err
+}
+
+// Requeue devuelve un mensaje a su tópico para que se vuelva a entregar,
+// conservando su prioridad y su antigüedad. Si ya se entregó la cantidad
+// máxima de veces, o si el broker está cerrado, se descarta (ver
+// Descartados).
+//
+// Retorna:
+//   - un error que envuelve a ErrMensajeDesconocido si el mensaje no estaba
+//     pendiente de confirmación.
+func (b *Broker) Requeue(nombre string, id uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t, m, err := b.pendiente(nombre, id)
+	if err != nil {
+		return err
+	}
+	if b.cerrado || (b.maxIntentos > 0 && m.Intentos >= b.maxIntentos) {
+		t.descartados = append(t.descartados, *m)
+		return nil
+	}
+
+	return t.cola.Put(m)
+}
+
+// Descartados retorna los mensajes de un tópico que agotaron sus intentos.
+func (b *Broker) Descartados(nombre string) []Mensaje {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t, ok := b.topicos[nombre]
+	if !ok {
+		return nil
+	}
+
+	return append([]Mensaje(nil), t.descartados...)
+}
+
+// Len retorna la cantidad de mensajes de un tópico esperando ser entregados
+// y la de mensajes entregados sin confirmar.
+func (b *Broker) Len(nombre string) (enCola, pendientes int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t, ok := b.topicos[nombre]
+	if !ok {
+		return 0, 0
+	}
+
+	return t.cola.Size(), len(t.pendientes)
+}
+
+// Close cierra el broker: no se aceptan más publicaciones y los consumidores
+// reciben los mensajes que quedan y después ErrBrokerCerrado.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.cerrado = true
+	for _, t := range b.topicos {
+		t.cola.Close()
+	}
+}
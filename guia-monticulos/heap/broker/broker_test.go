@@ -0,0 +1,140 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func recibir(t *testing.T, b *Broker, topico string) Mensaje {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	m, err := b.Receive(ctx, topico)
+	assert.NoError(t, err)
+
+	return m
+}
+
+func TestEntregaPorPrioridadYAntiguedad(t *testing.T) {
+	b := New(0)
+	for _, p := range []struct {
+		prioridad int
+		cuerpo    string
+	}{{1, "baja"}, {5, "alta-1"}, {3, "media"}, {5, "alta-2"}} {
+		_, err := b.Publish("pedidos", p.prioridad, []byte(p.cuerpo))
+		assert.NoError(t, err)
+	}
+	_, err := b.Publish("otro", 9, []byte("otro tópico"))
+	assert.NoError(t, err)
+
+	for _, esperado := range []string{"alta-1", "alta-2", "media", "baja"} {
+		m := recibir(t, b, "pedidos")
+		assert.Equal(t, esperado, string(m.Cuerpo))
+		assert.Equal(t, "pedidos", m.Topico)
+		assert.NoError(t, b.Ack("pedidos", m.ID))
+	}
+	enCola, pendientes := b.Len("pedidos")
+	assert.Zero(t, enCola)
+	assert.Zero(t, pendientes)
+}
+
+func TestAckYRequeue(t *testing.T) {
+	b := New(0)
+	id1, _ := b.Publish("t", 1, []byte("a"))
+	_, _ = b.Publish("t", 1, []byte("b"))
+
+	m := recibir(t, b, "t")
+	assert.Equal(t, id1, m.ID)
+	_, pendientes := b.Len("t")
+	assert.Equal(t, 1, pendientes)
+
+	assert.NoError(t, b.Requeue("t", m.ID))
+	m = recibir(t, b, "t")
+	assert.Equal(t, id1, m.ID, "el mensaje devuelto conserva su antigüedad")
+	assert.Equal(t, 2, m.Intentos)
+
+	assert.NoError(t, b.Ack("t", m.ID))
+	assert.ErrorIs(t, b.Ack("t", m.ID), ErrMensajeDesconocido)
+	assert.ErrorIs(t, b.Requeue("nada", 1), ErrMensajeDesconocido)
+}
+
+func TestMaxIntentos(t *testing.T) {
+	b := New(2)
+	id, _ := b.Publish("t", 1, []byte("veneno"))
+
+	assert.NoError(t, b.Requeue("t", recibir(t, b, "t").ID))
+	assert.NoError(t, b.Requeue("t", recibir(t, b, "t").ID))
+
+	enCola, _ := b.Len("t")
+	assert.Zero(t, enCola)
+	descartados := b.Descartados("t")
+	assert.Len(t, descartados, 1)
+	assert.Equal(t, id, descartados[0].ID)
+	assert.Nil(t, b.Descartados("nada"))
+}
+
+func TestReceiveCancelado(t *testing.T) {
+	b := New(0)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := b.Receive(ctx, "vacio")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClose(t *testing.T) {
+	b := New(0)
+	_, _ = b.Publish("t", 1, []byte("último"))
+	b.Close()
+
+	_, err := b.Publish("t", 1, nil)
+	assert.ErrorIs(t, err, ErrBrokerCerrado)
+	assert.Equal(t, "último", string(recibir(t, b, "t").Cuerpo))
+	_, err = b.Receive(context.Background(), "t")
+	assert.ErrorIs(t, err, ErrBrokerCerrado)
+	_, err = b.Receive(context.Background(), "nuevo")
+	assert.ErrorIs(t, err, ErrBrokerCerrado)
+}
+
+func TestSubscribeConConsumidoresConcurrentes(t *testing.T) {
+	b := New(0)
+	const total = 200
+	for i := 0; i < total; i++ {
+		_, err := b.Publish("trabajos", i%5, []byte{byte(i)})
+		assert.NoError(t, err)
+	}
+
+	var mu sync.Mutex
+	procesados := map[uint64]int{}
+	fallados := map[uint64]bool{}
+	s := b.Subscribe(context.Background(), "trabajos", 4, func(m Mensaje) error {
+		mu.Lock()
+		defer mu.Unlock()
+		// los mensajes de ID par fallan la primera vez que se entregan
+		if m.ID%2 == 0 && !fallados[m.ID] {
+			fallados[m.ID] = true
+			return errors.New("reintentar")
+		}
+		procesados[m.ID]++
+		return nil
+	})
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(procesados) == total
+	}, 2*time.Second, 5*time.Millisecond)
+	b.Close()
+	s.Wait()
+
+	for id, veces := range procesados {
+		assert.Equal(t, 1, veces, "mensaje %d", id)
+	}
+	_, pendientes := b.Len("trabajos")
+	assert.Zero(t, pendientes)
+}
@@ -0,0 +1,62 @@
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// Suscripcion es un grupo de consumidores de un tópico, creado con Subscribe.
+type Suscripcion struct {
+	wg sync.WaitGroup
+}
+
+// Wait espera a que terminen todos los consumidores de la suscripción, lo
+// que ocurre cuando se cancela su contexto o se cierra el broker.
+func (s *Suscripcion) Wait() {
+	s.wg.Wait()
+}
+
+// Subscribe lanza `consumidores` goroutines que reciben mensajes del tópico
+// y los pasan a `handler`. Si el handler retorna nil el mensaje se confirma;
+// si retorna un error el mensaje vuelve a la cola.
+//
+// Uso:
+//
+//	s := b.Subscribe(ctx, "pedidos", 4, func(m broker.Mensaje) error {
+//		return procesar(m.Cuerpo)
+//	})
+//	...
+//	cancel()
+//	s.Wait()
+//
+// Parámetros:
+//   - `ctx` contexto que detiene a los consumidores al cancelarse.
+//   - `nombre` tópico.
+//   - `consumidores` cantidad de goroutines; al menos una.
+//   - `handler` función que procesa cada mensaje. Puede ser llamada desde
+//     varias goroutines a la vez.
+//
+// Retorna:
+//   - la suscripción, para esperar a que terminen los consumidores.
+func (b *Broker) Subscribe(ctx context.Context, nombre string, consumidores int, handler func(Mensaje) error) *Suscripcion {
+	s := &Suscripcion{}
+	for i := 0; i < max(consumidores, 1); i++ {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			for {
+				m, err := b.Receive(ctx, nombre)
+				if err != nil {
+					return
+				}
+				if handler(m) == nil {
+					_ = b.Ack(nombre, m.ID)
+				} else {
+					_ = b.Requeue(nombre, m.ID)
+				}
+			}
+		}()
+	}
+
+	return s
+}
@@ -0,0 +1,79 @@
+package heap
+
+import "context"
+
+// NewHeapFromChannel crea un heap con todos los elementos que se reciban por
+// el canal hasta que se cierre. Los elementos se acumulan y el heap se arma
+// al final con heapify, en O(n) en lugar de O(n log n).
+//
+// Uso:
+//
+//	h := heap.NewHeapFromChannel(resultados, cmp.Compare[int])
+//
+// Parámetros:
+//   - `ch` canal a consumir. La función se bloquea hasta que se cierre.
+//   - `comp` función de comparación, con la misma convención que NewGenericHeap.
+//
+// Retorna:
+//   - un puntero al heap. Si `ch` es nil el heap queda vacío.
+func NewHeapFromChannel[T any](ch <-chan T, comp func(a T, b T) int) *Heap[T] {
+	h := NewGenericHeap(comp)
+	if ch == nil {
+		return h
+	}
+	for v := range ch {
+		h.elements = append(h.elements, v)
+	}
+	h.heapify()
+
+	return h
+}
+
+// NewColaBloqueanteDesdeCanal crea una cola bloqueante que se llena en
+// background con los elementos que llegan por el canal, para que un
+// consumidor pueda ir retirando el más prioritario de lo recibido hasta el
+// momento sin esperar a que el productor termine. Cuando el canal se cierra
+// (o se cancela el contexto) la cola se cierra: Take entrega lo que quede y
+// después retorna ErrColaCerrada.
+//
+// Uso:
+//
+//	cola := heap.NewColaBloqueanteDesdeCanal(ctx, trabajos, porPrioridad)
+//	for {
+//		t, err := cola.Take(ctx)
+//		if err != nil {
+//			break
+//		}
+//		...
+//	}
+//
+// Parámetros:
+//   - `ctx` contexto para dejar de consumir el canal.
+//   - `ch` canal a consumir.
+//   - `comp` función de comparación, con la misma convención que NewGenericHeap.
+//
+// Retorna:
+//   - un puntero a la cola.
+func NewColaBloqueanteDesdeCanal[T any](ctx context.Context, ch <-chan T, comp func(a T, b T) int) *ColaBloqueante[T] {
+	c := NewColaBloqueante(comp)
+	go func() {
+		defer c.Close()
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					return
+				}
+				// solo falla si alguien cerró la cola, y entonces no tiene
+				// sentido seguir consumiendo
+				if c.Put(v) != nil {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return c
+}
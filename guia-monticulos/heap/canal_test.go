@@ -0,0 +1,78 @@
+package heap
+
+import (
+	"cmp"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHeapFromChannel(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for _, v := range []int{8, 3, 9, 1, 4, 7, 2} {
+			ch <- v
+		}
+	}()
+
+	h := NewHeapFromChannel(ch, cmp.Compare[int])
+
+	assert.Equal(t, 7, h.Size())
+	assert.Equal(t, []int{1, 2, 3, 4, 7, 8, 9}, extraerTodos(h))
+}
+
+func TestNewHeapFromChannelNil(t *testing.T) {
+	h := NewHeapFromChannel(nil, cmp.Compare[int])
+
+	assert.Equal(t, 0, h.Size())
+	h.Insert(1)
+	assert.Equal(t, 1, h.Size())
+}
+
+func TestHeapifyCumpleLaPropiedad(t *testing.T) {
+	h := NewMaxHeap[int]()
+	h.elements = []int{3, 9, 1, 12, 5, 5, 0, 7, 15, 2}
+
+	h.heapify()
+
+	assert.True(t, h.IsValid())
+}
+
+func TestColaBloqueanteDesdeCanalSigueConsumiendo(t *testing.T) {
+	ch := make(chan int)
+	c := NewColaBloqueanteDesdeCanal(context.Background(), ch, cmp.Compare[int])
+
+	ch <- 5
+	v, err := c.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 5, v)
+
+	// lo que llega después también queda disponible, por prioridad
+	ch <- 9
+	ch <- 2
+	close(ch)
+	var resto []int
+	for {
+		v, err := c.Take(context.Background())
+		if err != nil {
+			assert.ErrorIs(t, err, ErrColaCerrada)
+			break
+		}
+		resto = append(resto, v)
+	}
+	// el orden depende de cuánto haya consumido el productor al llegar Take,
+	// pero una vez cerrada la cola no se pierde nada
+	assert.ElementsMatch(t, []int{2, 9}, resto)
+}
+
+func TestColaBloqueanteDesdeCanalSeCierraAlCancelar(t *testing.T) {
+	ctx, cancelar := context.WithCancel(context.Background())
+	c := NewColaBloqueanteDesdeCanal(ctx, make(chan int), cmp.Compare[int])
+
+	cancelar()
+	_, err := c.Take(context.Background())
+
+	assert.ErrorIs(t, err, ErrColaCerrada)
+}
@@ -0,0 +1,186 @@
+package heap
+
+import "fmt"
+
+// ErrEstadoAjeno indica que se intentó restaurar un estado tomado de otro heap.
+var ErrEstadoAjeno error = &errorLocalizado{es: "el estado pertenece a otro heap", en: "the state belongs to another heap"}
+
+// Estado es una copia del contenido de un heap en un momento dado, tomada con
+// Snapshot, que se puede volver a aplicar con Restore.
+type Estado[T any] struct {
+	origen   *Heap[T]
+	elements []T
+}
+
+// Size retorna la cantidad de elementos que tenía el heap al tomar el estado.
+func (e Estado[T]) Size() int {
+	return len(e.elements)
+}
+
+// copiarElementos duplica un slice de elementos. Si se pasa una función de
+// copia se aplica a cada elemento, para que los elementos que son punteros o
+// slices no queden compartidos. `copiar` es el parámetro opcional de la
+// operación `op`: si trae más de una función se produce un panic, en lugar
+// de ignorar las demás en silencio.
+func copiarElementos[T any](op string, elements []T, copiar []func(T) T) []T {
+	if len(copiar) > 1 {
+		panic(fmt.Sprintf(Localizar("heap: %s admite una sola función de copia, se pasaron %d", "heap: %s accepts a single copy function, got %d"), op, len(copiar)))
+	}
+	copia := make([]T, len(elements))
+	if len(copiar) == 0 || copiar[0] == nil {
+		copy(copia, elements)
+		return copia
+	}
+	for i, e := range elements {
+		copia[i] = copiar[0](e)
+	}
+
+	return copia
+}
+
+// Clone crea un heap independiente con los mismos elementos y la misma
+// función de comparación.
+//
+// Uso:
+//
+//	copia := heap.Clone()
+//	profunda := heap.Clone(func(p *Paciente) *Paciente { c := *p; return &c })
+//
+// Parámetros:
+//   - `copiar` (opcional) función que duplica cada elemento. Sin ella los
+//     elementos se copian por asignación, por lo que punteros y slices quedan
+//     compartidos entre el original y la copia. Pasar más de una produce un
+//     panic.
+//
+// Retorna:
+//   - un puntero al heap copiado, o nil si el heap es nil.
+func (m *Heap[T]) Clone(copiar ...func(T) T) *Heap[T] {
+	if m == nil {
+		return nil
+	}
+	m.guardia.entrar("Clone")
+	defer m.guardia.salir()
+
+	return &Heap[T]{compare: m.compare, elements: copiarElementos("clone", m.elements, copiar), tipo: m.tipo}
+}
+
+// Snapshot guarda el contenido actual del heap para poder restaurarlo luego
+// con Restore.
+//
+// Uso:
+//
+//	estado := heap.Snapshot()
+//	heap.Insert(7)
+//	_ = heap.Restore(estado) // el heap vuelve a no tener el 7
+//
+// Parámetros:
+//   - `copiar` (opcional) función que duplica cada elemento, para que
+//     modificar un elemento del heap no altere el estado guardado.
+//
+// Retorna:
+//   - el estado del heap.
+func (m *Heap[T]) Snapshot(copiar ...func(T) T) Estado[T] {
+	if m == nil {
+		return Estado[T]{}
+	}
+	m.guardia.entrar("Snapshot")
+	defer m.guardia.salir()
+
+	return Estado[T]{origen: m, elements: copiarElementos("snapshot", m.elements, copiar)}
+}
+
+// Restore reemplaza el contenido del heap por el de un estado tomado con
+// Snapshot sobre este mismo heap. El estado se puede restaurar varias veces.
+//
+// Uso:
+//
+//	err := heap.Restore(estado)
+//
+// Parámetros:
+//   - `e` estado a restaurar.
+//   - `copiar` (opcional) función que duplica cada elemento al restaurar.
+//
+// Retorna:
+//   - un error que envuelve a ErrHeapNil si el heap es nil, o a
+//     ErrEstadoAjeno si el estado se tomó de otro heap.
+func (m *Heap[T]) Restore(e Estado[T], copiar ...func(T) T) error {
+	if m == nil {
+		return fmt.Errorf(Localizar("restaurar: %w", "restore: %w"), ErrHeapNil)
+	}
+	if e.origen != m {
+		return fmt.Errorf(Localizar("restaurar: %w", "restore: %w"), ErrEstadoAjeno)
+	}
+	m.guardia.entrar("Restore")
+	defer m.guardia.salir()
+	m.elements = copiarElementos("restore", e.elements, copiar)
+
+	return nil
+}
+
+// Filter crea un heap con los elementos que cumplen el predicado y la misma
+// función de comparación. Se arma con heapify en O(n) y el heap original no
+// se modifica.
+//
+// Uso:
+//
+//	pares := heap.Filter(func(v int) bool { return v%2 == 0 })
+//
+// Parámetros:
+//   - `pred` predicado que deben cumplir los elementos.
+//   - `copiar` (opcional) función que duplica cada elemento conservado.
+//
+// Retorna:
+//   - un puntero al heap filtrado, o nil si el heap es nil.
+func (m *Heap[T]) Filter(pred func(T) bool, copiar ...func(T) T) *Heap[T] {
+	if m == nil {
+		return nil
+	}
+	m.guardia.entrar("Filter")
+	defer m.guardia.salir()
+
+	conservados := make([]T, 0, len(m.elements))
+	for _, e := range m.elements {
+		if pred(e) {
+			conservados = append(conservados, e)
+		}
+	}
+	filtrado := &Heap[T]{compare: m.compare, elements: copiarElementos("filter", conservados, copiar), tipo: m.tipo}
+	filtrado.heapify()
+
+	return filtrado
+}
+
+// MapHeap crea un heap con el resultado de aplicar `f` a cada elemento de
+// `h`, ordenado con otra función de comparación. Se arma con heapify en O(n)
+// y `h` no se modifica. Sirve para derivar otras vistas de prioridad, por
+// ejemplo de tareas a sus vencimientos.
+//
+// Uso:
+//
+//	vencimientos := heap.MapHeap(tareas, func(t Tarea) time.Time { return t.Vence }, time.Time.Compare)
+//
+// Parámetros:
+//   - `h` heap de origen.
+//   - `f` función que transforma cada elemento.
+//   - `comp` función de comparación del nuevo heap, con la misma convención
+//     que NewGenericHeap.
+//
+// Retorna:
+//   - un puntero al nuevo heap, o nil si `h` es nil.
+//
+// Si `comp` es nil se produce un panic, como en NewGenericHeap.
+func MapHeap[T, U any](h *Heap[T], f func(T) U, comp func(a U, b U) int) *Heap[U] {
+	mapeado := NewGenericHeap(comp)
+	if h == nil {
+		return nil
+	}
+	h.guardia.entrar("MapHeap")
+	defer h.guardia.salir()
+	mapeado.elements = make([]U, len(h.elements))
+	for i, e := range h.elements {
+		mapeado.elements[i] = f(e)
+	}
+	mapeado.heapify()
+
+	return mapeado
+}
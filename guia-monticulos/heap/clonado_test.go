@@ -0,0 +1,170 @@
+package heap
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type paciente struct {
+	nombre    string
+	prioridad int
+}
+
+func copiarPaciente(p *paciente) *paciente {
+	c := *p
+	return &c
+}
+
+func heapDePacientes(prioridades ...int) *Heap[*paciente] {
+	h := NewGenericHeap(func(a, b *paciente) int { return b.prioridad - a.prioridad })
+	for i, p := range prioridades {
+		h.Insert(&paciente{nombre: string(rune('a' + i)), prioridad: p})
+	}
+
+	return h
+}
+
+func TestCloneEsIndependiente(t *testing.T) {
+	h := NewMinHeap[int]()
+	h.Insert(3)
+	h.Insert(1)
+
+	copia := h.Clone()
+	copia.Insert(0)
+	v, _ := h.Remove()
+
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 3, copia.Size())
+	assert.Equal(t, 1, h.Size())
+}
+
+func TestCloneSinCopiaCompartePunteros(t *testing.T) {
+	h := heapDePacientes(5, 9)
+
+	superficial := h.Clone()
+	profunda := h.Clone(copiarPaciente)
+	h.Values()[0].prioridad = 1
+
+	assert.Equal(t, 1, superficial.Values()[0].prioridad)
+	assert.Equal(t, 9, profunda.Values()[0].prioridad)
+}
+
+func TestCloneNil(t *testing.T) {
+	var h *Heap[int]
+	assert.Nil(t, h.Clone())
+	assert.Nil(t, h.Filter(func(int) bool { return true }))
+}
+
+func TestSnapshotYRestore(t *testing.T) {
+	h := NewMaxHeap[int]()
+	h.Insert(4)
+	h.Insert(8)
+	estado := h.Snapshot()
+
+	h.Insert(10)
+	_, _ = h.Remove()
+	_, _ = h.Remove()
+	assert.NoError(t, h.Restore(estado))
+	assert.Equal(t, 2, estado.Size())
+
+	v, _ := h.Remove()
+	assert.Equal(t, 8, v)
+	assert.NoError(t, h.Restore(estado), "un estado se puede restaurar más de una vez")
+	assert.Equal(t, 2, h.Size())
+}
+
+func TestSnapshotConCopiaNoSeAlteraAlModificarElementos(t *testing.T) {
+	h := heapDePacientes(7)
+	estado := h.Snapshot(copiarPaciente)
+
+	h.Values()[0].prioridad = 0
+	assert.NoError(t, h.Restore(estado, copiarPaciente))
+
+	assert.Equal(t, 7, h.Values()[0].prioridad)
+}
+
+func TestRestoreEstadoAjeno(t *testing.T) {
+	h := NewMinHeap[int]()
+	otro := NewMinHeap[int]()
+
+	err := h.Restore(otro.Snapshot())
+	assert.ErrorIs(t, err, ErrEstadoAjeno)
+	assert.EqualError(t, err, "restaurar: el estado pertenece a otro heap")
+
+	var nilHeap *Heap[int]
+	assert.ErrorIs(t, nilHeap.Restore(h.Snapshot()), ErrHeapNil)
+}
+
+func TestFilter(t *testing.T) {
+	h := NewMinHeap[int]()
+	for _, v := range []int{9, 4, 7, 2, 6, 1} {
+		h.Insert(v)
+	}
+
+	pares := h.Filter(func(v int) bool { return v%2 == 0 })
+
+	assert.Equal(t, 6, h.Size())
+	assert.Equal(t, 3, pares.Size())
+	for _, esperado := range []int{2, 4, 6} {
+		v, _ := pares.Remove()
+		assert.Equal(t, esperado, v)
+	}
+}
+
+func TestFilterUsaHeapify(t *testing.T) {
+	h := NewMinHeap[int]()
+	for _, v := range []int{1, 10, 2, 11, 12, 3, 4} {
+		h.Insert(v)
+	}
+
+	filtrado := h.Filter(func(v int) bool { return v != 1 })
+
+	assert.True(t, filtrado.IsValid())
+	assert.Equal(t, NuevoMonticuloMinDesdeArreglo([]int{10, 2, 11, 12, 3, 4}).Values(), filtrado.Values())
+}
+
+func TestVariasFuncionesDeCopiaProducenPanic(t *testing.T) {
+	h := heapDePacientes(3, 8)
+
+	assert.PanicsWithValue(t, "heap: clone admite una sola función de copia, se pasaron 2", func() {
+		h.Clone(copiarPaciente, copiarPaciente)
+	})
+	assert.Panics(t, func() { h.Snapshot(copiarPaciente, copiarPaciente) })
+	assert.Panics(t, func() { h.Filter(func(*paciente) bool { return true }, copiarPaciente, copiarPaciente) })
+	assert.Equal(t, 2, h.Size())
+}
+
+func TestFilterConCopia(t *testing.T) {
+	h := heapDePacientes(3, 8, 5)
+
+	urgentes := h.Filter(func(p *paciente) bool { return p.prioridad > 4 }, copiarPaciente)
+	for _, p := range h.Values() {
+		p.prioridad = 0
+	}
+
+	primero, _ := urgentes.Remove()
+	segundo, _ := urgentes.Remove()
+	assert.Equal(t, 8, primero.prioridad)
+	assert.Equal(t, 5, segundo.prioridad)
+}
+
+func TestMapHeap(t *testing.T) {
+	h := heapDePacientes(3, 8, 5)
+
+	// de mayor prioridad primero a nombres en orden alfabético inverso
+	nombres := MapHeap(h, func(p *paciente) string { return p.nombre }, func(a, b string) int {
+		return cmp.Compare(b, a)
+	})
+
+	assert.Equal(t, 3, h.Size())
+	assert.Equal(t, []string{"c", "b", "a"}, extraerTodos(nombres))
+	assert.Equal(t, 3, h.Size())
+}
+
+func TestMapHeapNil(t *testing.T) {
+	var h *Heap[int]
+	assert.Nil(t, MapHeap(h, func(v int) int { return v }, cmp.Compare[int]))
+	assert.Panics(t, func() { MapHeap(NewMinHeap[int](), func(v int) int { return v }, nil) })
+}
@@ -0,0 +1,216 @@
+package heap
+
+import (
+	"fmt"
+	"math/bits"
+	"unsafe"
+)
+
+// Pequeno es el constraint de los enteros de hasta 16 bits que acepta
+// HeapCompacto.
+type Pequeno interface {
+	~int8 | ~uint8 | ~int16 | ~uint16
+}
+
+// HeapCompacto es una cola de prioridad para enteros de hasta 16 bits que,
+// en lugar de guardar cada elemento, cuenta cuántas veces aparece cada valor
+// posible. La memoria depende solo del tipo (2 KiB para int8 y uint8, 512 KiB
+// para int16 y uint16) y no de la cantidad de elementos, así que cientos de
+// millones de claves pequeñas ocupan lo mismo que una.
+//
+// Para encontrar la cima sin recorrer todos los contadores se mantienen dos
+// niveles de bits que indican qué valores tienen al menos una aparición:
+// Insert es O(1) y Remove revisa a lo sumo 16 + 1 palabras de 64 bits.
+type HeapCompacto[T Pequeno] struct {
+	contadores []uint64
+	// bit i encendido si contadores[i] > 0
+	presentes []uint64
+	// bit j encendido si presentes[j] != 0
+	resumen []uint64
+	// se aplica a cada valor para que el orden de los índices coincida con el
+	// de los valores: invierte el bit de signo en los tipos con signo
+	signo     uint64
+	mascara   uint64
+	deMaximos bool
+	size      int
+}
+
+func nuevoHeapCompacto[T Pequeno](deMaximos bool) *HeapCompacto[T] {
+	var cero T
+	ancho := uint(unsafe.Sizeof(cero)) * 8
+	dominio := 1 << ancho
+	h := &HeapCompacto[T]{
+		contadores: make([]uint64, dominio),
+		presentes:  make([]uint64, (dominio+63)/64),
+		mascara:    uint64(dominio - 1),
+		deMaximos:  deMaximos,
+	}
+	h.resumen = make([]uint64, (len(h.presentes)+63)/64)
+	// con todos los bits encendidos, un tipo con signo vale -1
+	if ^cero < cero {
+		h.signo = 1 << (ancho - 1)
+	}
+
+	return h
+}
+
+// NewMinHeapCompacto crea un heap compacto de mínimos.
+//
+// Uso:
+//
+//	h := heap.NewMinHeapCompacto[uint16]()
+//
+// Retorna:
+//   - un puntero a un heap compacto vacío.
+func NewMinHeapCompacto[T Pequeno]() *HeapCompacto[T] {
+	return nuevoHeapCompacto[T](false)
+}
+
+// NewMaxHeapCompacto crea un heap compacto de máximos.
+//
+// Uso:
+//
+//	h := heap.NewMaxHeapCompacto[int8]()
+//
+// Retorna:
+//   - un puntero a un heap compacto vacío.
+func NewMaxHeapCompacto[T Pequeno]() *HeapCompacto[T] {
+	return nuevoHeapCompacto[T](true)
+}
+
+func (h *HeapCompacto[T]) indice(v T) uint64 {
+	return (uint64(v) ^ h.signo) & h.mascara
+}
+
+func (h *HeapCompacto[T]) valor(i uint64) T {
+	return T(i ^ h.signo)
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (h *HeapCompacto[T]) Size() int {
+	return h.size
+}
+
+// Count retorna cuántas veces está el valor en el heap.
+func (h *HeapCompacto[T]) Count(v T) int {
+	return int(h.contadores[h.indice(v)])
+}
+
+// Insert agrega un elemento al heap. O(1)
+func (h *HeapCompacto[T]) Insert(element T) {
+	i := h.indice(element)
+	if h.contadores[i] == 0 {
+		h.presentes[i/64] |= 1 << (i % 64)
+		h.resumen[i/4096] |= 1 << (i / 64 % 64)
+	}
+	h.contadores[i]++
+	h.size++
+}
+
+// Remove elimina y retorna el elemento en la cima del heap.
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (h *HeapCompacto[T]) Remove() (T, error) {
+	if h.size == 0 {
+		var cero T
+		return cero, fmt.Errorf(Localizar("extraer: %w", "remove: %w"), ErrHeapVacio)
+	}
+	i := h.cima()
+	h.contadores[i]--
+	h.size--
+	if h.contadores[i] == 0 {
+		h.presentes[i/64] &^= 1 << (i % 64)
+		if h.presentes[i/64] == 0 {
+			h.resumen[i/4096] &^= 1 << (i / 64 % 64)
+		}
+	}
+
+	return h.valor(i), nil
+}
+
+// cima retorna el índice del menor valor presente, o del mayor en un heap de
+// máximos. El heap no debe estar vacío.
+func (h *HeapCompacto[T]) cima() uint64 {
+	if h.deMaximos {
+		r := len(h.resumen) - 1
+		for h.resumen[r] == 0 {
+			r--
+		}
+		p := uint64(r)*64 + uint64(63-bits.LeadingZeros64(h.resumen[r]))
+		return p*64 + uint64(63-bits.LeadingZeros64(h.presentes[p]))
+	}
+	r := 0
+	for h.resumen[r] == 0 {
+		r++
+	}
+	p := uint64(r)*64 + uint64(bits.TrailingZeros64(h.resumen[r]))
+
+	return p*64 + uint64(bits.TrailingZeros64(h.presentes[p]))
+}
+
+// HeapBool es una cola de prioridad de valores bool que guarda solo cuántos
+// true y cuántos false contiene.
+type HeapBool struct {
+	// contadores[0] cuenta los false y contadores[1] los true
+	contadores [2]int
+	// indica si los true salen antes que los false
+	truePrimero bool
+}
+
+// NewHeapBool crea un heap de bool vacío.
+//
+// Uso:
+//
+//	h := heap.NewHeapBool(true)
+//
+// Parámetros:
+//   - `truePrimero` indica si los true tienen prioridad sobre los false.
+//
+// Retorna:
+//   - un puntero a un heap de bool vacío.
+func NewHeapBool(truePrimero bool) *HeapBool {
+	return &HeapBool{truePrimero: truePrimero}
+}
+
+func indiceBool(v bool) int {
+	if v {
+		return 1
+	}
+
+	return 0
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (h *HeapBool) Size() int {
+	return h.contadores[0] + h.contadores[1]
+}
+
+// Count retorna cuántas veces está el valor en el heap.
+func (h *HeapBool) Count(v bool) int {
+	return h.contadores[indiceBool(v)]
+}
+
+// Insert agrega un elemento al heap. O(1)
+func (h *HeapBool) Insert(element bool) {
+	h.contadores[indiceBool(element)]++
+}
+
+// Remove elimina y retorna el elemento en la cima del heap. O(1)
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (h *HeapBool) Remove() (bool, error) {
+	if h.Size() == 0 {
+		return false, fmt.Errorf(Localizar("extraer: %w", "remove: %w"), ErrHeapVacio)
+	}
+	v := h.truePrimero
+	if h.contadores[indiceBool(v)] == 0 {
+		v = !v
+	}
+	h.contadores[indiceBool(v)]--
+
+	return v, nil
+}
@@ -0,0 +1,94 @@
+package heap
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// compararConHeap aplica la misma secuencia al azar a un heap compacto y a
+// un Heap común y verifica que retiren lo mismo.
+func compararConHeap[T Pequeno](t *testing.T, compacto *HeapCompacto[T], comun *Heap[T], generar func(*rand.Rand) T) {
+	t.Helper()
+	r := rand.New(rand.NewSource(11))
+	for i := 0; i < 5000; i++ {
+		if r.Intn(5) < 2 {
+			esperado, errEsperado := comun.Remove()
+			v, err := compacto.Remove()
+			assert.Equal(t, errEsperado == nil, err == nil)
+			assert.Equal(t, esperado, v)
+		} else {
+			v := generar(r)
+			comun.Insert(v)
+			compacto.Insert(v)
+		}
+		assert.Equal(t, comun.Size(), compacto.Size())
+	}
+}
+
+func TestHeapCompactoCoincideConHeap(t *testing.T) {
+	compararConHeap(t, NewMinHeapCompacto[int8](), NewMinHeap[int8](),
+		func(r *rand.Rand) int8 { return int8(r.Intn(256) - 128) })
+	compararConHeap(t, NewMaxHeapCompacto[int8](), NewMaxHeap[int8](),
+		func(r *rand.Rand) int8 { return int8(r.Intn(256) - 128) })
+	compararConHeap(t, NewMinHeapCompacto[uint16](), NewMinHeap[uint16](),
+		func(r *rand.Rand) uint16 { return uint16(r.Intn(1 << 16)) })
+	compararConHeap(t, NewMaxHeapCompacto[int16](), NewMaxHeap[int16](),
+		func(r *rand.Rand) int16 { return int16(r.Intn(1<<16) - 1<<15) })
+}
+
+func TestHeapCompactoExtremosDelDominio(t *testing.T) {
+	h := NewMinHeapCompacto[int8]()
+	for _, v := range []int8{127, -128, 0, -1, 1, 127} {
+		h.Insert(v)
+	}
+
+	assert.Equal(t, 2, h.Count(127))
+	var salida []int8
+	for h.Size() > 0 {
+		v, _ := h.Remove()
+		salida = append(salida, v)
+	}
+	assert.Equal(t, []int8{-128, -1, 0, 1, 127, 127}, salida)
+	_, err := h.Remove()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+}
+
+func TestHeapCompactoMuchasRepeticiones(t *testing.T) {
+	h := NewMaxHeapCompacto[uint8]()
+	for i := 0; i < 1_000_000; i++ {
+		h.Insert(uint8(i % 3))
+	}
+
+	assert.Equal(t, 1_000_000, h.Size())
+	assert.Len(t, h.contadores, 256)
+	v, _ := h.Remove()
+	assert.Equal(t, uint8(2), v)
+	assert.Equal(t, 333_332, h.Count(2))
+}
+
+func TestHeapBool(t *testing.T) {
+	h := NewHeapBool(true)
+	for _, v := range []bool{false, true, false, true, false} {
+		h.Insert(v)
+	}
+
+	assert.Equal(t, 5, h.Size())
+	assert.Equal(t, 3, h.Count(false))
+	var salida []bool
+	for h.Size() > 0 {
+		v, err := h.Remove()
+		assert.NoError(t, err)
+		salida = append(salida, v)
+	}
+	assert.Equal(t, []bool{true, true, false, false, false}, salida)
+	_, err := h.Remove()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+
+	h = NewHeapBool(false)
+	h.Insert(true)
+	h.Insert(false)
+	v, _ := h.Remove()
+	assert.False(t, v)
+}
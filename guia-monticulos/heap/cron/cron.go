@@ -0,0 +1,234 @@
+// Package cron ejecuta tareas recurrentes según especificaciones de cron. Las
+// tareas programadas se guardan en un heap de mínimos ordenado por su próximo
+// disparo, de modo que el scheduler solo necesita esperar hasta la cima.
+package cron
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// ID identifica una tarea programada.
+type ID int
+
+// entrada es una tarea programada con su próximo disparo.
+type entrada struct {
+	id        ID
+	spec      Spec
+	fn        func()
+	proximo   time.Time
+	cancelada bool
+}
+
+// reloj abstrae el paso del tiempo para poder probar el scheduler sin esperar.
+type reloj interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type relojReal struct{}
+
+func (relojReal) Now() time.Time { return time.Now() }
+
+func (relojReal) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Scheduler ejecuta tareas cuando llega su próximo disparo. Cada ejecución
+// corre en su propia goroutine, por lo que una tarea lenta no demora a las
+// demás. Es seguro usarlo desde varias goroutines.
+type Scheduler struct {
+	mu          sync.Mutex
+	proximos    *heap.Heap[*entrada]
+	entradas    map[ID]*entrada
+	siguienteID ID
+	loc         *time.Location
+	reloj       reloj
+
+	corriendo bool
+	cambios   chan struct{}
+	parar     chan struct{}
+	terminado chan struct{}
+	tareas    sync.WaitGroup
+}
+
+// New crea un scheduler detenido.
+//
+// Uso:
+//
+//	s := cron.New(time.UTC)
+//
+// Parámetros:
+//   - `loc` zona horaria en la que se evalúan las especificaciones que no
+//     indican una propia. Si es nil se usa time.Local.
+//
+// Retorna:
+//   - un puntero al scheduler.
+func New(loc *time.Location) *Scheduler {
+	if loc == nil {
+		loc = time.Local
+	}
+
+	return &Scheduler{
+		proximos: heap.NewGenericHeap(func(a, b *entrada) int {
+			return a.proximo.Compare(b.proximo)
+		}),
+		entradas: make(map[ID]*entrada),
+		loc:      loc,
+		reloj:    relojReal{},
+		cambios:  make(chan struct{}, 1),
+	}
+}
+
+// Schedule programa una tarea recurrente. Se puede llamar antes o después
+// de Start.
+//
+// Uso:
+//
+//	id, err := s.Schedule("0 9 * * 1-5", func() { fmt.Println("buen día") })
+//
+// Parámetros:
+//   - `spec` especificación de cron (ver Spec).
+//   - `fn` tarea a ejecutar.
+//
+// Retorna:
+//   - el identificador de la tarea, para cancelarla con Remove.
+//   - un error si la especificación es inválida o no tiene disparos.
+func (s *Scheduler) Schedule(spec string, fn func()) (ID, error) {
+	parseada, err := Parse(spec)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	proximo := parseada.Next(s.reloj.Now().In(s.loc))
+	if proximo.IsZero() {
+		return 0, fmt.Errorf("especificación sin disparos: %q", spec)
+	}
+	s.siguienteID++
+	e := &entrada{id: s.siguienteID, spec: parseada, fn: fn, proximo: proximo}
+	s.entradas[e.id] = e
+	s.proximos.Insert(e)
+	s.avisar()
+
+	return e.id, nil
+}
+
+// Remove cancela una tarea programada. Las ejecuciones ya iniciadas terminan
+// normalmente.
+//
+// Retorna:
+//   - true si la tarea existía.
+func (s *Scheduler) Remove(id ID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entradas[id]
+	if !ok {
+		return false
+	}
+	// la entrada se descarta del heap cuando llega a la cima
+	e.cancelada = true
+	delete(s.entradas, id)
+	s.avisar()
+
+	return true
+}
+
+// Len retorna la cantidad de tareas programadas.
+func (s *Scheduler) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.entradas)
+}
+
+// Start pone en marcha el scheduler. Llamarlo con el scheduler en marcha no
+// tiene efecto.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.corriendo {
+		return
+	}
+	s.corriendo = true
+	s.parar = make(chan struct{})
+	s.terminado = make(chan struct{})
+	go s.ejecutar(s.parar, s.terminado)
+}
+
+// Stop detiene el scheduler y espera a que terminen las tareas que están en
+// ejecución. Las tareas programadas se conservan, así que se puede volver a
+// llamar a Start. Llamarlo con el scheduler detenido no tiene efecto.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	if !s.corriendo {
+		s.mu.Unlock()
+		return
+	}
+	s.corriendo = false
+	close(s.parar)
+	terminado := s.terminado
+	s.mu.Unlock()
+
+	<-terminado
+	s.tareas.Wait()
+}
+
+// avisar despierta al ciclo principal para que recalcule la espera. Debe
+// llamarse con el mutex tomado.
+func (s *Scheduler) avisar() {
+	select {
+	case s.cambios <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Scheduler) ejecutar(parar, terminado chan struct{}) {
+	defer close(terminado)
+	for {
+		espera := s.dispararVencidas()
+		select {
+		case <-espera:
+		case <-s.cambios:
+		case <-parar:
+			return
+		}
+	}
+}
+
+// dispararVencidas lanza las tareas cuyo disparo ya llegó, las reprograma y
+// retorna un canal que se activa al llegar el próximo disparo, o nil si no
+// hay tareas.
+func (s *Scheduler) dispararVencidas() <-chan time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ahora := s.reloj.Now()
+	for s.proximos.Size() > 0 {
+		e, _ := s.proximos.Remove()
+		if e.cancelada {
+			continue
+		}
+		if e.proximo.After(ahora) {
+			s.proximos.Insert(e)
+			return s.reloj.After(e.proximo.Sub(ahora))
+		}
+
+		s.tareas.Add(1)
+		go func(fn func()) {
+			defer s.tareas.Done()
+			fn()
+		}(e.fn)
+
+		// si el scheduler estuvo detenido se saltean los disparos perdidos
+		e.proximo = e.spec.Next(ahora.In(s.loc))
+		if e.proximo.IsZero() {
+			delete(s.entradas, e.id)
+			continue
+		}
+		s.proximos.Insert(e)
+	}
+
+	return nil
+}
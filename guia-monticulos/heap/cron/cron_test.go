@@ -0,0 +1,162 @@
+package cron
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// relojFalso avanza solo cuando el test lo indica.
+type relojFalso struct {
+	mu      sync.Mutex
+	ahora   time.Time
+	esperas []espera
+}
+
+type espera struct {
+	hasta time.Time
+	ch    chan time.Time
+}
+
+func (r *relojFalso) Now() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ahora
+}
+
+func (r *relojFalso) After(d time.Duration) <-chan time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	r.esperas = append(r.esperas, espera{hasta: r.ahora.Add(d), ch: ch})
+
+	return ch
+}
+
+func (r *relojFalso) Avanzar(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ahora = r.ahora.Add(d)
+	pendientes := r.esperas[:0]
+	for _, e := range r.esperas {
+		if e.hasta.After(r.ahora) {
+			pendientes = append(pendientes, e)
+		} else {
+			e.ch <- r.ahora
+		}
+	}
+	r.esperas = pendientes
+}
+
+func nuevoSchedulerDePrueba(desde string) (*Scheduler, *relojFalso) {
+	r := &relojFalso{ahora: fecha(desde)}
+	s := New(time.UTC)
+	s.reloj = r
+
+	return s, r
+}
+
+func recibir(t *testing.T, ch <-chan string) string {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(2 * time.Second):
+		t.Fatal("la tarea no se ejecutó")
+		return ""
+	}
+}
+
+func sinRecibir(t *testing.T, ch <-chan string) {
+	t.Helper()
+	select {
+	case v := <-ch:
+		t.Fatalf("no se esperaba la ejecución de %q", v)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestSchedulerEjecutaEnOrden(t *testing.T) {
+	s, r := nuevoSchedulerDePrueba("2024-05-10 10:02")
+	ejecutadas := make(chan string, 10)
+	_, err := s.Schedule("*/10 * * * *", func() { ejecutadas <- "cada10" })
+	assert.NoError(t, err)
+	_, err = s.Schedule("5 * * * *", func() { ejecutadas <- "a-las-5" })
+	assert.NoError(t, err)
+	s.Start()
+	defer s.Stop()
+
+	sinRecibir(t, ejecutadas)
+	r.Avanzar(3 * time.Minute)
+	assert.Equal(t, "a-las-5", recibir(t, ejecutadas))
+	sinRecibir(t, ejecutadas)
+	r.Avanzar(5 * time.Minute)
+	assert.Equal(t, "cada10", recibir(t, ejecutadas))
+	r.Avanzar(10 * time.Minute)
+	assert.Equal(t, "cada10", recibir(t, ejecutadas))
+}
+
+func TestSchedulerRemove(t *testing.T) {
+	s, r := nuevoSchedulerDePrueba("2024-05-10 10:00")
+	ejecutadas := make(chan string, 10)
+	id, err := s.Schedule("* * * * *", func() { ejecutadas <- "cancelada" })
+	assert.NoError(t, err)
+	s.Start()
+	defer s.Stop()
+
+	assert.True(t, s.Remove(id))
+	assert.False(t, s.Remove(id))
+	assert.Equal(t, 0, s.Len())
+	r.Avanzar(time.Minute)
+	sinRecibir(t, ejecutadas)
+}
+
+func TestSchedulerStopEsperaLasTareas(t *testing.T) {
+	s, r := nuevoSchedulerDePrueba("2024-05-10 10:00")
+	iniciada := make(chan string, 1)
+	liberar := make(chan struct{})
+	terminada := false
+	_, err := s.Schedule("* * * * *", func() {
+		iniciada <- "lenta"
+		<-liberar
+		terminada = true
+	})
+	assert.NoError(t, err)
+	s.Start()
+
+	r.Avanzar(time.Minute)
+	recibir(t, iniciada)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(liberar)
+	}()
+	s.Stop()
+
+	assert.True(t, terminada)
+	s.Stop()
+}
+
+func TestSchedulerZonaHoraria(t *testing.T) {
+	s, _ := nuevoSchedulerDePrueba("2024-05-10 10:00")
+	buenosAires, err := time.LoadLocation("America/Argentina/Buenos_Aires")
+	assert.NoError(t, err)
+	s.loc = buenosAires
+
+	_, err = s.Schedule("0 9 * * *", func() {})
+	assert.NoError(t, err)
+	e, _ := s.proximos.Remove()
+
+	assert.True(t, fecha("2024-05-10 12:00").Equal(e.proximo))
+}
+
+func TestSchedulerSpecSinDisparos(t *testing.T) {
+	s, _ := nuevoSchedulerDePrueba("2024-05-10 10:00")
+
+	_, err := s.Schedule("0 0 31 4 *", func() {})
+	assert.EqualError(t, err, `especificación sin disparos: "0 0 31 4 *"`)
+	_, err = s.Schedule("nada", func() {})
+	assert.Error(t, err)
+}
@@ -0,0 +1,201 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Spec es una especificación de disparos en el formato clásico de cron:
+//
+//	minuto hora día-del-mes mes día-de-la-semana
+//
+// Cada campo acepta "*", valores ("5"), rangos ("1-5"), listas ("1,15,30")
+// y pasos ("*/10", "8-18/2"). El día de la semana va de 0 (domingo) a 6; el
+// 7 también es domingo. Si el día del mes y el de la semana están ambos
+// restringidos, alcanza con que se cumpla uno, como en cron; un campo que
+// incluye todos sus valores, como "*/1", no cuenta como restringido.
+//
+// También se aceptan los atajos @yearly, @monthly, @weekly, @daily y
+// @hourly, y el prefijo "TZ=Zona " para evaluar la especificación en otra
+// zona horaria, por ejemplo "TZ=America/Argentina/Buenos_Aires 0 9 * * 1-5".
+type Spec struct {
+	minuto, hora, diaMes, mes, diaSemana uint64
+	// indica si el campo correspondiente incluye todos sus valores, como "*"
+	// o "*/1", para la regla del día
+	diaMesLibre, diaSemanaLibre bool
+	// zona horaria propia, o nil para usar la del scheduler
+	zona *time.Location
+}
+
+type campo struct {
+	nombre   string
+	min, max int
+}
+
+var campos = []campo{
+	{"minuto", 0, 59},
+	{"hora", 0, 23},
+	{"día del mes", 1, 31},
+	{"mes", 1, 12},
+	{"día de la semana", 0, 7},
+}
+
+var atajos = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse interpreta una especificación de cron.
+//
+// Uso:
+//
+//	spec, err := cron.Parse("*/15 8-18 * * 1-5")
+//
+// Parámetros:
+//   - `spec` especificación a interpretar.
+//
+// Retorna:
+//   - la especificación.
+//   - un error si la especificación es inválida o la zona horaria no existe.
+func Parse(spec string) (Spec, error) {
+	var s Spec
+	texto := strings.TrimSpace(spec)
+	if strings.HasPrefix(texto, "TZ=") {
+		zona, resto, _ := strings.Cut(texto[len("TZ="):], " ")
+		loc, err := time.LoadLocation(zona)
+		if err != nil {
+			return s, fmt.Errorf("especificación inválida %q: zona horaria %q: %w", spec, zona, err)
+		}
+		s.zona = loc
+		texto = strings.TrimSpace(resto)
+	}
+	if atajo, ok := atajos[texto]; ok {
+		texto = atajo
+	}
+
+	partes := strings.Fields(texto)
+	if len(partes) != len(campos) {
+		return s, fmt.Errorf("especificación inválida %q: se esperaban %d campos y hay %d", spec, len(campos), len(partes))
+	}
+	valores := make([]uint64, len(campos))
+	for i, parte := range partes {
+		bits, err := parsearCampo(parte, campos[i])
+		if err != nil {
+			return s, fmt.Errorf("especificación inválida %q: %w", spec, err)
+		}
+		valores[i] = bits
+	}
+	s.minuto, s.hora, s.diaMes, s.mes = valores[0], valores[1], valores[2], valores[3]
+	// el 7 es otra forma de escribir el domingo
+	s.diaSemana = valores[4]&^(1<<7) | (valores[4]>>7)&1
+	s.diaMesLibre = s.diaMes == mascara(1, 31)
+	s.diaSemanaLibre = s.diaSemana == mascara(0, 6)
+
+	return s, nil
+}
+
+// mascara retorna la máscara de bits con los valores de `desde` a `hasta`.
+func mascara(desde, hasta int) uint64 {
+	return (1<<(hasta+1) - 1) &^ (1<<desde - 1)
+}
+
+// parsearCampo retorna el conjunto de valores del campo como máscara de bits.
+func parsearCampo(texto string, c campo) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(texto, ",") {
+		rango, paso, tienePaso := strings.Cut(item, "/")
+		desde, hasta := c.min, c.max
+		if rango != "*" {
+			a, b, esRango := strings.Cut(rango, "-")
+			var err error
+			if desde, err = parsearValor(a, c); err != nil {
+				return 0, err
+			}
+			hasta = desde
+			if esRango {
+				if hasta, err = parsearValor(b, c); err != nil {
+					return 0, err
+				}
+			} else if tienePaso {
+				hasta = c.max
+			}
+			if desde > hasta {
+				return 0, fmt.Errorf("%s: rango vacío %q", c.nombre, rango)
+			}
+		}
+		incremento := 1
+		if tienePaso {
+			n, err := strconv.Atoi(paso)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("%s: paso inválido %q", c.nombre, paso)
+			}
+			incremento = n
+		}
+		for v := desde; v <= hasta; v += incremento {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+func parsearValor(texto string, c campo) (int, error) {
+	v, err := strconv.Atoi(texto)
+	if err != nil || v < c.min || v > c.max {
+		return 0, fmt.Errorf("%s: valor inválido %q (debe estar entre %d y %d)", c.nombre, texto, c.min, c.max)
+	}
+
+	return v, nil
+}
+
+// Next retorna el primer disparo posterior a `t`, en la zona horaria de la
+// especificación si tiene una o en la de `t` si no. Retorna el instante cero
+// si no hay disparos en los próximos cinco años (por ejemplo "0 0 30 2 *").
+func (s Spec) Next(t time.Time) time.Time {
+	if s.zona != nil {
+		t = t.In(s.zona)
+	}
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limite := t.AddDate(5, 0, 0)
+
+	for t.Before(limite) {
+		if s.mes&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.coincideDia(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.hora&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if s.minuto&(1<<uint(t.Minute())) == 0 {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Spec) coincideDia(t time.Time) bool {
+	diaMes := s.diaMes&(1<<uint(t.Day())) != 0
+	diaSemana := s.diaSemana&(1<<uint(t.Weekday())) != 0
+	if s.diaMesLibre || s.diaSemanaLibre {
+		return diaMes && diaSemana
+	}
+
+	return diaMes || diaSemana
+}
@@ -0,0 +1,92 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fecha(s string) time.Time {
+	t, err := time.Parse("2006-01-02 15:04", s)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+func TestNext(t *testing.T) {
+	casos := []struct {
+		spec, desde, esperado string
+	}{
+		{"* * * * *", "2024-05-10 10:02", "2024-05-10 10:03"},
+		{"*/15 * * * *", "2024-05-10 10:02", "2024-05-10 10:15"},
+		{"0 9 * * *", "2024-05-10 10:02", "2024-05-11 09:00"},
+		{"30 8-18/2 * * *", "2024-05-10 10:30", "2024-05-10 12:30"},
+		{"0 0 1 * *", "2024-12-15 00:00", "2025-01-01 00:00"},
+		{"0 0 29 2 *", "2023-03-01 00:00", "2024-02-29 00:00"},
+		{"0 12 * * 1-5", "2024-05-10 13:00", "2024-05-13 12:00"},
+		{"0 0 * * 7", "2024-05-10 00:00", "2024-05-12 00:00"},
+		{"0 0 13 * 5", "2024-05-01 00:00", "2024-05-03 00:00"},
+		{"5,10 * * * *", "2024-05-10 10:05", "2024-05-10 10:10"},
+		{"@hourly", "2024-05-10 10:02", "2024-05-10 11:00"},
+		{"@weekly", "2024-05-10 10:02", "2024-05-12 00:00"},
+	}
+	for _, c := range casos {
+		spec, err := Parse(c.spec)
+		assert.NoError(t, err, c.spec)
+		assert.Equal(t, fecha(c.esperado), spec.Next(fecha(c.desde)), c.spec)
+	}
+}
+
+func TestNextConCampoDeDiaSinRestricciones(t *testing.T) {
+	// un campo que incluye todos los valores no restringe el día, así que
+	// sólo cuenta el otro: estos disparan únicamente los lunes o el día 13
+	casos := []struct {
+		spec, desde, esperado string
+	}{
+		{"0 0 */1 * 1", "2024-05-10 10:00", "2024-05-13 00:00"},
+		{"0 0 1-31 * 1", "2024-05-10 10:00", "2024-05-13 00:00"},
+		{"0 0 13 * */1", "2024-05-01 00:00", "2024-05-13 00:00"},
+		{"0 0 13 * 0-7", "2024-05-01 00:00", "2024-05-13 00:00"},
+		// con pasos que no cubren todo el rango ambos campos restringen
+		{"0 0 */2 * 1", "2024-05-10 10:00", "2024-05-11 00:00"},
+	}
+	for _, c := range casos {
+		spec, err := Parse(c.spec)
+		assert.NoError(t, err, c.spec)
+		assert.Equal(t, fecha(c.esperado), spec.Next(fecha(c.desde)), c.spec)
+	}
+}
+
+func TestNextSinDisparos(t *testing.T) {
+	spec, err := Parse("0 0 30 2 *")
+	assert.NoError(t, err)
+	assert.True(t, spec.Next(fecha("2024-01-01 00:00")).IsZero())
+}
+
+func TestNextConZonaHoraria(t *testing.T) {
+	spec, err := Parse("TZ=America/Argentina/Buenos_Aires 0 9 * * *")
+	assert.NoError(t, err)
+
+	proximo := spec.Next(fecha("2024-05-10 10:00"))
+	assert.Equal(t, "America/Argentina/Buenos_Aires", proximo.Location().String())
+	assert.True(t, fecha("2024-05-10 12:00").Equal(proximo), proximo.UTC())
+}
+
+func TestParseInvalido(t *testing.T) {
+	casos := map[string]string{
+		"* * * *":        `especificación inválida "* * * *": se esperaban 5 campos y hay 4`,
+		"60 * * * *":     `especificación inválida "60 * * * *": minuto: valor inválido "60" (debe estar entre 0 y 59)`,
+		"* * 0 * *":      `especificación inválida "* * 0 * *": día del mes: valor inválido "0" (debe estar entre 1 y 31)`,
+		"*/0 * * * *":    `especificación inválida "*/0 * * * *": minuto: paso inválido "0"`,
+		"* 10-2 * * *":   `especificación inválida "* 10-2 * * *": hora: rango vacío "10-2"`,
+		"* * * ene *":    `especificación inválida "* * * ene *": mes: valor inválido "ene" (debe estar entre 1 y 12)`,
+		"TZ=Nada/Nada *": `especificación inválida "TZ=Nada/Nada *": zona horaria "Nada/Nada": `,
+	}
+	for spec, msg := range casos {
+		_, err := Parse(spec)
+		assert.ErrorContains(t, err, msg, spec)
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !heapdebug
+
+package heap
+
+// guardia no hace nada fuera del modo de depuración. Ver debug_on.go.
+type guardia struct{}
+
+func (g *guardia) entrar(string) {}
+
+func (g *guardia) salir() {}
@@ -0,0 +1,61 @@
+//go:build heapdebug
+
+package heap
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// guardia registra qué goroutine está usando el heap y produce un panic si
+// otra goroutine lo usa al mismo tiempo. Solo se compila con el build tag
+// heapdebug:
+//
+//	go test -tags heapdebug ./...
+//
+// Detecta los accesos que se superponen en el tiempo, por lo que no
+// reemplaza a -race, pero no requiere cgo y da un mensaje más claro.
+type guardia struct {
+	mu          sync.Mutex
+	goroutine   uint64
+	operacion   string
+	anidamiento int
+}
+
+func (g *guardia) entrar(operacion string) {
+	id := idGoroutine()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.anidamiento > 0 && g.goroutine != id {
+		panic(fmt.Sprintf(Localizar(
+			"heap: acceso concurrente detectado: la goroutine %d llamó a %s mientras la goroutine %d ejecutaba %s",
+			"heap: concurrent access detected: goroutine %d called %s while goroutine %d was running %s"),
+			id, operacion, g.goroutine, g.operacion))
+	}
+	if g.anidamiento == 0 {
+		g.goroutine = id
+		g.operacion = operacion
+	}
+	g.anidamiento++
+}
+
+func (g *guardia) salir() {
+	g.mu.Lock()
+	g.anidamiento--
+	g.mu.Unlock()
+}
+
+// idGoroutine obtiene el identificador de la goroutine actual a partir de la
+// primera línea de su stack ("goroutine 7 [running]:").
+func idGoroutine() uint64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	campo := bytes.TrimPrefix(buf[:n], []byte("goroutine "))
+	campo = campo[:bytes.IndexByte(campo, ' ')]
+	id, _ := strconv.ParseUint(string(campo), 10, 64)
+
+	return id
+}
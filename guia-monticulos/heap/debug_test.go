@@ -0,0 +1,62 @@
+//go:build heapdebug
+
+package heap
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardiaDetectaAccesoConcurrente(t *testing.T) {
+	dentro := make(chan struct{})
+	continuar := make(chan struct{})
+	bloquear := false
+	h := NewGenericHeap(func(a, b int) int {
+		if bloquear {
+			bloquear = false
+			close(dentro)
+			<-continuar
+		}
+		return a - b
+	})
+	h.Insert(1)
+	bloquear = true
+
+	terminado := make(chan struct{})
+	go func() {
+		defer close(terminado)
+		h.Insert(2)
+	}()
+	<-dentro
+
+	assert.PanicsWithValue(t, "heap: acceso concurrente detectado: la goroutine "+
+		formatearID(idGoroutine())+" llamó a Size mientras la goroutine "+
+		formatearID(h.guardia.goroutine)+" ejecutaba Insert", func() { h.Size() })
+
+	close(continuar)
+	<-terminado
+	assert.Equal(t, 2, h.Size())
+}
+
+func TestGuardiaPermiteUsoSecuencialDesdeVariasGoroutines(t *testing.T) {
+	h := NewMinHeap[int]()
+	for i := 0; i < 10; i++ {
+		terminado := make(chan struct{})
+		go func(v int) {
+			defer close(terminado)
+			h.Insert(v)
+		}(i)
+		<-terminado
+	}
+
+	assert.Equal(t, 10, h.Size())
+	v, err := h.Remove()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, v)
+}
+
+func formatearID(id uint64) string {
+	return strconv.FormatUint(id, 10)
+}
@@ -0,0 +1,186 @@
+package heap
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// reloj abstrae el paso del tiempo para poder probar la DelayQueue sin esperar.
+type reloj interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type relojReal struct{}
+
+func (relojReal) Now() time.Time { return time.Now() }
+
+func (relojReal) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// demorado es un elemento de la DelayQueue con el instante desde el que se
+// puede retirar.
+type demorado[T any] struct {
+	valor T
+	listo time.Time
+	// orden de llegada, para que a igual instante salga primero el más viejo
+	seq uint64
+}
+
+// DelayQueue es una cola en la que cada elemento recién se puede retirar a
+// partir de un instante dado, y los elementos salen en el orden de esos
+// instantes. Es segura para usar desde varias goroutines. Sirve para
+// reintentos, vencimientos y tareas programadas.
+type DelayQueue[T any] struct {
+	mu       sync.Mutex
+	elements *Heap[demorado[T]]
+	seq      uint64
+	cerrada  bool
+	// se cierra y se reemplaza cada vez que cambia el estado de la cola, como
+	// en ColaBloqueante
+	aviso chan struct{}
+	reloj reloj
+}
+
+// NewDelayQueue crea una cola con demora vacía.
+//
+// Uso:
+//
+//	cola := heap.NewDelayQueue[Trabajo]()
+//	_ = cola.Put(trabajo, time.Now().Add(time.Minute))
+//
+// Retorna:
+//   - un puntero a la cola.
+func NewDelayQueue[T any]() *DelayQueue[T] {
+	return &DelayQueue[T]{
+		elements: NewGenericHeap(func(a, b demorado[T]) int {
+			if c := a.listo.Compare(b.listo); c != 0 {
+				return c
+			}
+			if a.seq < b.seq {
+				return -1
+			}
+			return 1
+		}),
+		aviso: make(chan struct{}),
+		reloj: relojReal{},
+	}
+}
+
+func (c *DelayQueue[T]) avisar() {
+	close(c.aviso)
+	c.aviso = make(chan struct{})
+}
+
+// Put agrega un elemento que se podrá retirar a partir del instante `listo`.
+// Un instante pasado hace que el elemento esté disponible enseguida.
+//
+// Retorna:
+//   - un error que envuelve a ErrColaCerrada si la cola está cerrada.
+func (c *DelayQueue[T]) Put(element T, listo time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cerrada {
+		return fmt.Errorf(Localizar("poner: %w", "put: %w"), ErrColaCerrada)
+	}
+	c.seq++
+	c.elements.Insert(demorado[T]{valor: element, listo: listo, seq: c.seq})
+	c.avisar()
+
+	return nil
+}
+
+// PutAfter agrega un elemento que se podrá retirar una vez transcurrida la
+// demora.
+//
+// Retorna:
+//   - un error que envuelve a ErrColaCerrada si la cola está cerrada.
+func (c *DelayQueue[T]) PutAfter(element T, demora time.Duration) error {
+	return c.Put(element, c.reloj.Now().Add(demora))
+}
+
+// Take retira el elemento con el instante más próximo, esperando a que haya
+// uno y a que llegue su instante.
+//
+// Uso:
+//
+//	trabajo, err := cola.Take(ctx)
+//
+// Parámetros:
+//   - `ctx` contexto para dejar de esperar.
+//
+// Retorna:
+//   - el elemento.
+//   - el error del contexto si se canceló antes, o un error que envuelve a
+//     ErrColaCerrada si la cola está cerrada y vacía. Una cola cerrada sigue
+//     entregando los elementos pendientes a medida que llega su instante.
+func (c *DelayQueue[T]) Take(ctx context.Context) (T, error) {
+	var cero T
+	for {
+		c.mu.Lock()
+		var espera <-chan time.Time
+		if c.elements.Size() > 0 {
+			cima := c.elements.elements[0]
+			demora := cima.listo.Sub(c.reloj.Now())
+			if demora <= 0 {
+				_, _ = c.elements.Remove()
+				c.mu.Unlock()
+
+				return cima.valor, nil
+			}
+			espera = c.reloj.After(demora)
+		} else if c.cerrada {
+			c.mu.Unlock()
+
+			return cero, fmt.Errorf(Localizar("tomar: %w", "take: %w"), ErrColaCerrada)
+		}
+		aviso := c.aviso
+		c.mu.Unlock()
+
+		select {
+		case <-espera:
+		case <-aviso:
+		case <-ctx.Done():
+			return cero, ctx.Err()
+		}
+	}
+}
+
+// TryTake retira el elemento con el instante más próximo si ese instante ya
+// llegó, sin esperar.
+//
+// Retorna:
+//   - el elemento.
+//   - false si no hay elementos disponibles todavía.
+func (c *DelayQueue[T]) TryTake() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.elements.Size() == 0 || c.elements.elements[0].listo.After(c.reloj.Now()) {
+		var cero T
+		return cero, false
+	}
+	d, _ := c.elements.Remove()
+
+	return d.valor, true
+}
+
+// Size retorna la cantidad de elementos en la cola, estén disponibles o no.
+func (c *DelayQueue[T]) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.elements.Size()
+}
+
+// Close cierra la cola: Put falla y Take, una vez entregados los elementos
+// pendientes, falla en lugar de esperar. Cerrar una cola cerrada no tiene
+// efecto.
+func (c *DelayQueue[T]) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.cerrada {
+		c.cerrada = true
+		c.avisar()
+	}
+}
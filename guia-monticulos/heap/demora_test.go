@@ -0,0 +1,131 @@
+package heap
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// relojManual avanza solo cuando el test lo indica.
+type relojManual struct {
+	mu      sync.Mutex
+	ahora   time.Time
+	esperas []chan time.Time
+	hastas  []time.Time
+}
+
+func (r *relojManual) Now() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ahora
+}
+
+func (r *relojManual) After(d time.Duration) <-chan time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	r.esperas = append(r.esperas, ch)
+	r.hastas = append(r.hastas, r.ahora.Add(d))
+
+	return ch
+}
+
+func (r *relojManual) avanzar(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ahora = r.ahora.Add(d)
+	for i, ch := range r.esperas {
+		if ch != nil && !r.hastas[i].After(r.ahora) {
+			ch <- r.ahora
+			r.esperas[i] = nil
+		}
+	}
+}
+
+func nuevaDelayQueueDePrueba() (*DelayQueue[string], *relojManual) {
+	r := &relojManual{ahora: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := NewDelayQueue[string]()
+	c.reloj = r
+
+	return c, r
+}
+
+func TestDelayQueueEntregaEnOrdenDeInstante(t *testing.T) {
+	c, r := nuevaDelayQueueDePrueba()
+	assert.NoError(t, c.PutAfter("tarde", 2*time.Second))
+	assert.NoError(t, c.PutAfter("pronto", time.Second))
+	assert.NoError(t, c.PutAfter("también pronto", time.Second))
+	assert.NoError(t, c.Put("ya", r.Now().Add(-time.Hour)))
+
+	v, ok := c.TryTake()
+	assert.True(t, ok)
+	assert.Equal(t, "ya", v)
+	_, ok = c.TryTake()
+	assert.False(t, ok)
+
+	r.avanzar(time.Second)
+	v, _ = c.TryTake()
+	assert.Equal(t, "pronto", v)
+	v, _ = c.TryTake()
+	assert.Equal(t, "también pronto", v)
+	assert.Equal(t, 1, c.Size())
+}
+
+func TestDelayQueueTakeEsperaElInstante(t *testing.T) {
+	c, r := nuevaDelayQueueDePrueba()
+	assert.NoError(t, c.PutAfter("a", time.Minute))
+	recibido := make(chan string)
+	go func() {
+		v, _ := c.Take(context.Background())
+		recibido <- v
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	select {
+	case <-recibido:
+		t.Fatal("Take no debería retornar antes del instante")
+	default:
+	}
+	r.avanzar(time.Minute)
+	assert.Equal(t, "a", <-recibido)
+}
+
+func TestDelayQueueTakeSeEnteraDeUnElementoMasProximo(t *testing.T) {
+	c, r := nuevaDelayQueueDePrueba()
+	assert.NoError(t, c.PutAfter("lejano", time.Hour))
+	recibido := make(chan string)
+	go func() {
+		v, _ := c.Take(context.Background())
+		recibido <- v
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	assert.NoError(t, c.PutAfter("cercano", time.Second))
+	time.Sleep(10 * time.Millisecond)
+	r.avanzar(time.Second)
+	assert.Equal(t, "cercano", <-recibido)
+}
+
+func TestDelayQueueCerradaYCancelada(t *testing.T) {
+	c, r := nuevaDelayQueueDePrueba()
+	assert.NoError(t, c.PutAfter("pendiente", time.Second))
+	c.Close()
+
+	assert.ErrorIs(t, c.Put("otro", r.Now()), ErrColaCerrada)
+	r.avanzar(time.Second)
+	v, err := c.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "pendiente", v)
+	_, err = c.Take(context.Background())
+	assert.ErrorIs(t, err, ErrColaCerrada)
+
+	c2, _ := nuevaDelayQueueDePrueba()
+	ctx, cancelar := context.WithCancel(context.Background())
+	cancelar()
+	_, err = c2.Take(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,212 @@
+// Package depscheduler arma el cronograma de un conjunto de tareas con
+// dependencias. Una tarea se habilita cuando terminan todas sus dependencias
+// (orden topológico, algoritmo de Kahn) y, entre las habilitadas, los
+// trabajadores libres toman primero las de mayor prioridad usando un heap.
+package depscheduler
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+var (
+	// ErrTareaInvalida indica una tarea repetida, sin nombre o con duración negativa.
+	ErrTareaInvalida = errors.New("tarea inválida")
+	// ErrDependenciaDesconocida indica una dependencia que no es ninguna de las tareas.
+	ErrDependenciaDesconocida = errors.New("dependencia desconocida")
+	// ErrCiclo indica que las dependencias forman un ciclo.
+	ErrCiclo = errors.New("las dependencias forman un ciclo")
+)
+
+// Tarea es un trabajo a planificar.
+type Tarea struct {
+	Nombre string
+	// Prioridad decide entre tareas habilitadas al mismo tiempo; mayor es
+	// más prioritaria. A igual prioridad se toma la de menor nombre.
+	Prioridad int
+	// Duracion es el tiempo que ocupa a un trabajador.
+	Duracion int
+	// Dependencias son los nombres de las tareas que deben terminar antes.
+	Dependencias []string
+}
+
+// Asignacion indica qué trabajador ejecuta una tarea y cuándo.
+type Asignacion struct {
+	Tarea      string
+	Trabajador int
+	Inicio     int
+	Fin        int
+}
+
+// Cronograma es el resultado de planificar, con las asignaciones ordenadas
+// por inicio (y por trabajador a igual inicio).
+type Cronograma struct {
+	Asignaciones []Asignacion
+	// Duracion es el instante en que termina la última tarea.
+	Duracion int
+}
+
+// Orden retorna los nombres de las tareas en el orden en que empiezan.
+func (c Cronograma) Orden() []string {
+	orden := make([]string, len(c.Asignaciones))
+	for i, a := range c.Asignaciones {
+		orden[i] = a.Tarea
+	}
+
+	return orden
+}
+
+// finalizacion es una tarea en curso, en el heap de eventos.
+type finalizacion struct {
+	fin        int
+	trabajador int
+	tarea      string
+}
+
+// Planificar arma el cronograma de las tareas con la cantidad de trabajadores
+// indicada. Cada vez que un trabajador queda libre toma la tarea habilitada
+// de mayor prioridad; si no hay ninguna, espera a que termine otra tarea.
+//
+// Uso:
+//
+//	c, err := depscheduler.Planificar([]depscheduler.Tarea{
+//		{Nombre: "compilar", Prioridad: 1, Duracion: 3},
+//		{Nombre: "testear", Prioridad: 1, Duracion: 2, Dependencias: []string{"compilar"}},
+//	}, 2)
+//
+// Parámetros:
+//   - `tareas` tareas a planificar.
+//   - `trabajadores` cantidad de tareas que se pueden ejecutar a la vez.
+//
+// Retorna:
+//   - el cronograma.
+//   - un error que envuelve a ErrTareaInvalida, ErrDependenciaDesconocida o
+//     ErrCiclo si las tareas no se pueden planificar.
+func Planificar(tareas []Tarea, trabajadores int) (Cronograma, error) {
+	if trabajadores < 1 {
+		return Cronograma{}, fmt.Errorf("cantidad de trabajadores inválida: %d", trabajadores)
+	}
+	porNombre, err := indexar(tareas)
+	if err != nil {
+		return Cronograma{}, err
+	}
+
+	// faltan[t] es la cantidad de dependencias de t que no terminaron
+	faltan := make(map[string]int, len(tareas))
+	dependientes := make(map[string][]string, len(tareas))
+	habilitadas := heap.NewGenericHeap(func(a, b Tarea) int {
+		if a.Prioridad != b.Prioridad {
+			return b.Prioridad - a.Prioridad
+		}
+		return strings.Compare(a.Nombre, b.Nombre)
+	})
+	for _, t := range tareas {
+		faltan[t.Nombre] = len(t.Dependencias)
+		for _, d := range t.Dependencias {
+			dependientes[d] = append(dependientes[d], t.Nombre)
+		}
+		if len(t.Dependencias) == 0 {
+			habilitadas.Insert(t)
+		}
+	}
+
+	enCurso := heap.NewGenericHeap(func(a, b finalizacion) int {
+		if a.fin != b.fin {
+			return a.fin - b.fin
+		}
+		return a.trabajador - b.trabajador
+	})
+	libres := heap.NewMinHeap[int]()
+	for i := 1; i <= trabajadores; i++ {
+		libres.Insert(i)
+	}
+
+	var c Cronograma
+	ahora := 0
+	for habilitadas.Size() > 0 || enCurso.Size() > 0 {
+		for habilitadas.Size() > 0 && libres.Size() > 0 {
+			t, _ := habilitadas.Remove()
+			w, _ := libres.Remove()
+			c.Asignaciones = append(c.Asignaciones, Asignacion{Tarea: t.Nombre, Trabajador: w, Inicio: ahora, Fin: ahora + t.Duracion})
+			enCurso.Insert(finalizacion{fin: ahora + t.Duracion, trabajador: w, tarea: t.Nombre})
+		}
+		if enCurso.Size() == 0 {
+			break
+		}
+
+		// avanzar hasta la próxima finalización y liberar todo lo que termina ahí
+		f, _ := enCurso.Remove()
+		ahora = f.fin
+		for {
+			libres.Insert(f.trabajador)
+			c.Duracion = max(c.Duracion, f.fin)
+			for _, d := range dependientes[f.tarea] {
+				faltan[d]--
+				if faltan[d] == 0 {
+					habilitadas.Insert(porNombre[d])
+				}
+			}
+			if enCurso.Size() == 0 {
+				break
+			}
+			siguiente, _ := enCurso.Remove()
+			if siguiente.fin != ahora {
+				enCurso.Insert(siguiente)
+				break
+			}
+			f = siguiente
+		}
+	}
+
+	if len(c.Asignaciones) < len(tareas) {
+		return Cronograma{}, fmt.Errorf("%w: %s", ErrCiclo, strings.Join(bloqueadas(faltan), ", "))
+	}
+	sort.SliceStable(c.Asignaciones, func(i, j int) bool {
+		a, b := c.Asignaciones[i], c.Asignaciones[j]
+		if a.Inicio != b.Inicio {
+			return a.Inicio < b.Inicio
+		}
+		return a.Trabajador < b.Trabajador
+	})
+
+	return c, nil
+}
+
+func indexar(tareas []Tarea) (map[string]Tarea, error) {
+	porNombre := make(map[string]Tarea, len(tareas))
+	for _, t := range tareas {
+		if t.Nombre == "" || t.Duracion < 0 {
+			return nil, fmt.Errorf("%w: %q con duración %d", ErrTareaInvalida, t.Nombre, t.Duracion)
+		}
+		if _, ok := porNombre[t.Nombre]; ok {
+			return nil, fmt.Errorf("%w: %q está repetida", ErrTareaInvalida, t.Nombre)
+		}
+		porNombre[t.Nombre] = t
+	}
+	for _, t := range tareas {
+		for _, d := range t.Dependencias {
+			if _, ok := porNombre[d]; !ok {
+				return nil, fmt.Errorf("%w: %q depende de %q", ErrDependenciaDesconocida, t.Nombre, d)
+			}
+		}
+	}
+
+	return porNombre, nil
+}
+
+// bloqueadas retorna, ordenadas, las tareas que nunca se habilitaron.
+func bloqueadas(faltan map[string]int) []string {
+	var r []string
+	for nombre, n := range faltan {
+		if n > 0 {
+			r = append(r, nombre)
+		}
+	}
+	sort.Strings(r)
+
+	return r
+}
@@ -0,0 +1,75 @@
+package depscheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanificarUnTrabajadorRespetaDependenciasYPrioridad(t *testing.T) {
+	tareas := []Tarea{
+		{Nombre: "deploy", Prioridad: 1, Duracion: 1, Dependencias: []string{"tests", "docs"}},
+		{Nombre: "tests", Prioridad: 5, Duracion: 2, Dependencias: []string{"build"}},
+		{Nombre: "docs", Prioridad: 2, Duracion: 1},
+		{Nombre: "build", Prioridad: 3, Duracion: 3},
+		{Nombre: "lint", Prioridad: 3, Duracion: 1},
+	}
+
+	c, err := Planificar(tareas, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"build", "tests", "lint", "docs", "deploy"}, c.Orden())
+	assert.Equal(t, 8, c.Duracion)
+	assert.Equal(t, Asignacion{Tarea: "tests", Trabajador: 1, Inicio: 3, Fin: 5}, c.Asignaciones[1])
+}
+
+func TestPlanificarVariosTrabajadores(t *testing.T) {
+	tareas := []Tarea{
+		{Nombre: "a", Prioridad: 1, Duracion: 4},
+		{Nombre: "b", Prioridad: 2, Duracion: 2},
+		{Nombre: "c", Prioridad: 3, Duracion: 1, Dependencias: []string{"b"}},
+		{Nombre: "d", Prioridad: 0, Duracion: 2, Dependencias: []string{"a", "c"}},
+	}
+
+	c, err := Planificar(tareas, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Asignacion{
+		{Tarea: "b", Trabajador: 1, Inicio: 0, Fin: 2},
+		{Tarea: "a", Trabajador: 2, Inicio: 0, Fin: 4},
+		{Tarea: "c", Trabajador: 1, Inicio: 2, Fin: 3},
+		{Tarea: "d", Trabajador: 1, Inicio: 4, Fin: 6},
+	}, c.Asignaciones)
+	assert.Equal(t, 6, c.Duracion)
+}
+
+func TestPlanificarSinTareas(t *testing.T) {
+	c, err := Planificar(nil, 3)
+
+	assert.NoError(t, err)
+	assert.Empty(t, c.Asignaciones)
+	assert.Zero(t, c.Duracion)
+}
+
+func TestPlanificarErrores(t *testing.T) {
+	_, err := Planificar([]Tarea{
+		{Nombre: "a", Dependencias: []string{"c"}},
+		{Nombre: "b", Dependencias: []string{"a"}},
+		{Nombre: "c", Dependencias: []string{"b"}},
+		{Nombre: "libre"},
+	}, 1)
+	assert.ErrorIs(t, err, ErrCiclo)
+	assert.EqualError(t, err, "las dependencias forman un ciclo: a, b, c")
+
+	_, err = Planificar([]Tarea{{Nombre: "a", Dependencias: []string{"x"}}}, 1)
+	assert.EqualError(t, err, `dependencia desconocida: "a" depende de "x"`)
+
+	_, err = Planificar([]Tarea{{Nombre: "a"}, {Nombre: "a"}}, 1)
+	assert.ErrorIs(t, err, ErrTareaInvalida)
+
+	_, err = Planificar([]Tarea{{Nombre: "a", Duracion: -1}}, 1)
+	assert.ErrorIs(t, err, ErrTareaInvalida)
+
+	_, err = Planificar(nil, 0)
+	assert.EqualError(t, err, "cantidad de trabajadores inválida: 0")
+}
@@ -0,0 +1,192 @@
+package heap
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ErrOperacionInesperada indica que al aplicar una secuencia de operaciones
+// un Remove no retiró el elemento esperado.
+var ErrOperacionInesperada error = &errorLocalizado{es: "operación inesperada", en: "unexpected operation"}
+
+// TipoOperacion distingue las operaciones de un diff.
+type TipoOperacion int
+
+const (
+	// OpInsert inserta Operacion.Valor.
+	OpInsert TipoOperacion = iota
+	// OpRemove retira la cima del heap, que debe ser equivalente a Operacion.Valor.
+	OpRemove
+)
+
+// String retorna el nombre de la operación.
+func (t TipoOperacion) String() string {
+	if t == OpRemove {
+		return "Remove"
+	}
+
+	return "Insert"
+}
+
+// Operacion es un paso de la secuencia calculada por Diff.
+type Operacion[T any] struct {
+	Tipo  TipoOperacion
+	Valor T
+}
+
+// String retorna la operación como una llamada, por ejemplo "Insert(4)".
+func (o Operacion[T]) String() string {
+	if o.Tipo == OpRemove {
+		return fmt.Sprintf("Remove() = %v", o.Valor)
+	}
+
+	return fmt.Sprintf("Insert(%v)", o.Valor)
+}
+
+// Diff calcula una secuencia de Insert y Remove que transforma `origen` en
+// un heap con los mismos elementos que `destino`. Como Remove solo retira la
+// cima, para quitar un elemento hay que retirar antes todos los que salen
+// antes que él; la secuencia retira el prefijo más corto que contiene a los
+// elementos sobrantes, vuelve a insertar los que hay que conservar e inserta
+// los que faltan, lo que da la menor cantidad de operaciones posible con esas
+// dos primitivas.
+//
+// Los elementos se comparan con la función de comparación de `origen`, que
+// debe ser la misma que la de `destino`: dos elementos para los que la
+// comparación da cero se consideran iguales. Un heap nil se toma como vacío.
+//
+// Uso:
+//
+//	ops := heap.Diff(actual, esperado)
+//	err := actual.Aplicar(ops)
+//
+// Parámetros:
+//   - `origen` heap de partida. No se modifica.
+//   - `destino` heap al que se quiere llegar. No se modifica.
+//
+// Retorna:
+//   - las operaciones, en el orden en que hay que aplicarlas. Si los heaps
+//     tienen los mismos elementos la secuencia es vacía.
+func Diff[T any](origen, destino *Heap[T]) []Operacion[T] {
+	compare := origen.comparador(destino)
+	if compare == nil {
+		return nil
+	}
+	a := ordenados(origen, compare)
+	b := ordenados(destino, compare)
+
+	// se recorren ambos en orden marcando qué elementos de `a` se conservan
+	conservado := make([]bool, len(a))
+	var faltantes []T
+	ultimoSobrante := -1
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j == len(b) || i < len(a) && compare(a[i], b[j]) < 0:
+			ultimoSobrante = i
+			i++
+		case i == len(a) || compare(a[i], b[j]) > 0:
+			faltantes = append(faltantes, b[j])
+			j++
+		default:
+			// entre elementos equivalentes conviene que sobren los primeros,
+			// así el prefijo a retirar es más corto
+			ra, rb := largoDeRacha(a, i, compare), largoDeRacha(b, j, compare)
+			for k := 0; k < ra; k++ {
+				if k < ra-rb {
+					ultimoSobrante = i + k
+				} else {
+					conservado[i+k] = true
+				}
+			}
+			faltantes = append(faltantes, b[j+min(ra, rb):j+rb]...)
+			i += ra
+			j += rb
+		}
+	}
+
+	var ops []Operacion[T]
+	for _, v := range a[:ultimoSobrante+1] {
+		ops = append(ops, Operacion[T]{Tipo: OpRemove, Valor: v})
+	}
+	for k, v := range a[:ultimoSobrante+1] {
+		if conservado[k] {
+			ops = append(ops, Operacion[T]{Tipo: OpInsert, Valor: v})
+		}
+	}
+	for _, v := range faltantes {
+		ops = append(ops, Operacion[T]{Tipo: OpInsert, Valor: v})
+	}
+
+	return ops
+}
+
+// comparador retorna la función de comparación del primero de los heaps que
+// no sea nil.
+func (m *Heap[T]) comparador(otro *Heap[T]) func(a T, b T) int {
+	if m != nil {
+		return m.compare
+	}
+	if otro != nil {
+		return otro.compare
+	}
+
+	return nil
+}
+
+// largoDeRacha retorna cuántos elementos equivalentes a elementos[i] hay a
+// partir de i.
+func largoDeRacha[T any](elementos []T, i int, compare func(a T, b T) int) int {
+	n := 1
+	for i+n < len(elementos) && compare(elementos[i], elementos[i+n]) == 0 {
+		n++
+	}
+
+	return n
+}
+
+// ordenados retorna los elementos del heap en el orden en que saldrían.
+func ordenados[T any](m *Heap[T], compare func(a T, b T) int) []T {
+	elementos := m.Values()
+	slices.SortStableFunc(elementos, compare)
+
+	return elementos
+}
+
+// Aplicar ejecuta una secuencia de operaciones como la que calcula Diff. Cada
+// Remove verifica que el elemento retirado sea equivalente al esperado, de
+// modo que aplicar un diff a un heap distinto del de origen se detecta en
+// lugar de dejarlo en un estado inesperado.
+//
+// Uso:
+//
+//	err := heap.Aplicar(heap.Diff(heap, esperado))
+//
+// Parámetros:
+//   - `ops` operaciones a aplicar.
+//
+// Retorna:
+//   - un error que envuelve a ErrHeapVacio si un Remove encuentra el heap
+//     vacío, o a ErrOperacionInesperada si retira otro elemento. Las
+//     operaciones anteriores a la que falló quedan aplicadas.
+func (m *Heap[T]) Aplicar(ops []Operacion[T]) error {
+	if m == nil {
+		return fmt.Errorf(Localizar("aplicar: %w", "apply: %w"), ErrHeapNil)
+	}
+	for i, op := range ops {
+		if op.Tipo == OpInsert {
+			m.Insert(op.Valor)
+			continue
+		}
+		v, err := m.Remove()
+		if err != nil {
+			return fmt.Errorf(Localizar("aplicar: operación %d: %w", "apply: operation %d: %w"), i, err)
+		}
+		if m.compare(v, op.Valor) != 0 {
+			return fmt.Errorf(Localizar("aplicar: operación %d: %w: se esperaba %v y salió %v",
+				"apply: operation %d: %w: expected %v, got %v"), i, ErrOperacionInesperada, op.Valor, v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package heap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func heapDeMinimos(valores ...int) *Heap[int] {
+	h := NewMinHeap[int]()
+	for _, v := range valores {
+		h.Insert(v)
+	}
+
+	return h
+}
+
+func extraerTodos[T any](h *Heap[T]) []T {
+	var salida []T
+	for h.Size() > 0 {
+		v, _ := h.Remove()
+		salida = append(salida, v)
+	}
+
+	return salida
+}
+
+func TestDiffHeapsIguales(t *testing.T) {
+	assert.Empty(t, Diff(heapDeMinimos(3, 1, 2), heapDeMinimos(1, 2, 3)))
+}
+
+func TestDiffSoloInserta(t *testing.T) {
+	ops := Diff(heapDeMinimos(1, 3), heapDeMinimos(1, 2, 3, 4))
+
+	assert.Equal(t, []Operacion[int]{
+		{Tipo: OpInsert, Valor: 2},
+		{Tipo: OpInsert, Valor: 4},
+	}, ops)
+}
+
+func TestDiffRetiraElPrefijoNecesario(t *testing.T) {
+	// para quitar el 5 hay que retirar antes el 1 y el 3
+	ops := Diff(heapDeMinimos(1, 3, 5, 7), heapDeMinimos(1, 3, 7, 8))
+
+	assert.Equal(t, []Operacion[int]{
+		{Tipo: OpRemove, Valor: 1},
+		{Tipo: OpRemove, Valor: 3},
+		{Tipo: OpRemove, Valor: 5},
+		{Tipo: OpInsert, Valor: 1},
+		{Tipo: OpInsert, Valor: 3},
+		{Tipo: OpInsert, Valor: 8},
+	}, ops)
+	assert.Equal(t, "Remove() = 1", ops[0].String())
+	assert.Equal(t, "Insert(8)", ops[5].String())
+}
+
+func TestDiffConDuplicados(t *testing.T) {
+	ops := Diff(heapDeMinimos(2, 2, 2), heapDeMinimos(2, 2))
+
+	assert.Equal(t, []Operacion[int]{{Tipo: OpRemove, Valor: 2}}, ops)
+}
+
+func TestDiffYAplicarLlegaAlDestino(t *testing.T) {
+	casos := []struct{ origen, destino []int }{
+		{nil, []int{4, 1}},
+		{[]int{4, 1}, nil},
+		{[]int{9, 3, 3, 7, 1}, []int{3, 8, 7, 7, 0}},
+		{[]int{5, 4, 3, 2, 1}, []int{10, 5}},
+	}
+	for _, c := range casos {
+		origen, destino := NewMaxHeap[int](), NewMaxHeap[int]()
+		for _, v := range c.origen {
+			origen.Insert(v)
+		}
+		for _, v := range c.destino {
+			destino.Insert(v)
+		}
+
+		err := origen.Aplicar(Diff(origen, destino))
+
+		assert.NoError(t, err)
+		assert.Equal(t, extraerTodos(destino.Clone()), extraerTodos(origen))
+	}
+}
+
+func TestDiffConHeapsNil(t *testing.T) {
+	var nulo *Heap[int]
+
+	assert.Nil(t, Diff(nulo, nulo))
+	assert.Equal(t, []Operacion[int]{{Tipo: OpRemove, Valor: 1}}, Diff(heapDeMinimos(1), nulo))
+	assert.Equal(t, []Operacion[int]{{Tipo: OpInsert, Valor: 1}}, Diff(nulo, heapDeMinimos(1)))
+}
+
+func TestAplicarDetectaUnHeapDistinto(t *testing.T) {
+	ops := Diff(heapDeMinimos(1, 2), heapDeMinimos(2))
+	otro := heapDeMinimos(0, 2)
+
+	err := otro.Aplicar(ops)
+
+	assert.ErrorIs(t, err, ErrOperacionInesperada)
+	assert.EqualError(t, err, "aplicar: operación 0: operación inesperada: se esperaba 1 y salió 0")
+
+	err = NewMinHeap[int]().Aplicar(ops)
+	assert.ErrorIs(t, err, ErrHeapVacio)
+
+	var nulo *Heap[int]
+	assert.ErrorIs(t, nulo.Aplicar(ops), ErrHeapNil)
+}
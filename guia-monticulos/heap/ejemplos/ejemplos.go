@@ -0,0 +1,111 @@
+// Package ejemplos reúne los tipos de dominio que aparecen en los
+// enunciados de la guía (pacientes de una guardia, procesos de un sistema
+// operativo y pedidos de un comercio), con sus funciones de comparación y
+// constructores de heaps ya armados, para que los trabajos prácticos y los
+// tests de la cátedra partan de la misma base. Los pacientes son los del
+// paquete triage.
+package ejemplos
+
+import (
+	"cmp"
+	"time"
+
+	"untref/ayp2/monticulo/heap"
+	"untref/ayp2/monticulo/heap/triage"
+)
+
+// CompararPacientes ordena primero a los pacientes más graves y, a igual
+// gravedad, al que llegó antes. A diferencia de triage.Cola, no tiene en
+// cuenta el tiempo de espera.
+func CompararPacientes(a, b triage.Paciente) int {
+	if c := cmp.Compare(b.Gravedad, a.Gravedad); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.Llegada, b.Llegada)
+}
+
+// NuevaGuardia crea la cola de prioridad de la guardia.
+//
+// Uso:
+//
+//	guardia := ejemplos.NuevaGuardia()
+//	guardia.Insert(triage.Paciente{Nombre: "Ana", Gravedad: 5, Llegada: 1})
+//
+// Retorna:
+//   - un heap ordenado con CompararPacientes.
+func NuevaGuardia() *heap.Heap[triage.Paciente] {
+	return heap.NewGenericHeap(CompararPacientes)
+}
+
+// Proceso es un proceso listo para ejecutar en un planificador de CPU.
+type Proceso struct {
+	PID int
+	// Prioridad sigue la convención de Unix: un valor menor es más prioritario.
+	Prioridad int
+	// Rafaga es el tiempo de CPU que necesita el proceso.
+	Rafaga time.Duration
+}
+
+// CompararProcesos ordena por prioridad, a igual prioridad primero la ráfaga
+// más corta (SJF) y por último por PID.
+func CompararProcesos(a, b Proceso) int {
+	if c := cmp.Compare(a.Prioridad, b.Prioridad); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Rafaga, b.Rafaga); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.PID, b.PID)
+}
+
+// NuevaColaDeProcesos crea la cola de listos del planificador.
+//
+// Uso:
+//
+//	listos := ejemplos.NuevaColaDeProcesos()
+//
+// Retorna:
+//   - un heap ordenado con CompararProcesos.
+func NuevaColaDeProcesos() *heap.Heap[Proceso] {
+	return heap.NewGenericHeap(CompararProcesos)
+}
+
+// Pedido es un pedido pendiente de despacho.
+type Pedido struct {
+	ID      int
+	Cliente string
+	Monto   float64
+	// Express indica que el pedido se despacha antes que los comunes.
+	Express     bool
+	Vencimiento time.Time
+}
+
+// CompararPedidos ordena primero los pedidos express, después los que vencen
+// antes y por último por ID.
+func CompararPedidos(a, b Pedido) int {
+	if a.Express != b.Express {
+		if a.Express {
+			return -1
+		}
+		return 1
+	}
+	if c := a.Vencimiento.Compare(b.Vencimiento); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.ID, b.ID)
+}
+
+// NuevaColaDePedidos crea la cola de despacho.
+//
+// Uso:
+//
+//	despacho := ejemplos.NuevaColaDePedidos()
+//
+// Retorna:
+//   - un heap ordenado con CompararPedidos.
+func NuevaColaDePedidos() *heap.Heap[Pedido] {
+	return heap.NewGenericHeap(CompararPedidos)
+}
@@ -0,0 +1,65 @@
+package ejemplos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"untref/ayp2/monticulo/heap"
+	"untref/ayp2/monticulo/heap/triage"
+)
+
+func extraer[T any](h *heap.Heap[T]) []T {
+	var salida []T
+	for h.Size() > 0 {
+		v, _ := h.Remove()
+		salida = append(salida, v)
+	}
+
+	return salida
+}
+
+func TestGuardiaAtiendePorGravedadYLlegada(t *testing.T) {
+	guardia := NuevaGuardia()
+	guardia.Insert(triage.Paciente{Nombre: "Ana", Gravedad: 2, Llegada: 1})
+	guardia.Insert(triage.Paciente{Nombre: "Beto", Gravedad: 5, Llegada: 2})
+	guardia.Insert(triage.Paciente{Nombre: "Carla", Gravedad: 2, Llegada: 0})
+	guardia.Insert(triage.Paciente{Nombre: "Dani", Gravedad: 5, Llegada: 3})
+
+	var nombres []string
+	for _, p := range extraer(guardia) {
+		nombres = append(nombres, p.Nombre)
+	}
+	assert.Equal(t, []string{"Beto", "Dani", "Carla", "Ana"}, nombres)
+}
+
+func TestColaDeProcesos(t *testing.T) {
+	listos := NuevaColaDeProcesos()
+	listos.Insert(Proceso{PID: 10, Prioridad: 0, Rafaga: 30 * time.Millisecond})
+	listos.Insert(Proceso{PID: 11, Prioridad: -5, Rafaga: time.Second})
+	listos.Insert(Proceso{PID: 12, Prioridad: 0, Rafaga: 10 * time.Millisecond})
+	listos.Insert(Proceso{PID: 9, Prioridad: 0, Rafaga: 10 * time.Millisecond})
+
+	var pids []int
+	for _, p := range extraer(listos) {
+		pids = append(pids, p.PID)
+	}
+	assert.Equal(t, []int{11, 9, 12, 10}, pids)
+}
+
+func TestColaDePedidos(t *testing.T) {
+	hoy := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	despacho := NuevaColaDePedidos()
+	despacho.Insert(Pedido{ID: 1, Vencimiento: hoy.AddDate(0, 0, 1)})
+	despacho.Insert(Pedido{ID: 2, Vencimiento: hoy.AddDate(0, 0, 5), Express: true})
+	despacho.Insert(Pedido{ID: 3, Vencimiento: hoy})
+	despacho.Insert(Pedido{ID: 4, Vencimiento: hoy.AddDate(0, 0, 2), Express: true})
+	despacho.Insert(Pedido{ID: 5, Vencimiento: hoy})
+
+	var ids []int
+	for _, p := range extraer(despacho) {
+		ids = append(ids, p.ID)
+	}
+	assert.Equal(t, []int{4, 2, 3, 5, 1}, ids)
+}
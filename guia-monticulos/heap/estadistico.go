@@ -0,0 +1,127 @@
+package heap
+
+// Numero es el constraint de los tipos numéricos que acepta HeapEstadistico.
+type Numero interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Resumen son las estadísticas de los elementos de un HeapEstadistico. La
+// suma es un float64 para que no desborde con tipos chicos como int8.
+type Resumen[T Numero] struct {
+	Cantidad int
+	Suma     float64
+	Minimo   T
+	Maximo   T
+	Promedio float64
+}
+
+// HeapEstadistico es un heap de mínimos o de máximos que mantiene la suma y
+// los extremos de sus elementos a medida que se insertan y se retiran, de modo
+// que Stats es O(1) y no necesita recorrer el arreglo.
+//
+// Uno de los extremos es siempre la cima. El otro solo puede cambiar al
+// insertar: como Remove retira la cima, si el elemento retirado fuera también
+// el otro extremo todos los elementos serían iguales y el extremo seguiría
+// siendo el mismo. Por eso el heap solo se construye con orden natural.
+type HeapEstadistico[T Numero] struct {
+	elements *Heap[T]
+	// se acumula en float64 porque en T podría desbordar, por ejemplo con int8
+	suma float64
+	// el extremo opuesto a la cima: el máximo en un heap de mínimos y
+	// viceversa
+	opuesto T
+	// indica si es un heap de máximos
+	deMaximos bool
+}
+
+// NewMinHeapEstadistico crea un heap de mínimos con estadísticas.
+//
+// Uso:
+//
+//	espera := heap.NewMinHeapEstadistico[float64]()
+//
+// Retorna:
+//   - un puntero al heap.
+func NewMinHeapEstadistico[T Numero]() *HeapEstadistico[T] {
+	return &HeapEstadistico[T]{elements: NewMinHeap[T]()}
+}
+
+// NewMaxHeapEstadistico crea un heap de máximos con estadísticas.
+//
+// Uso:
+//
+//	prioridades := heap.NewMaxHeapEstadistico[int]()
+//
+// Retorna:
+//   - un puntero al heap.
+func NewMaxHeapEstadistico[T Numero]() *HeapEstadistico[T] {
+	return &HeapEstadistico[T]{elements: NewMaxHeap[T](), deMaximos: true}
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (h *HeapEstadistico[T]) Size() int {
+	return h.elements.Size()
+}
+
+// Insert agrega un elemento y actualiza las estadísticas en O(1), además del
+// O(log n) de la inserción.
+func (h *HeapEstadistico[T]) Insert(element T) {
+	if h.elements.Size() == 0 || h.elements.Compare(element, h.opuesto) > 0 {
+		h.opuesto = element
+	}
+	h.suma += float64(element)
+	h.elements.Insert(element)
+}
+
+// Remove elimina y retorna el elemento en la cima del heap.
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (h *HeapEstadistico[T]) Remove() (T, error) {
+	element, err := h.elements.Remove()
+	if err != nil {
+		return element, err
+	}
+	h.suma -= float64(element)
+	if h.elements.Size() == 0 {
+		// evita arrastrar el error de redondeo de los float de un uso al siguiente
+		var cero T
+		h.suma, h.opuesto = 0, cero
+	}
+
+	return element, nil
+}
+
+// Stats retorna la cantidad, la suma, el mínimo, el máximo y el promedio de
+// los elementos, en O(1). Con el heap vacío todos los campos son cero.
+//
+// La suma se mantiene en float64 sumando y restando, por lo que con elementos
+// de punto flotante puede acumular error de redondeo tras muchas operaciones,
+// y con enteros de más de 53 bits puede perder precisión.
+//
+// Uso:
+//
+//	r := espera.Stats()
+//	fmt.Printf("%d en cola, espera promedio %.1f\n", r.Cantidad, r.Promedio)
+func (h *HeapEstadistico[T]) Stats() Resumen[T] {
+	n := h.elements.Size()
+	if n == 0 {
+		return Resumen[T]{}
+	}
+	cima := h.elements.elements[0]
+	r := Resumen[T]{
+		Cantidad: n,
+		Suma:     h.suma,
+		Minimo:   cima,
+		Maximo:   h.opuesto,
+		Promedio: h.suma / float64(n),
+	}
+	if h.deMaximos {
+		r.Minimo, r.Maximo = r.Maximo, r.Minimo
+	}
+
+	return r
+}
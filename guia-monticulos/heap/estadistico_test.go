@@ -0,0 +1,84 @@
+package heap
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeapEstadisticoVacio(t *testing.T) {
+	h := NewMinHeapEstadistico[int]()
+
+	assert.Equal(t, Resumen[int]{}, h.Stats())
+	_, err := h.Remove()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+}
+
+func TestHeapEstadisticoDeMinimos(t *testing.T) {
+	h := NewMinHeapEstadistico[int]()
+	for _, v := range []int{5, 2, 9, 4} {
+		h.Insert(v)
+	}
+
+	assert.Equal(t, Resumen[int]{Cantidad: 4, Suma: 20, Minimo: 2, Maximo: 9, Promedio: 5}, h.Stats())
+
+	v, err := h.Remove()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, Resumen[int]{Cantidad: 3, Suma: 18, Minimo: 4, Maximo: 9, Promedio: 6}, h.Stats())
+}
+
+func TestHeapEstadisticoNoDesbordaConTiposChicos(t *testing.T) {
+	h := NewMinHeapEstadistico[int8]()
+	h.Insert(100)
+	h.Insert(100)
+
+	assert.Equal(t, Resumen[int8]{Cantidad: 2, Suma: 200, Minimo: 100, Maximo: 100, Promedio: 100}, h.Stats())
+
+	_, _ = h.Remove()
+	assert.Equal(t, 100.0, h.Stats().Suma)
+}
+
+func TestHeapEstadisticoDeMaximos(t *testing.T) {
+	h := NewMaxHeapEstadistico[float64]()
+	for _, v := range []float64{1.5, 3, 0.5} {
+		h.Insert(v)
+	}
+	_, _ = h.Remove()
+
+	r := h.Stats()
+	assert.Equal(t, 2, r.Cantidad)
+	assert.Equal(t, 0.5, r.Minimo)
+	assert.Equal(t, 1.5, r.Maximo)
+	assert.InDelta(t, 1.0, r.Promedio, 1e-9)
+}
+
+func TestHeapEstadisticoCoincideConRecorrerElArreglo(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for _, h := range []*HeapEstadistico[int]{NewMinHeapEstadistico[int](), NewMaxHeapEstadistico[int]()} {
+		for i := 0; i < 2000; i++ {
+			if r.Intn(3) == 0 {
+				_, _ = h.Remove()
+			} else {
+				h.Insert(r.Intn(50) - 25)
+			}
+			if h.Size() == 0 {
+				assert.Equal(t, Resumen[int]{}, h.Stats())
+				continue
+			}
+
+			elementos := h.elements.Values()
+			suma, minimo, maximo := 0, elementos[0], elementos[0]
+			for _, e := range elementos {
+				suma += e
+				minimo, maximo = min(minimo, e), max(maximo, e)
+			}
+			s := h.Stats()
+			assert.Equal(t, len(elementos), s.Cantidad)
+			assert.Equal(t, float64(suma), s.Suma)
+			assert.Equal(t, minimo, s.Minimo)
+			assert.Equal(t, maximo, s.Maximo)
+		}
+	}
+}
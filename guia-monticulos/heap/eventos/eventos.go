@@ -0,0 +1,114 @@
+// Package eventos implementa un motor de simulación de eventos discretos. Los
+// eventos pendientes se guardan en un heap de mínimos ordenado por el instante
+// en que ocurren, y el reloj de la simulación salta de un evento al siguiente.
+package eventos
+
+import (
+	"fmt"
+	"math"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// evento es una acción programada para un instante de la simulación.
+type evento struct {
+	tiempo float64
+	// orden de programación, para que los eventos simultáneos ocurran en el
+	// orden en que se programaron
+	secuencia int
+	accion    func()
+}
+
+func compararEventos(a, b evento) int {
+	if a.tiempo < b.tiempo {
+		return -1
+	}
+	if a.tiempo > b.tiempo {
+		return 1
+	}
+
+	return a.secuencia - b.secuencia
+}
+
+// Simulacion es el motor de eventos. No es seguro usarlo desde varias
+// goroutines; las acciones corren en la goroutine que llama a Ejecutar.
+type Simulacion struct {
+	ahora      float64
+	pendientes *heap.Heap[evento]
+	secuencia  int
+}
+
+// New crea una simulación sin eventos, con el reloj en cero.
+//
+// Uso:
+//
+//	sim := eventos.New()
+//
+// Retorna:
+//   - un puntero a la simulación.
+func New() *Simulacion {
+	return &Simulacion{pendientes: heap.NewGenericHeap(compararEventos)}
+}
+
+// Ahora retorna el instante actual de la simulación.
+func (s *Simulacion) Ahora() float64 {
+	return s.ahora
+}
+
+// Pendientes retorna la cantidad de eventos que todavía no ocurrieron.
+func (s *Simulacion) Pendientes() int {
+	return s.pendientes.Size()
+}
+
+// Programar agrega un evento que ocurrirá `demora` unidades de tiempo
+// después del instante actual. Se puede llamar desde una acción.
+//
+// Uso:
+//
+//	err := sim.Programar(2.5, func() { fmt.Println("llegó un cliente") })
+//
+// Parámetros:
+//   - `demora` tiempo hasta el evento; cero lo programa para el instante
+//     actual, después de los ya programados para ese instante.
+//   - `accion` función a ejecutar cuando ocurra el evento.
+//
+// Retorna:
+//   - un error si la demora es negativa, ya que el evento ocurriría en el pasado.
+func (s *Simulacion) Programar(demora float64, accion func()) error {
+	if demora < 0 || math.IsNaN(demora) {
+		return fmt.Errorf("demora inválida: %v", demora)
+	}
+	s.secuencia++
+	s.pendientes.Insert(evento{tiempo: s.ahora + demora, secuencia: s.secuencia, accion: accion})
+
+	return nil
+}
+
+// Ejecutar procesa en orden los eventos que ocurren hasta el instante
+// `hasta`, inclusive, y deja el reloj en el último evento procesado. Los
+// eventos posteriores quedan pendientes para otra llamada.
+//
+// Uso:
+//
+//	procesados := sim.Ejecutar(math.Inf(1)) // hasta que no haya eventos
+//
+// Parámetros:
+//   - `hasta` instante límite.
+//
+// Retorna:
+//   - la cantidad de eventos procesados.
+func (s *Simulacion) Ejecutar(hasta float64) int {
+	procesados := 0
+	for s.pendientes.Size() > 0 {
+		e, _ := s.pendientes.Remove()
+		if e.tiempo > hasta {
+			s.pendientes.Insert(e)
+			break
+		}
+		s.ahora = e.tiempo
+		e.accion()
+		procesados++
+	}
+
+	return procesados
+}
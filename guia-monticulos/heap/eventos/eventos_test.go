@@ -0,0 +1,50 @@
+package eventos
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventosOcurrenEnOrdenDeTiempo(t *testing.T) {
+	sim := New()
+	var orden []string
+	registrar := func(nombre string) func() {
+		return func() { orden = append(orden, nombre) }
+	}
+	assert.NoError(t, sim.Programar(3, registrar("c")))
+	assert.NoError(t, sim.Programar(1, registrar("a")))
+	assert.NoError(t, sim.Programar(2, registrar("b1")))
+	assert.NoError(t, sim.Programar(2, registrar("b2")))
+
+	assert.Equal(t, 4, sim.Ejecutar(math.Inf(1)))
+	assert.Equal(t, []string{"a", "b1", "b2", "c"}, orden)
+	assert.Equal(t, 3.0, sim.Ahora())
+}
+
+func TestAccionesProgramanNuevosEventos(t *testing.T) {
+	sim := New()
+	var tiempos []float64
+	var tic func()
+	tic = func() {
+		tiempos = append(tiempos, sim.Ahora())
+		_ = sim.Programar(1.5, tic)
+	}
+	assert.NoError(t, sim.Programar(0, tic))
+
+	assert.Equal(t, 4, sim.Ejecutar(4.5))
+	assert.Equal(t, []float64{0, 1.5, 3, 4.5}, tiempos)
+	assert.Equal(t, 1, sim.Pendientes())
+
+	assert.Equal(t, 1, sim.Ejecutar(6))
+	assert.Equal(t, 6.0, sim.Ahora())
+}
+
+func TestProgramarDemoraInvalida(t *testing.T) {
+	sim := New()
+
+	assert.EqualError(t, sim.Programar(-1, func() {}), "demora inválida: -1")
+	assert.Error(t, sim.Programar(math.NaN(), func() {}))
+	assert.Equal(t, 0, sim.Pendientes())
+}
@@ -0,0 +1,112 @@
+package heap
+
+import "fmt"
+
+// PopN extrae los k elementos más prioritarios del heap, en orden de
+// prioridad. Evita escribir el ciclo de Remove con su manejo de errores,
+// por ejemplo para armar un reporte de los k mejores.
+//
+// Uso:
+//
+//	primeros, err := heap.PopN(10)
+//
+// Parámetros:
+//   - `k` cantidad de elementos a extraer, entre 0 y Size().
+//
+// Retorna:
+//   - los elementos extraídos, del más prioritario al menos prioritario.
+//   - un error que envuelve a ErrFueraDeRango si `k` es negativo o mayor que
+//     la cantidad de elementos, en cuyo caso el heap no se modifica.
+func (m *Heap[T]) PopN(k int) ([]T, error) {
+	if m == nil {
+		return nil, fmt.Errorf(Localizar("extraer n: %w", "pop n: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("PopN")
+	defer m.guardia.salir()
+	if k < 0 || k > len(m.elements) {
+		return nil, fmt.Errorf(Localizar("extraer n: %w: se pidieron %d elementos de un heap de %d",
+			"pop n: %w: asked for %d elements from a heap of %d"), ErrFueraDeRango, k, len(m.elements))
+	}
+	extraidos := make([]T, k)
+	for i := range extraidos {
+		extraidos[i], _ = m.Remove()
+	}
+
+	return extraidos, nil
+}
+
+// PeekN retorna los k elementos más prioritarios del heap, en orden de
+// prioridad, sin modificarlo. En lugar de copiar el heap recorre el árbol
+// con un heap auxiliar de candidatos: el siguiente elemento en orden es
+// siempre un hijo de alguno de los ya retornados, así que el costo es
+// O(k log k) sin importar el tamaño del heap.
+//
+// Uso:
+//
+//	proximos, err := eventos.PeekN(5)
+//
+// Parámetros:
+//   - `k` cantidad de elementos a retornar, entre 0 y Size().
+//
+// Retorna:
+//   - los elementos, del más prioritario al menos prioritario.
+//   - un error que envuelve a ErrFueraDeRango si `k` es negativo o mayor que
+//     la cantidad de elementos.
+func (m *Heap[T]) PeekN(k int) ([]T, error) {
+	if m == nil {
+		return nil, fmt.Errorf(Localizar("consultar n: %w", "peek n: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("PeekN")
+	defer m.guardia.salir()
+	if k < 0 || k > len(m.elements) {
+		return nil, fmt.Errorf(Localizar("consultar n: %w: se pidieron %d elementos de un heap de %d",
+			"peek n: %w: asked for %d elements from a heap of %d"), ErrFueraDeRango, k, len(m.elements))
+	}
+
+	primeros := make([]T, k)
+	it := nuevoIteradorOrdenado(m.elements, m.compare)
+	for i := range primeros {
+		primeros[i], _ = it.Next()
+	}
+
+	return primeros, nil
+}
+
+// Drain vacía el heap y retorna sus elementos en orden de prioridad. Es el
+// heapsort en una línea, y también sirve para procesar lo que queda en una
+// cola al terminar.
+//
+// Uso:
+//
+//	ordenados := heap.NuevoMonticuloMinDesdeArreglo(datos).Drain()
+//
+// Retorna:
+//   - los elementos, del más prioritario al menos prioritario, o nil si el
+//     heap es nil.
+func (m *Heap[T]) Drain() []T {
+	if m == nil {
+		return nil
+	}
+	m.guardia.entrar("Drain")
+	defer m.guardia.salir()
+	ordenados, _ := m.PopN(len(m.elements))
+
+	return ordenados
+}
+
+// ToSortedSlice retorna los elementos del heap en orden de prioridad sin
+// modificarlo: vacía una copia con Drain. Cuesta O(n log n) y O(n) de
+// memoria adicional; para los primeros k elementos conviene PeekN.
+//
+// Uso:
+//
+//	for _, p := range guardia.ToSortedSlice() {
+//		fmt.Println(p)
+//	}
+//
+// Retorna:
+//   - los elementos, del más prioritario al menos prioritario, o nil si el
+//     heap es nil.
+func (m *Heap[T]) ToSortedSlice() []T {
+	return m.Clone().Drain()
+}
@@ -0,0 +1,109 @@
+package heap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopNExtraeEnOrden(t *testing.T) {
+	h := NuevoMonticuloMaxDesdeArreglo([]int{4, 9, 1, 7, 3})
+
+	primeros, err := h.PopN(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{9, 7, 4}, primeros)
+	assert.Equal(t, 2, h.Size())
+
+	vacio, err := h.PopN(0)
+	assert.NoError(t, err)
+	assert.Empty(t, vacio)
+
+	resto, err := h.PopN(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 1}, resto)
+	assert.True(t, h.IsEmpty())
+}
+
+func TestPopNFueraDeRangoNoModificaElHeap(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{2, 1})
+
+	_, err := h.PopN(3)
+	assert.ErrorIs(t, err, ErrFueraDeRango)
+	assert.EqualError(t, err, "extraer n: n fuera de rango: se pidieron 3 elementos de un heap de 2")
+	_, err = h.PopN(-1)
+	assert.ErrorIs(t, err, ErrFueraDeRango)
+	assert.Equal(t, 2, h.Size())
+
+	var nulo *Heap[int]
+	_, err = nulo.PopN(1)
+	assert.ErrorIs(t, err, ErrHeapNil)
+}
+
+func TestPeekNNoModificaElHeap(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{8, 3, 5, 1, 9, 2, 7})
+	antes := h.Values()
+
+	proximos, err := h.PeekN(4)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 5}, proximos)
+	assert.Equal(t, antes, h.Values())
+
+	todos, err := h.PeekN(h.Size())
+	assert.NoError(t, err)
+	assert.Equal(t, extraerTodos(h.Clone()), todos)
+
+	vacio, err := h.PeekN(0)
+	assert.NoError(t, err)
+	assert.Empty(t, vacio)
+}
+
+func TestPeekNConComparadorPersonalizado(t *testing.T) {
+	h := NuevoMonticuloDesdeArregloConComparador([]string{"bb", "a", "dddd", "ccc"}, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	largas, err := h.PeekN(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dddd", "ccc"}, largas)
+}
+
+func TestPeekNFueraDeRango(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{2, 1})
+
+	_, err := h.PeekN(3)
+	assert.ErrorIs(t, err, ErrFueraDeRango)
+	assert.EqualError(t, err, "consultar n: n fuera de rango: se pidieron 3 elementos de un heap de 2")
+	_, err = h.PeekN(-1)
+	assert.ErrorIs(t, err, ErrFueraDeRango)
+
+	var nulo *Heap[int]
+	_, err = nulo.PeekN(1)
+	assert.ErrorIs(t, err, ErrHeapNil)
+}
+
+func TestDrainVaciaElHeapEnOrden(t *testing.T) {
+	h := NuevoMonticuloMaxDesdeArreglo([]int{3, 8, 1, 8, 5})
+
+	assert.Equal(t, []int{8, 8, 5, 3, 1}, h.Drain())
+	assert.True(t, h.IsEmpty())
+	assert.Empty(t, h.Drain())
+
+	// el heap sigue siendo usable después de vaciarlo
+	h.Insert(4)
+	assert.Equal(t, []int{4}, h.Drain())
+
+	var nulo *Heap[int]
+	assert.Nil(t, nulo.Drain())
+}
+
+func TestToSortedSliceNoModificaElHeap(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{6, 2, 9, 2, 4})
+	antes := h.Values()
+
+	assert.Equal(t, []int{2, 2, 4, 6, 9}, h.ToSortedSlice())
+	assert.Equal(t, antes, h.Values())
+	assert.Equal(t, h.ToSortedSlice(), h.Drain())
+
+	var nulo *Heap[int]
+	assert.Nil(t, nulo.ToSortedSlice())
+}
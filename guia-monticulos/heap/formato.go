@@ -0,0 +1,82 @@
+package heap
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String retorna el tipo de heap y su arreglo interno, para que
+// fmt.Println(heap) muestre algo útil al depurar:
+//
+//	heap de máximos [99 98 65 58 68 11 44 2 3 29]
+//
+// Los heaps creados con una función de comparación propia se muestran solo
+// como "heap", ya que no se puede saber si son de mínimos o de máximos.
+func (m *Heap[T]) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	m.guardia.entrar("String")
+	defer m.guardia.salir()
+
+	return fmt.Sprintf("%s %v", m.tipo, m.elements)
+}
+
+// String retorna el nombre del tipo de heap en el idioma elegido.
+func (t TipoHeap) String() string {
+	switch t {
+	case HeapDeMinimos:
+		return Localizar("heap de mínimos", "min heap")
+	case HeapDeMaximos:
+		return Localizar("heap de máximos", "max heap")
+	default:
+		return "heap"
+	}
+}
+
+// RenderTree dibuja el heap como árbol, con el mismo formato que usan los
+// comentarios de los tests y la guía, para poder comparar a simple vista:
+//
+//	[99]
+//	├── [98]
+//	│   ├── [58]
+//	│   └── [68]
+//	└── [65]
+//
+// El hijo izquierdo se dibuja antes que el derecho. Cada elemento se muestra
+// con el formato %v.
+//
+// Retorna:
+//   - el dibujo, una línea por elemento y sin salto de línea al final, o una
+//     cadena vacía si el heap está vacío o es nil.
+func (m *Heap[T]) RenderTree() string {
+	if m == nil {
+		return ""
+	}
+	m.guardia.entrar("RenderTree")
+	defer m.guardia.salir()
+	if len(m.elements) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%v]", m.elements[0])
+	m.dibujarHijos(&b, 0, "")
+
+	return b.String()
+}
+
+// dibujarHijos agrega al dibujo los subárboles de los hijos de la posición
+// i. `prefijo` es lo que va antes de la rama de cada hijo.
+func (m *Heap[T]) dibujarHijos(b *strings.Builder, i int, prefijo string) {
+	for _, hijo := range []int{2*i + 1, 2*i + 2} {
+		if hijo >= len(m.elements) {
+			return
+		}
+		rama, continuacion := "├── ", "│   "
+		if hijo == 2*i+2 || hijo+1 >= len(m.elements) {
+			rama, continuacion = "└── ", "    "
+		}
+		fmt.Fprintf(b, "\n%s%s[%v]", prefijo, rama, m.elements[hijo])
+		m.dibujarHijos(b, hijo, prefijo+continuacion)
+	}
+}
@@ -0,0 +1,68 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringMuestraElTipoYElArreglo(t *testing.T) {
+	maximos := NewMaxHeap[int]()
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3, 68, 99} {
+		maximos.Insert(v)
+	}
+	assert.Equal(t, "heap de máximos [99 98 65 58 68 11 44 2 3 29]", maximos.String())
+	assert.Equal(t, "heap de mínimos [1 2 3]", fmt.Sprint(NuevoMonticuloMinDesdeArreglo([]int{3, 2, 1})))
+	assert.Equal(t, "heap [b a]", fmt.Sprint(NuevoMonticuloDesdeArregloConComparador([]string{"a", "b"}, func(a, b string) int {
+		return cmp.Compare(b, a)
+	})))
+	assert.Equal(t, "heap de mínimos []", NewMinHeap[int]().String())
+
+	var nulo *Heap[int]
+	assert.Equal(t, "<nil>", nulo.String())
+}
+
+func TestStringConservaElTipoEnLasCopias(t *testing.T) {
+	h := NuevoMonticuloMaxDesdeArreglo([]int{1, 2})
+	assert.Equal(t, "heap de máximos [2 1]", h.Clone().String())
+	assert.Equal(t, "heap de máximos [2]", h.Filter(func(v int) bool { return v > 1 }).String())
+}
+
+func TestStringEnIngles(t *testing.T) {
+	defer func() { _ = SetIdioma(Espanol) }()
+	assert.NoError(t, SetIdioma(Ingles))
+	assert.Equal(t, "max heap [2 1]", NuevoMonticuloMaxDesdeArreglo([]int{1, 2}).String())
+}
+
+func TestRenderTreeDibujaComoLosComentariosDeLosTests(t *testing.T) {
+	h := NewMaxHeap[int]()
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3, 68, 99} {
+		h.Insert(v)
+	}
+
+	esperado := strings.Join([]string{
+		"[99]",
+		"├── [98]",
+		"│   ├── [58]",
+		"│   │   ├── [2]",
+		"│   │   └── [3]",
+		"│   └── [68]",
+		"│       └── [29]",
+		"└── [65]",
+		"    ├── [11]",
+		"    └── [44]",
+	}, "\n")
+	assert.Equal(t, esperado, h.RenderTree())
+}
+
+func TestRenderTreeCasosBorde(t *testing.T) {
+	assert.Equal(t, "", NewMinHeap[int]().RenderTree())
+	assert.Equal(t, "[7]", NuevoMonticuloMinDesdeArreglo([]int{7}).RenderTree())
+	assert.Equal(t, "[1]\n└── [2]", NuevoMonticuloMinDesdeArreglo([]int{2, 1}).RenderTree())
+
+	var nulo *Heap[int]
+	assert.Equal(t, "", nulo.RenderTree())
+}
@@ -0,0 +1,152 @@
+// Package frescura implementa una cola de prioridad en la que la prioridad de
+// cada elemento decae (o crece) exponencialmente con el tiempo transcurrido
+// desde que se agregó, como en los feeds que se ordenan por "frescura" o los
+// sistemas de recomendación que olvidan lo viejo.
+package frescura
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// ErrBaseInvalida indica que se agregó un elemento con una prioridad base que
+// no es un número positivo.
+var ErrBaseInvalida = errors.New("la prioridad base debe ser positiva")
+
+// item es un elemento de la cola con su prioridad base.
+type item[T any] struct {
+	valor  T
+	base   float64
+	creado time.Time
+	// log(base) + lambda * (creado - origen): ver Cola
+	clave float64
+}
+
+// Cola es una cola de prioridad de máximos en la que la prioridad de un
+// elemento en el instante t es
+//
+//	base * e^(-lambda * (t - creado))
+//
+// La prioridad se calcula recién cuando se la pide, con Peek o Pop. Para
+// ordenar no hace falta recalcularla: como todos los elementos decaen al mismo
+// ritmo, el cociente entre dos prioridades no cambia con el tiempo, y el heap
+// se ordena por su logaritmo sin el término que depende de t,
+//
+//	log(base) + lambda * (creado - origen)
+//
+// lo que además evita que las prioridades de los elementos viejos se
+// redondeen a cero y empaten.
+type Cola[T any] struct {
+	items  *heap.Heap[item[T]]
+	lambda float64
+	ahora  func() time.Time
+	// instante de referencia para las claves, para no perder precisión
+	origen time.Time
+}
+
+// New crea una cola vacía.
+//
+// Uso:
+//
+//	// la prioridad se reduce a la mitad cada hora
+//	feed := frescura.New[Post](math.Ln2/time.Hour.Seconds(), nil)
+//
+// Parámetros:
+//   - `lambda` tasa de decaimiento por segundo. Con un valor negativo la
+//     prioridad crece con el tiempo, y con cero no cambia.
+//   - `ahora` reloj a usar; si es nil se usa time.Now.
+//
+// Retorna:
+//   - un puntero a la cola.
+func New[T any](lambda float64, ahora func() time.Time) *Cola[T] {
+	if ahora == nil {
+		ahora = time.Now
+	}
+
+	return &Cola[T]{
+		items: heap.NewGenericHeap(func(a, b item[T]) int {
+			// mayor clave primero
+			return -cmpFloat(a.clave, b.clave)
+		}),
+		lambda: lambda,
+		ahora:  ahora,
+		origen: ahora(),
+	}
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+// Len retorna la cantidad de elementos en la cola.
+func (c *Cola[T]) Len() int {
+	return c.items.Size()
+}
+
+// Push agrega un elemento con su prioridad base, que es la que tiene en el
+// instante en que se agrega.
+//
+// Uso:
+//
+//	err := feed.Push(post, float64(post.Likes+1))
+//
+// Retorna:
+//   - un error que envuelve a ErrBaseInvalida si `base` no es positiva.
+func (c *Cola[T]) Push(valor T, base float64) error {
+	if !(base > 0) || math.IsInf(base, 1) {
+		return fmt.Errorf("%w: %v", ErrBaseInvalida, base)
+	}
+	creado := c.ahora()
+	c.items.Insert(item[T]{
+		valor:  valor,
+		base:   base,
+		creado: creado,
+		clave:  math.Log(base) + c.lambda*creado.Sub(c.origen).Seconds(),
+	})
+
+	return nil
+}
+
+// prioridad retorna la prioridad del elemento en el instante actual.
+func (c *Cola[T]) prioridad(it item[T]) float64 {
+	return it.base * math.Exp(-c.lambda*c.ahora().Sub(it.creado).Seconds())
+}
+
+// Peek retorna el elemento de mayor prioridad actual sin quitarlo.
+//
+// Retorna:
+//   - el elemento y su prioridad en este instante.
+//   - un error que envuelve a heap.ErrHeapVacio si la cola está vacía.
+func (c *Cola[T]) Peek() (T, float64, error) {
+	it, err := c.items.Peek()
+	if err != nil {
+		return it.valor, 0, err
+	}
+
+	return it.valor, c.prioridad(it), nil
+}
+
+// Pop quita y retorna el elemento de mayor prioridad actual.
+//
+// Retorna:
+//   - el elemento y su prioridad en este instante.
+//   - un error que envuelve a heap.ErrHeapVacio si la cola está vacía.
+func (c *Cola[T]) Pop() (T, float64, error) {
+	it, err := c.items.Remove()
+	if err != nil {
+		return it.valor, 0, err
+	}
+
+	return it.valor, c.prioridad(it), nil
+}
@@ -0,0 +1,102 @@
+package frescura
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+type relojFalso struct{ t time.Time }
+
+func (r *relojFalso) ahora() time.Time { return r.t }
+
+func (r *relojFalso) avanzar(d time.Duration) { r.t = r.t.Add(d) }
+
+func nuevoReloj() *relojFalso {
+	return &relojFalso{t: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+// mediaVida es la tasa con la que la prioridad se reduce a la mitad por hora.
+var mediaVida = math.Ln2 / time.Hour.Seconds()
+
+func TestLoNuevoLeGanaALoViejo(t *testing.T) {
+	reloj := nuevoReloj()
+	c := New[string](mediaVida, reloj.ahora)
+
+	assert.NoError(t, c.Push("viejo", 10))
+	reloj.avanzar(2 * time.Hour)
+	// 10 decayó a 2.5
+	assert.NoError(t, c.Push("nuevo", 3))
+
+	v, p, err := c.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, "nuevo", v)
+	assert.InDelta(t, 3, p, 1e-9)
+
+	reloj.avanzar(time.Hour)
+	_, _, _ = c.Pop()
+	v, p, err = c.Pop()
+	assert.NoError(t, err)
+	assert.Equal(t, "viejo", v)
+	assert.InDelta(t, 1.25, p, 1e-9)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestSinDecaimientoOrdenaPorBase(t *testing.T) {
+	reloj := nuevoReloj()
+	c := New[int](0, reloj.ahora)
+	for _, base := range []float64{2, 8, 5} {
+		assert.NoError(t, c.Push(int(base), base))
+		reloj.avanzar(time.Minute)
+	}
+
+	for _, esperado := range []int{8, 5, 2} {
+		v, p, err := c.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, esperado, v)
+		assert.Equal(t, float64(esperado), p)
+	}
+}
+
+func TestCrecimientoFavoreceALoQueEspera(t *testing.T) {
+	reloj := nuevoReloj()
+	c := New[string](-mediaVida, reloj.ahora)
+
+	assert.NoError(t, c.Push("espera", 1))
+	reloj.avanzar(3 * time.Hour)
+	assert.NoError(t, c.Push("recien", 5))
+
+	v, p, _ := c.Pop()
+	assert.Equal(t, "espera", v)
+	assert.InDelta(t, 8, p, 1e-9)
+}
+
+func TestElementosMuyViejosNoEmpatan(t *testing.T) {
+	reloj := nuevoReloj()
+	c := New[string](mediaVida, reloj.ahora)
+
+	assert.NoError(t, c.Push("a", 1))
+	assert.NoError(t, c.Push("b", 2))
+	reloj.avanzar(24 * 365 * time.Hour)
+
+	// ambas prioridades ya se redondean a cero, pero el orden se conserva
+	v, p, _ := c.Pop()
+	assert.Equal(t, "b", v)
+	assert.Zero(t, p)
+}
+
+func TestErrores(t *testing.T) {
+	c := New[int](1, nil)
+
+	for _, base := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		assert.ErrorIs(t, c.Push(1, base), ErrBaseInvalida)
+	}
+	_, _, err := c.Peek()
+	assert.ErrorIs(t, err, heap.ErrHeapVacio)
+	_, _, err = c.Pop()
+	assert.ErrorIs(t, err, heap.ErrHeapVacio)
+}
@@ -0,0 +1,56 @@
+package heap_test
+
+import (
+	"cmp"
+	"testing"
+
+	"untref/ayp2/monticulo/heap"
+	"untref/ayp2/monticulo/heap/heaptest"
+)
+
+func agregarSemillas(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 1, 1})
+	f.Add([]byte{88, 58, 116, 4, 196, 22, 130, 6, 136, 198, 1, 1, 1, 1, 1})
+	f.Add([]byte{10, 10, 10, 2, 1, 2, 1, 1, 1, 1})
+	f.Add([]byte{10, 4, 11, 8, 15, 1, 251, 3, 1, 1})
+}
+
+func FuzzMinHeapContraOraculo(f *testing.F) {
+	agregarSemillas(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		ops := heaptest.DecodificarOperaciones(data)
+		if err := heaptest.EjecutarContraOraculo[int](heap.NewMinHeap[int](), cmp.Compare[int], ops); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func FuzzMaxHeapContraOraculo(f *testing.F) {
+	agregarSemillas(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		ops := heaptest.DecodificarOperaciones(data)
+		compare := func(a, b int) int { return cmp.Compare(b, a) }
+		if err := heaptest.EjecutarContraOraculo[int](heap.NewMaxHeap[int](), compare, ops); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func FuzzGenericHeapContraOraculo(f *testing.F) {
+	agregarSemillas(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// ordena por paridad y luego por valor, para forzar muchos empates parciales
+		compare := func(a, b int) int {
+			if a%2 != b%2 {
+				return a%2 - b%2
+			}
+
+			return cmp.Compare(a, b)
+		}
+		ops := heaptest.DecodificarOperaciones(data)
+		if err := heaptest.EjecutarContraOraculo[int](heap.NewGenericHeap(compare), compare, ops); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package heap
 
 import (
+	"cmp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,6 +31,7 @@ func TestHeapRemoveVacio(t *testing.T) {
 	m := NewGenericHeap[Persona](personasDeMayorAMenorEdad)
 	_, err := m.Remove()
 	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, ErrHeapVacio)
 }
 
 func TestHeapCrearInsertarYExtraer(t *testing.T) {
@@ -66,7 +68,7 @@ func TestHeapCrearInsertarYExtraer(t *testing.T) {
 	// Verificaciones a medida que vamos insertando
 	for i := 0; i < len(secuenciaDeInsercion); i++ {
 		m.Insert(secuenciaDeInsercion[i])
-		assert.Equal(t, ordenEsperadoDespuesDeInsertar[i], m.elements)
+		assert.Equal(t, ordenEsperadoDespuesDeInsertar[i], m.Values())
 	}
 
 	ordenEsperadoDespuesDeEliminar := [][]Persona{
@@ -84,7 +86,97 @@ func TestHeapCrearInsertarYExtraer(t *testing.T) {
 
 	for i := 0; i < len(secuenciaDeInsercion); i++ {
 		_, err := m.Remove()
-		assert.Equal(t, ordenEsperadoDespuesDeEliminar[i], m.elements)
+		assert.Equal(t, ordenEsperadoDespuesDeEliminar[i], m.Values())
 		assert.NoError(t, err)
 	}
 }
+
+func TestNuevoMonticuloDesdeArregloConComparador(t *testing.T) {
+	personas := []Persona{{"Ana", 44}, {"Juan", 29}, {"Pedro", 58}, {"Lucía", 35}}
+
+	m := NuevoMonticuloDesdeArregloConComparador(personas, personasDeMayorAMenorEdad)
+
+	assert.Equal(t, 4, m.Size())
+	assert.Equal(t, []Persona{{"Pedro", 58}, {"Ana", 44}, {"Lucía", 35}, {"Juan", 29}}, extraerTodos(m))
+	// el arreglo original no se modifica
+	assert.Equal(t, Persona{"Ana", 44}, personas[0])
+}
+
+func TestNuevoMonticuloDesdeArregloConComparadorVacioYNil(t *testing.T) {
+	m := NuevoMonticuloDesdeArregloConComparador(nil, personasDeMayorAMenorEdad)
+	assert.Equal(t, 0, m.Size())
+
+	assert.Panics(t, func() {
+		NuevoMonticuloDesdeArregloConComparador([]Persona{{"Ana", 44}}, nil)
+	})
+}
+
+func TestAdoptarArregloNoCopia(t *testing.T) {
+	arr := []int{5, 9, 1, 7, 3}
+
+	m := AdoptarArreglo(arr, func(a, b int) int { return a - b })
+
+	// el heap usa y reordena el mismo arreglo que recibió
+	assert.Same(t, &arr[0], &m.elements[0])
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, arr)
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, extraerTodos(m))
+}
+
+func TestAdoptarArregloNil(t *testing.T) {
+	m := AdoptarArreglo[int](nil, func(a, b int) int { return a - b })
+
+	assert.True(t, m.IsEmpty())
+	m.Insert(4)
+	assert.Equal(t, 1, m.Size())
+}
+
+func TestContainsConIgualdadPropia(t *testing.T) {
+	m := NuevoMonticuloDesdeArregloConComparador([]Persona{{"Ana", 44}, {"Juan", 29}}, personasDeMayorAMenorEdad)
+	mismoNombre := func(a, b Persona) bool { return a.nombre == b.nombre }
+
+	assert.True(t, m.Contains(Persona{nombre: "Juan"}, mismoNombre))
+	assert.False(t, m.Contains(Persona{nombre: "Pedro"}, mismoNombre))
+}
+
+func TestContainsConElComparador(t *testing.T) {
+	m := NuevoMonticuloMinDesdeArreglo([]int{4, 8, 15, 16, 23, 42})
+
+	assert.True(t, m.Contains(23, nil))
+	assert.False(t, m.Contains(5, nil))
+	// la búsqueda no modifica el heap
+	assert.Equal(t, []int{4, 8, 15, 16, 23, 42}, m.Values())
+
+	var nulo *Heap[int]
+	assert.False(t, nulo.Contains(1, nil))
+}
+
+func TestCombinarMonticulosGenericos(t *testing.T) {
+	manana := NewGenericHeap(personasDeMayorAMenorEdad)
+	manana.Insert(Persona{nombre: "Ana", edad: 30})
+	manana.Insert(Persona{nombre: "Beto", edad: 70})
+	// la tarde se ordena por nombre; el combinado usa el orden de la mañana
+	tarde := NewGenericHeap(func(a, b Persona) int { return cmp.Compare(a.nombre, b.nombre) })
+	tarde.Insert(Persona{nombre: "Carla", edad: 45})
+	tarde.Insert(Persona{nombre: "Dora", edad: 90})
+
+	combinado := CombinarMonticulosGenericos(manana, tarde)
+
+	assert.Equal(t, 4, combinado.Size())
+	assert.True(t, combinado.IsValid())
+	var edades []int
+	for _, p := range extraerTodos(combinado) {
+		edades = append(edades, p.edad)
+	}
+	assert.Equal(t, []int{90, 70, 45, 30}, edades)
+	assert.Equal(t, 2, manana.Size())
+	assert.Equal(t, 2, tarde.Size())
+}
+
+func TestCombinarMonticulosGenericosConNil(t *testing.T) {
+	h := NewGenericHeap(personasDeMayorAMenorEdad)
+	h.Insert(Persona{nombre: "Ana", edad: 30})
+
+	assert.Equal(t, 1, CombinarMonticulosGenericos(nil, h).Size())
+	assert.Equal(t, 1, CombinarMonticulosGenericos(h, nil).Size())
+	assert.Nil(t, CombinarMonticulosGenericos[Persona](nil, nil))
+}
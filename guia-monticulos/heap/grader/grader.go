@@ -0,0 +1,268 @@
+// Package grader corrige automáticamente ejercicios de seguimiento de
+// montículos: ejecuta una secuencia de operaciones sobre la implementación de
+// un estudiante y compara el estado del arreglo después de cada paso con los
+// estados esperados guardados en un archivo golden.
+package grader
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// Implementacion es lo que debe exponer la implementación a corregir. Los
+// estados se comparan sobre el arreglo interno del montículo, por lo que
+// Elementos debe retornarlo en el orden en que está almacenado.
+type Implementacion interface {
+	Insert(element int)
+	Remove() (int, error)
+	Elementos() []int
+}
+
+// Operacion es un paso del enunciado.
+type Operacion struct {
+	// Op es "insert" o "remove".
+	Op    string `json:"op"`
+	Valor int    `json:"valor,omitempty"`
+}
+
+// String retorna la operación en formato legible, por ejemplo "insert 45".
+func (o Operacion) String() string {
+	if o.Op == "insert" {
+		return fmt.Sprintf("insert %d", o.Valor)
+	}
+
+	return o.Op
+}
+
+// Enunciado describe un ejercicio como una secuencia de operaciones.
+type Enunciado struct {
+	Nombre      string      `json:"nombre"`
+	Operaciones []Operacion `json:"operaciones"`
+}
+
+// Golden contiene los estados esperados del arreglo después de cada operación.
+type Golden struct {
+	Estados [][]int `json:"estados"`
+}
+
+// CargarEnunciado lee un enunciado en formato JSON.
+//
+// Uso:
+//
+//	enunciado, err := grader.CargarEnunciado("testdata/ejercicio1.json")
+//
+// Parámetros:
+//   - `path` ruta del archivo.
+//
+// Retorna:
+//   - el enunciado leído.
+//   - un error si el archivo no existe o no es válido.
+func CargarEnunciado(path string) (Enunciado, error) {
+	var e Enunciado
+	if err := cargarJSON(path, &e); err != nil {
+		return e, err
+	}
+	for i, op := range e.Operaciones {
+		if op.Op != "insert" && op.Op != "remove" {
+			return e, fmt.Errorf(heap.Localizar("%s: operación %d desconocida: %q", "%s: unknown operation %d: %q"), path, i, op.Op)
+		}
+	}
+
+	return e, nil
+}
+
+// CargarGolden lee un archivo golden en formato JSON.
+//
+// Uso:
+//
+//	golden, err := grader.CargarGolden("testdata/ejercicio1.golden.json")
+//
+// Parámetros:
+//   - `path` ruta del archivo.
+//
+// Retorna:
+//   - los estados esperados.
+//   - un error si el archivo no existe o no es válido.
+func CargarGolden(path string) (Golden, error) {
+	var g Golden
+	err := cargarJSON(path, &g)
+
+	return g, err
+}
+
+func cargarJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Diferencia es una posición del arreglo en la que el estado obtenido no
+// coincide con el esperado. Esperado u Obtenido son nil si el arreglo
+// correspondiente no llega a esa posición.
+type Diferencia[T comparable] struct {
+	Indice   int `json:"indice"`
+	Esperado *T  `json:"esperado"`
+	Obtenido *T  `json:"obtenido"`
+}
+
+// String retorna la diferencia en formato legible.
+func (d Diferencia[T]) String() string {
+	return fmt.Sprintf(heap.Localizar("[%d] esperado %s, obtenido %s", "[%d] expected %s, got %s"), d.Indice, mostrar(d.Esperado), mostrar(d.Obtenido))
+}
+
+func mostrar[T any](v *T) string {
+	if v == nil {
+		return heap.Localizar("(nada)", "(nothing)")
+	}
+
+	return fmt.Sprintf("%v", *v)
+}
+
+// DiffHeaps compara posición a posición dos arreglos de montículo.
+//
+// Uso:
+//
+//	diffs := grader.DiffHeaps([]int{45, 15, 25}, []int{45, 25, 15})
+//
+// Parámetros:
+//   - `esperado` arreglo esperado.
+//   - `obtenido` arreglo obtenido.
+//
+// Retorna:
+//   - las posiciones que difieren; vacío si los arreglos son iguales.
+func DiffHeaps[T comparable](esperado, obtenido []T) []Diferencia[T] {
+	diffs := make([]Diferencia[T], 0)
+	n := len(esperado)
+	if len(obtenido) > n {
+		n = len(obtenido)
+	}
+	for i := 0; i < n; i++ {
+		d := Diferencia[T]{Indice: i}
+		if i < len(esperado) {
+			d.Esperado = &esperado[i]
+		}
+		if i < len(obtenido) {
+			d.Obtenido = &obtenido[i]
+		}
+		if d.Esperado == nil || d.Obtenido == nil || *d.Esperado != *d.Obtenido {
+			diffs = append(diffs, d)
+		}
+	}
+
+	return diffs
+}
+
+// ResultadoPaso es la corrección de una operación del enunciado.
+type ResultadoPaso struct {
+	Paso        int               `json:"paso"`
+	Operacion   Operacion         `json:"operacion"`
+	Correcto    bool              `json:"correcto"`
+	Error       string            `json:"error,omitempty"`
+	Diferencias []Diferencia[int] `json:"diferencias,omitempty"`
+}
+
+// Reporte es el resultado de corregir un enunciado completo.
+type Reporte struct {
+	Enunciado string          `json:"enunciado"`
+	Correctos int             `json:"correctos"`
+	Total     int             `json:"total"`
+	Aprobado  bool            `json:"aprobado"`
+	Pasos     []ResultadoPaso `json:"pasos"`
+}
+
+// Corregir ejecuta el enunciado sobre la implementación y compara cada estado
+// con el golden. La corrección continúa aunque un paso falle, para que el
+// reporte muestre todos los errores. Si la implementación produce un panic,
+// se registra como error del paso y se sigue con el siguiente.
+//
+// Uso:
+//
+//	reporte, err := grader.Corregir(enunciado, golden, implementacionDelEstudiante)
+//
+// Parámetros:
+//   - `enunciado` operaciones a ejecutar.
+//   - `golden` estados esperados después de cada operación.
+//   - `impl` implementación a corregir, vacía.
+//
+// Retorna:
+//   - el reporte de la corrección.
+//   - un error si el golden no tiene un estado por operación.
+func Corregir(enunciado Enunciado, golden Golden, impl Implementacion) (Reporte, error) {
+	if len(golden.Estados) != len(enunciado.Operaciones) {
+		return Reporte{}, fmt.Errorf(heap.Localizar("el golden tiene %d estados y el enunciado %d operaciones",
+			"the golden file has %d states and the exercise %d operations"),
+			len(golden.Estados), len(enunciado.Operaciones))
+	}
+
+	reporte := Reporte{Enunciado: enunciado.Nombre, Total: len(enunciado.Operaciones)}
+	for i, op := range enunciado.Operaciones {
+		paso := ResultadoPaso{Paso: i + 1, Operacion: op}
+		elementos, err := ejecutar(impl, op)
+		if err != nil {
+			paso.Error = err.Error()
+		}
+		paso.Diferencias = DiffHeaps(golden.Estados[i], elementos)
+		paso.Correcto = paso.Error == "" && len(paso.Diferencias) == 0
+		if paso.Correcto {
+			reporte.Correctos++
+		}
+		reporte.Pasos = append(reporte.Pasos, paso)
+	}
+	reporte.Aprobado = reporte.Correctos == reporte.Total
+
+	return reporte, nil
+}
+
+// ejecutar aplica la operación y retorna el estado resultante. Un panic de la
+// implementación se convierte en error.
+func ejecutar(impl Implementacion, op Operacion) (elementos []int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			elementos, err = nil, fmt.Errorf("panic: %v", r)
+		}
+	}()
+	if op.Op == "insert" {
+		impl.Insert(op.Valor)
+	} else if _, err = impl.Remove(); err != nil {
+		return impl.Elementos(), err
+	}
+
+	return impl.Elementos(), nil
+}
+
+// JSON retorna el reporte serializado, para procesarlo con otras herramientas.
+func (r Reporte) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// String retorna el reporte en formato legible, en el idioma elegido con
+// heap.SetIdioma.
+func (r Reporte) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(heap.Localizar("%s: %d/%d pasos correctos\n", "%s: %d/%d correct steps\n"), r.Enunciado, r.Correctos, r.Total))
+	for _, p := range r.Pasos {
+		if p.Correcto {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf(heap.Localizar("  paso %d (%s):", "  step %d (%s):"), p.Paso, p.Operacion))
+		if p.Error != "" {
+			sb.WriteString(" error: " + p.Error)
+		}
+		for _, d := range p.Diferencias {
+			sb.WriteString("\n    " + d.String())
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,167 @@
+package grader
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// maxHeapDeReferencia es una implementación correcta de un heap de máximos.
+type maxHeapDeReferencia struct {
+	elements []int
+}
+
+func (h *maxHeapDeReferencia) Insert(element int) {
+	h.elements = append(h.elements, element)
+	for i := len(h.elements) - 1; i > 0 && h.elements[(i-1)/2] < h.elements[i]; i = (i - 1) / 2 {
+		h.elements[i], h.elements[(i-1)/2] = h.elements[(i-1)/2], h.elements[i]
+	}
+}
+
+func (h *maxHeapDeReferencia) Remove() (int, error) {
+	if len(h.elements) == 0 {
+		return 0, errors.New("heap vacío")
+	}
+	top := h.elements[0]
+	h.elements[0] = h.elements[len(h.elements)-1]
+	h.elements = h.elements[:len(h.elements)-1]
+	for i := 0; ; {
+		mayor := i
+		for _, hijo := range []int{2*i + 1, 2*i + 2} {
+			if hijo < len(h.elements) && h.elements[hijo] > h.elements[mayor] {
+				mayor = hijo
+			}
+		}
+		if mayor == i {
+			break
+		}
+		h.elements[i], h.elements[mayor] = h.elements[mayor], h.elements[i]
+		i = mayor
+	}
+
+	return top, nil
+}
+
+func (h *maxHeapDeReferencia) Elementos() []int {
+	return h.elements
+}
+
+// heapSinRemove simula una entrega que olvidó reordenar al remover.
+type heapSinRemove struct {
+	maxHeapDeReferencia
+}
+
+func (h *heapSinRemove) Remove() (int, error) {
+	top := h.elements[0]
+	h.elements = h.elements[1:]
+
+	return top, nil
+}
+
+func cargar(t *testing.T) (Enunciado, Golden) {
+	enunciado, err := CargarEnunciado("testdata/seguimiento1.json")
+	assert.NoError(t, err)
+	golden, err := CargarGolden("testdata/seguimiento1.golden.json")
+	assert.NoError(t, err)
+
+	return enunciado, golden
+}
+
+func TestDiffHeaps(t *testing.T) {
+	assert.Empty(t, DiffHeaps([]int{3, 2, 1}, []int{3, 2, 1}))
+
+	diffs := DiffHeaps([]int{3, 2, 1}, []int{3, 1})
+	assert.Len(t, diffs, 2)
+	assert.Equal(t, "[1] esperado 2, obtenido 1", diffs[0].String())
+	assert.Equal(t, "[2] esperado 1, obtenido (nada)", diffs[1].String())
+}
+
+func TestCargarEnunciadoInexistente(t *testing.T) {
+	_, err := CargarEnunciado("testdata/no-existe.json")
+	assert.Error(t, err)
+}
+
+func TestCorregirImplementacionCorrecta(t *testing.T) {
+	enunciado, golden := cargar(t)
+
+	reporte, err := Corregir(enunciado, golden, &maxHeapDeReferencia{})
+	assert.NoError(t, err)
+	assert.True(t, reporte.Aprobado)
+	assert.Equal(t, 9, reporte.Correctos)
+	assert.Equal(t, "Parte I - Ejercicio 1: 9/9 pasos correctos\n", reporte.String())
+}
+
+func TestCorregirImplementacionIncorrecta(t *testing.T) {
+	enunciado, golden := cargar(t)
+
+	reporte, err := Corregir(enunciado, golden, &heapSinRemove{})
+	assert.NoError(t, err)
+	assert.False(t, reporte.Aprobado)
+	assert.Equal(t, 7, reporte.Correctos)
+	assert.False(t, reporte.Pasos[7].Correcto)
+	assert.Equal(t, "remove", reporte.Pasos[7].Operacion.String())
+	assert.Contains(t, reporte.String(), "paso 8 (remove):\n    [0] esperado 25, obtenido 15")
+
+	data, err := reporte.JSON()
+	assert.NoError(t, err)
+	var decodificado Reporte
+	assert.NoError(t, json.Unmarshal(data, &decodificado))
+	assert.Equal(t, reporte.Correctos, decodificado.Correctos)
+}
+
+// heapQueEntraEnPanico simula una entrega que falla al remover de un heap
+// con menos de tres elementos.
+type heapQueEntraEnPanico struct {
+	maxHeapDeReferencia
+}
+
+func (h *heapQueEntraEnPanico) Remove() (int, error) {
+	if len(h.elements) < 3 {
+		panic("índice fuera de rango")
+	}
+
+	return h.maxHeapDeReferencia.Remove()
+}
+
+func TestCorregirImplementacionQueEntraEnPanico(t *testing.T) {
+	enunciado := Enunciado{Nombre: "panic", Operaciones: []Operacion{
+		{Op: "insert", Valor: 3},
+		{Op: "insert", Valor: 5},
+		{Op: "remove"},
+		{Op: "insert", Valor: 1},
+	}}
+	golden := Golden{Estados: [][]int{{3}, {5, 3}, {3}, {3, 1}}}
+
+	reporte, err := Corregir(enunciado, golden, &heapQueEntraEnPanico{})
+	assert.NoError(t, err)
+	assert.Len(t, reporte.Pasos, 4)
+	assert.Equal(t, 2, reporte.Correctos)
+	assert.False(t, reporte.Pasos[2].Correcto)
+	assert.Equal(t, "panic: índice fuera de rango", reporte.Pasos[2].Error)
+	// la corrección sigue después del panic
+	assert.Empty(t, reporte.Pasos[3].Error)
+	assert.NotEmpty(t, reporte.Pasos[3].Diferencias)
+}
+
+func TestCorregirGoldenIncompleto(t *testing.T) {
+	enunciado, golden := cargar(t)
+	golden.Estados = golden.Estados[:3]
+
+	_, err := Corregir(enunciado, golden, &maxHeapDeReferencia{})
+	assert.EqualError(t, err, "el golden tiene 3 estados y el enunciado 9 operaciones")
+}
+
+func TestReporteEnIngles(t *testing.T) {
+	assert.NoError(t, heap.SetIdioma(heap.Ingles))
+	defer heap.SetIdioma(heap.Espanol)
+	enunciado, golden := cargar(t)
+
+	reporte, err := Corregir(enunciado, golden, &heapSinRemove{})
+	assert.NoError(t, err)
+	assert.Contains(t, reporte.String(), "Parte I - Ejercicio 1: 7/9 correct steps\n")
+	assert.Contains(t, reporte.String(), "step 8 (remove):\n    [0] expected 25, got 15")
+}
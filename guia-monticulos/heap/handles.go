@@ -0,0 +1,253 @@
+package heap
+
+import "fmt"
+
+var (
+	// ErrHandleInvalido indica que se usó un handle de otro heap o de un
+	// elemento que ya se eliminó.
+	ErrHandleInvalido error = &errorLocalizado{es: "handle inválido", en: "invalid handle"}
+	// ErrPrioridadMenor indica que DecreaseKey recibió un valor menos
+	// prioritario que el actual.
+	ErrPrioridadMenor error = &errorLocalizado{es: "el nuevo valor es menos prioritario", en: "new value has lower priority"}
+)
+
+// Handle identifica a un elemento de un HeapConHandles. Se obtiene al
+// insertar y sigue siendo válido aunque el elemento cambie de posición,
+// hasta que se elimina del heap.
+type Handle[T any] struct {
+	valor T
+	// posición en el arreglo del heap, o -1 si el elemento ya no está
+	pos  int
+	heap *HeapConHandles[T]
+}
+
+// Value retorna el valor actual del elemento.
+func (h *Handle[T]) Value() T {
+	return h.valor
+}
+
+// HeapConHandles es un heap binario en el que Insert retorna un handle del
+// elemento. Con el handle se puede aumentar la prioridad del elemento
+// (decrease-key) o eliminarlo en O(log n), sin necesidad de que los
+// elementos tengan una clave como en HeapIndexado. Es la estructura que
+// necesitan Dijkstra y Prim cuando los vértices no tienen un identificador
+// comparable a mano.
+type HeapConHandles[T any] struct {
+	elements []*Handle[T]
+	// misma convención que Heap.compare
+	compare func(a T, b T) int
+	guardia guardia
+}
+
+// NewHeapConHandles crea un heap con handles vacío.
+//
+// Uso:
+//
+//	h := heap.NewHeapConHandles(cmp.Compare[int])
+//	handle := h.Insert(10)
+//	err := h.DecreaseKey(handle, 3)
+//
+// Parámetros:
+//   - `comp` función de comparación, con la misma convención que NewGenericHeap.
+//
+// Retorna:
+//   - un puntero a un heap con handles vacío.
+func NewHeapConHandles[T any](comp func(a T, b T) int) *HeapConHandles[T] {
+	if comp == nil {
+		panic(Localizar("heap: la función de comparación no puede ser nil", "heap: comparison function must not be nil"))
+	}
+
+	return &HeapConHandles[T]{compare: comp}
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (m *HeapConHandles[T]) Size() int {
+	if m == nil {
+		return 0
+	}
+	m.guardia.entrar("Size")
+	defer m.guardia.salir()
+
+	return len(m.elements)
+}
+
+// Insert agrega un elemento.
+//
+// Retorna:
+//   - el handle del elemento.
+//
+// Si el heap es nil se produce un panic con un error que envuelve a
+// ErrHeapNil, como en Heap.Insert.
+func (m *HeapConHandles[T]) Insert(valor T) *Handle[T] {
+	if m == nil {
+		panic(fmt.Errorf(Localizar("insertar: %w", "insert: %w"), ErrHeapNil))
+	}
+	m.guardia.entrar("Insert")
+	defer m.guardia.salir()
+	h := &Handle[T]{valor: valor, pos: len(m.elements), heap: m}
+	m.elements = append(m.elements, h)
+	m.upHeap(h.pos)
+
+	return h
+}
+
+// Peek retorna el handle del elemento en la cima del heap, sin eliminarlo.
+//
+// Retorna:
+//   - el handle de la cima.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (m *HeapConHandles[T]) Peek() (*Handle[T], error) {
+	if m == nil {
+		return nil, fmt.Errorf(Localizar("consultar: %w", "peek: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Peek")
+	defer m.guardia.salir()
+	if len(m.elements) == 0 {
+		return nil, fmt.Errorf(Localizar("consultar: %w", "peek: %w"), ErrHeapVacio)
+	}
+
+	return m.elements[0], nil
+}
+
+// Remove elimina y retorna el elemento en la cima del heap. Su handle deja
+// de ser válido.
+//
+// Retorna:
+//   - el elemento de la cima.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (m *HeapConHandles[T]) Remove() (T, error) {
+	var cero T
+	if m == nil {
+		return cero, fmt.Errorf(Localizar("extraer: %w", "remove: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Remove")
+	defer m.guardia.salir()
+	if len(m.elements) == 0 {
+		return cero, fmt.Errorf(Localizar("extraer: %w", "remove: %w"), ErrHeapVacio)
+	}
+
+	return m.eliminarEn(0).valor, nil
+}
+
+// DecreaseKey reemplaza el valor del elemento por uno más prioritario (menor
+// en un heap de mínimos) y lo sube hasta su lugar en O(log n).
+//
+// Uso:
+//
+//	err := h.DecreaseKey(handle, nuevaDistancia)
+//
+// Parámetros:
+//   - `h` handle del elemento, obtenido con Insert.
+//   - `valor` nuevo valor del elemento.
+//
+// Retorna:
+//   - un error que envuelve a ErrHandleInvalido si el handle no es de este
+//     heap o su elemento ya se eliminó, o a ErrPrioridadMenor si `valor` es
+//     menos prioritario que el valor actual. En ambos casos el heap no cambia.
+func (m *HeapConHandles[T]) DecreaseKey(h *Handle[T], valor T) error {
+	if m == nil {
+		return fmt.Errorf(Localizar("disminuir clave: %w", "decrease key: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("DecreaseKey")
+	defer m.guardia.salir()
+	if !m.valido(h) {
+		return fmt.Errorf(Localizar("disminuir clave: %w", "decrease key: %w"), ErrHandleInvalido)
+	}
+	if m.compare(valor, h.valor) > 0 {
+		return fmt.Errorf(Localizar("disminuir clave %v: %w", "decrease key %v: %w"), valor, ErrPrioridadMenor)
+	}
+	h.valor = valor
+	m.upHeap(h.pos)
+
+	return nil
+}
+
+// RemoveHandle elimina el elemento del handle, esté donde esté, en
+// O(log n). El handle deja de ser válido. Remove, sin argumentos, extrae la
+// cima como en Heap.
+//
+// Uso:
+//
+//	v, err := h.RemoveHandle(handle)
+//
+// Parámetros:
+//   - `h` handle del elemento, obtenido con Insert.
+//
+// Retorna:
+//   - el elemento eliminado.
+//   - un error que envuelve a ErrHandleInvalido si el handle no es de este
+//     heap o su elemento ya se eliminó.
+func (m *HeapConHandles[T]) RemoveHandle(h *Handle[T]) (T, error) {
+	var cero T
+	if m == nil {
+		return cero, fmt.Errorf(Localizar("eliminar handle: %w", "remove handle: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("RemoveHandle")
+	defer m.guardia.salir()
+	if !m.valido(h) {
+		return cero, fmt.Errorf(Localizar("eliminar handle: %w", "remove handle: %w"), ErrHandleInvalido)
+	}
+
+	return m.eliminarEn(h.pos).valor, nil
+}
+
+// valido indica si el handle corresponde a un elemento que está en el heap.
+func (m *HeapConHandles[T]) valido(h *Handle[T]) bool {
+	return h != nil && h.heap == m && h.pos >= 0
+}
+
+// eliminarEn quita el elemento de la posición i reemplazándolo por el último.
+func (m *HeapConHandles[T]) eliminarEn(i int) *Handle[T] {
+	h := m.elements[i]
+	ultimo := len(m.elements) - 1
+	m.intercambiar(i, ultimo)
+	m.elements[ultimo] = nil
+	m.elements = m.elements[:ultimo]
+	h.pos = -1
+	if i < ultimo {
+		if i > 0 && m.compare(m.elements[i].valor, m.elements[(i-1)/2].valor) < 0 {
+			m.upHeap(i)
+		} else {
+			m.downHeap(i)
+		}
+	}
+
+	return h
+}
+
+func (m *HeapConHandles[T]) intercambiar(i, j int) {
+	m.elements[i], m.elements[j] = m.elements[j], m.elements[i]
+	m.elements[i].pos = i
+	m.elements[j].pos = j
+}
+
+func (m *HeapConHandles[T]) upHeap(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if m.compare(m.elements[i].valor, m.elements[parent].valor) >= 0 {
+			break
+		}
+		m.intercambiar(i, parent)
+		i = parent
+	}
+}
+
+func (m *HeapConHandles[T]) downHeap(i int) {
+	for {
+		left := 2*i + 1
+		right := 2*i + 2
+		smallest := i
+
+		if left < len(m.elements) && m.compare(m.elements[left].valor, m.elements[smallest].valor) < 0 {
+			smallest = left
+		}
+		if right < len(m.elements) && m.compare(m.elements[right].valor, m.elements[smallest].valor) < 0 {
+			smallest = right
+		}
+		if smallest == i {
+			break
+		}
+		m.intercambiar(i, smallest)
+		i = smallest
+	}
+}
@@ -0,0 +1,144 @@
+package heap
+
+import (
+	"cmp"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func handlesValido[T any](t *testing.T, h *HeapConHandles[T]) {
+	t.Helper()
+	for i := 1; i < len(h.elements); i++ {
+		assert.LessOrEqual(t, h.compare(h.elements[(i-1)/2].valor, h.elements[i].valor), 0)
+	}
+	for i, e := range h.elements {
+		assert.Equal(t, i, e.pos)
+	}
+}
+
+func TestHeapConHandlesInsertYRemove(t *testing.T) {
+	h := NewHeapConHandles(cmp.Compare[int])
+	for _, v := range []int{5, 3, 8, 1} {
+		h.Insert(v)
+	}
+
+	cima, err := h.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cima.Value())
+
+	for _, esperado := range []int{1, 3, 5, 8} {
+		v, err := h.Remove()
+		assert.NoError(t, err)
+		assert.Equal(t, esperado, v)
+	}
+	_, err = h.Remove()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+	_, err = h.Peek()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+}
+
+func TestHeapConHandlesDecreaseKey(t *testing.T) {
+	h := NewHeapConHandles(cmp.Compare[int])
+	handles := make([]*Handle[int], 5)
+	for i := range handles {
+		handles[i] = h.Insert((i + 1) * 10)
+	}
+
+	assert.NoError(t, h.DecreaseKey(handles[4], 5))
+	assert.Equal(t, 5, handles[4].Value())
+	cima, _ := h.Peek()
+	assert.Same(t, handles[4], cima)
+	handlesValido(t, h)
+
+	err := h.DecreaseKey(handles[0], 15)
+	assert.ErrorIs(t, err, ErrPrioridadMenor)
+	assert.EqualError(t, err, "disminuir clave 15: el nuevo valor es menos prioritario")
+	assert.Equal(t, 10, handles[0].Value())
+}
+
+func TestHeapConHandlesRemoveHandle(t *testing.T) {
+	h := NewHeapConHandles(cmp.Compare[int])
+	handles := make([]*Handle[int], 10)
+	for i := range handles {
+		handles[i] = h.Insert(9 - i)
+	}
+
+	v, err := h.RemoveHandle(handles[4])
+	assert.NoError(t, err)
+	assert.Equal(t, 5, v)
+	assert.Equal(t, 9, h.Size())
+	handlesValido(t, h)
+
+	_, err = h.RemoveHandle(handles[4])
+	assert.ErrorIs(t, err, ErrHandleInvalido)
+	assert.ErrorIs(t, h.DecreaseKey(handles[4], 0), ErrHandleInvalido)
+
+	// el handle de la cima deja de ser válido al extraerla
+	_, _ = h.Remove()
+	_, err = h.RemoveHandle(handles[9])
+	assert.ErrorIs(t, err, ErrHandleInvalido)
+}
+
+func TestHeapConHandlesDeOtroHeap(t *testing.T) {
+	h := NewHeapConHandles(cmp.Compare[int])
+	otro := NewHeapConHandles(cmp.Compare[int])
+	handle := otro.Insert(1)
+	h.Insert(2)
+
+	assert.ErrorIs(t, h.DecreaseKey(handle, 0), ErrHandleInvalido)
+	_, err := h.RemoveHandle(handle)
+	assert.ErrorIs(t, err, ErrHandleInvalido)
+	_, err = h.RemoveHandle(nil)
+	assert.ErrorIs(t, err, ErrHandleInvalido)
+	assert.Equal(t, 1, otro.Size())
+}
+
+func TestHeapConHandlesOperacionesAleatorias(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := NewHeapConHandles(cmp.Compare[int])
+	vivos := map[*Handle[int]]bool{}
+	for i := 0; i < 2000; i++ {
+		switch r.Intn(4) {
+		case 0, 1:
+			vivos[h.Insert(r.Intn(1000))] = true
+		case 2:
+			for handle := range vivos {
+				if r.Intn(2) == 0 {
+					assert.NoError(t, h.DecreaseKey(handle, handle.Value()-r.Intn(100)))
+				} else {
+					_, err := h.RemoveHandle(handle)
+					assert.NoError(t, err)
+					delete(vivos, handle)
+				}
+				break
+			}
+		case 3:
+			if cima, err := h.Peek(); err == nil {
+				for otro := range vivos {
+					assert.LessOrEqual(t, cima.Value(), otro.Value())
+				}
+				_, _ = h.Remove()
+				delete(vivos, cima)
+			}
+		}
+		assert.Equal(t, len(vivos), h.Size())
+	}
+	handlesValido(t, h)
+}
+
+func TestHeapConHandlesNil(t *testing.T) {
+	var h *HeapConHandles[int]
+
+	assert.Equal(t, 0, h.Size())
+	assert.PanicsWithError(t, "insertar: heap nil", func() { h.Insert(1) })
+	assert.ErrorIs(t, h.DecreaseKey(nil, 1), ErrHeapNil)
+	_, err := h.RemoveHandle(nil)
+	assert.ErrorIs(t, err, ErrHeapNil)
+	_, err = h.Remove()
+	assert.ErrorIs(t, err, ErrHeapNil)
+	_, err = h.Peek()
+	assert.ErrorIs(t, err, ErrHeapNil)
+	assert.Panics(t, func() { NewHeapConHandles[int](nil) })
+}
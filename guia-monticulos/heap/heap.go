@@ -2,10 +2,39 @@
 package heap
 
 import (
-	"errors"
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Ordered es el constraint de los tipos con orden natural que aceptan los
+// constructores del paquete. Es un alias de cmp.Ordered.
+type Ordered = cmp.Ordered
+
+// Los mensajes de los errores siguen el idioma elegido con SetIdioma.
+var (
+	// ErrHeapVacio indica que se intentó extraer un elemento de un heap sin elementos.
+	ErrHeapVacio error = &errorLocalizado{es: "heap vacío", en: "empty heap"}
+	// ErrFueraDeRango indica que se pidió una posición que el heap no tiene.
+	ErrFueraDeRango error = &errorLocalizado{es: "n fuera de rango", en: "n out of range"}
+	// ErrHeapNil indica que se operó sobre un puntero a heap nil.
+	ErrHeapNil error = &errorLocalizado{es: "heap nil", en: "nil heap"}
+	// ErrElementoInexistente indica que se buscó un elemento que no está en el heap.
+	ErrElementoInexistente error = &errorLocalizado{es: "elemento inexistente", en: "missing element"}
+)
+
+// TipoHeap indica si un heap es de mínimos, de máximos o usa una función de
+// comparación propia. Se registra al crear el heap y se consulta con Kind.
+type TipoHeap int
 
-	"github.com/untref-ayp2/data-structures/types"
-	"github.com/untref-ayp2/data-structures/utils"
+const (
+	// HeapPersonalizado es un heap creado con una función de comparación
+	// propia, como los de NewGenericHeap.
+	HeapPersonalizado TipoHeap = iota
+	// HeapDeMinimos es un heap de mínimos según el orden natural.
+	HeapDeMinimos
+	// HeapDeMaximos es un heap de máximos según el orden natural.
+	HeapDeMaximos
 )
 
 type Heap[T any] struct {
@@ -15,6 +44,15 @@ type Heap[T any] struct {
 	// devuelve -1 si a < b, 0 si a == b, 1 si a > b
 	// Para un heap de máximo, devuelve 1 si a < b, 0 si a == b, -1 si a > b
 	compare func(a T, b T) int
+	// constructor con el que se creó el heap; se conserva en las copias
+	tipo TipoHeap
+	// contadores de operaciones, o nil si no se activaron con EnableStats
+	stats *Estadisticas
+	// observadores registrados con Observe
+	observadores []observadorRegistrado[T]
+	// detecta el uso simultáneo desde varias goroutines con el build tag
+	// heapdebug; en otro caso no ocupa lugar ni hace nada
+	guardia guardia
 }
 
 // NewMinHeap crea un nuevo heap binario de mínimos.
@@ -25,8 +63,8 @@ type Heap[T any] struct {
 //
 // Retorna:
 //   - un puntero a un heap binario de mínimos.
-func NewMinHeap[T types.Ordered]() *Heap[T] {
-	return &Heap[T]{compare: utils.Compare[T], elements: make([]T, 0)}
+func NewMinHeap[T Ordered]() *Heap[T] {
+	return &Heap[T]{compare: cmp.Compare[T], elements: make([]T, 0), tipo: HeapDeMinimos}
 }
 
 // NewMaxHeap crea un nuevo heap binario de máximos.
@@ -37,12 +75,12 @@ func NewMinHeap[T types.Ordered]() *Heap[T] {
 //
 // Retorna:
 //   - un puntero a un heap binario de máximos.
-func NewMaxHeap[T types.Ordered]() *Heap[T] {
+func NewMaxHeap[T Ordered]() *Heap[T] {
 	comp := func(a T, b T) int {
-		return utils.Compare[T](b, a)
+		return cmp.Compare[T](b, a)
 	}
 
-	return &Heap[T]{compare: comp, elements: make([]T, 0)}
+	return &Heap[T]{compare: comp, elements: make([]T, 0), tipo: HeapDeMaximos}
 }
 
 // NewGenericHeap crea un nuevo heap binario con una función de comparación personalizada.
@@ -64,10 +102,59 @@ func NewMaxHeap[T types.Ordered]() *Heap[T] {
 //
 // Retorna:
 //   - un puntero a un heap binario con una función de comparación personalizada.
+//
+// Si `comp` es nil se produce un panic, ya que de otro modo el error recién
+// aparecería al insertar el segundo elemento.
 func NewGenericHeap[T any](comp func(a T, b T) int) *Heap[T] {
+	if comp == nil {
+		panic(Localizar("heap: la función de comparación no puede ser nil", "heap: comparison function must not be nil"))
+	}
+
 	return &Heap[T]{compare: comp, elements: make([]T, 0)}
 }
 
+// NewMinHeapWithCapacity crea un heap de mínimos con lugar reservado para
+// `n` elementos, para evitar que el arreglo interno se agrande y se copie
+// varias veces cuando se sabe de antemano cuántos elementos se van a
+// insertar.
+//
+// Uso:
+//
+//	heap := heap.NewMinHeapWithCapacity[int](1_000_000)
+//
+// Parámetros:
+//   - `n` capacidad inicial. Un valor negativo se toma como cero.
+//
+// Retorna:
+//   - un puntero a un heap binario de mínimos vacío.
+func NewMinHeapWithCapacity[T Ordered](n int) *Heap[T] {
+	heap := NewMinHeap[T]()
+	heap.elements = make([]T, 0, max(n, 0))
+
+	return heap
+}
+
+// NewMaxHeapWithCapacity crea un heap de máximos con lugar reservado para
+// `n` elementos, como NewMinHeapWithCapacity.
+func NewMaxHeapWithCapacity[T Ordered](n int) *Heap[T] {
+	heap := NewMaxHeap[T]()
+	heap.elements = make([]T, 0, max(n, 0))
+
+	return heap
+}
+
+// NewGenericHeapWithCapacity crea un heap con una función de comparación
+// personalizada y lugar reservado para `n` elementos, como
+// NewMinHeapWithCapacity.
+//
+// Si `comp` es nil se produce un panic, como en NewGenericHeap.
+func NewGenericHeapWithCapacity[T any](comp func(a T, b T) int, n int) *Heap[T] {
+	heap := NewGenericHeap(comp)
+	heap.elements = make([]T, 0, max(n, 0))
+
+	return heap
+}
+
 // Size retorna la cantidad de elementos en el heap.
 //
 // Uso:
@@ -75,11 +162,228 @@ func NewGenericHeap[T any](comp func(a T, b T) int) *Heap[T] {
 //	size := heap.Size()
 //
 // Retorna:
-//   - la cantidad de elementos en el heap.
+//   - la cantidad de elementos en el heap. Un heap nil no tiene elementos.
 func (m *Heap[T]) Size() int {
+	if m == nil {
+		return 0
+	}
+	m.guardia.entrar("Size")
+	defer m.guardia.salir()
+
 	return len(m.elements)
 }
 
+// IsEmpty indica si el heap no tiene elementos.
+//
+// Uso:
+//
+//	for !heap.IsEmpty() {
+//		element, _ := heap.Remove()
+//		...
+//	}
+//
+// Retorna:
+//   - true si el heap no tiene elementos. Un heap nil está vacío.
+func (m *Heap[T]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Peek retorna el elemento en la cima del heap sin eliminarlo.
+//
+// Uso:
+//
+//	heap := heap.NewMinHeap[int]()
+//	heap.Insert(5)
+//	element, _ := heap.Peek()
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos, o a
+//     ErrHeapNil si el heap es nil.
+func (m *Heap[T]) Peek() (T, error) {
+	return m.peek()
+}
+
+// Kind retorna el tipo del heap, que se registra al crearlo: de mínimos o de
+// máximos para los constructores con orden natural, y personalizado para los
+// que reciben una función de comparación. Las copias conservan el tipo.
+//
+// Uso:
+//
+//	if heap.Kind() == heap.HeapDeMaximos {
+//		...
+//	}
+//
+// Retorna:
+//   - el tipo del heap. Un heap nil se considera personalizado.
+func (m *Heap[T]) Kind() TipoHeap {
+	if m == nil {
+		return HeapPersonalizado
+	}
+
+	return m.tipo
+}
+
+// Values retorna una copia del arreglo interno del heap, en el orden exacto
+// en que está almacenado (no en orden de prioridad). Permite inspeccionar el
+// layout desde otros paquetes, tests y ejercicios sin acceder a campos no
+// exportados.
+//
+// Uso:
+//
+//	elementos := heap.Values()
+//
+// Retorna:
+//   - una copia del arreglo interno, o nil si el heap es nil. Modificarla no
+//     afecta al heap.
+func (m *Heap[T]) Values() []T {
+	if m == nil {
+		return nil
+	}
+	m.guardia.entrar("Values")
+	defer m.guardia.salir()
+	valores := make([]T, len(m.elements))
+	copy(valores, m.elements)
+
+	return valores
+}
+
+// Compare compara dos elementos con la función de comparación del heap.
+//
+// Uso:
+//
+//	if heap.Compare(a, b) < 0 {
+//		// a sale del heap antes que b
+//	}
+//
+// Retorna:
+//   - un valor negativo si `a` sale antes que `b`, cero si son equivalentes y
+//     un valor positivo si `b` sale antes que `a`.
+//
+// Un heap nil no tiene función de comparación, así que, como Insert, produce
+// un panic con un error que envuelve a ErrHeapNil.
+func (m *Heap[T]) Compare(a T, b T) int {
+	if m == nil {
+		panic(fmt.Errorf(Localizar("comparar: %w", "compare: %w"), ErrHeapNil))
+	}
+
+	return m.compare(a, b)
+}
+
+// IsValid indica si el heap cumple la propiedad de heap: ningún padre sale
+// después que sus hijos según la función de comparación. Sirve para que los
+// ejercicios que manipulan el heap verifiquen el resultado, y para
+// comprobaciones de depuración. Es O(n).
+//
+// Uso:
+//
+//	if !heap.IsValid() {
+//		t.Errorf("el heap no cumple la propiedad: %v", heap)
+//	}
+//
+// Retorna:
+//   - true si todos los pares padre-hijo están en orden. Un heap nil o vacío
+//     es válido.
+func (m *Heap[T]) IsValid() bool {
+	if m == nil {
+		return true
+	}
+	m.guardia.entrar("IsValid")
+	defer m.guardia.salir()
+	for i := 1; i < len(m.elements); i++ {
+		if m.compare(m.elements[(i-1)/2], m.elements[i]) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Contains indica si el heap tiene un elemento igual a `element`. Recorre
+// todo el arreglo, así que es O(n).
+//
+// Uso:
+//
+//	encolado := frontera.Contains(v, func(a, b Vertice) bool { return a.ID == b.ID })
+//
+// Parámetros:
+//   - `element` elemento a buscar.
+//   - `eq` función de igualdad. Si es nil, dos elementos son iguales cuando la
+//     función de comparación del heap da cero.
+//
+// Retorna:
+//   - true si algún elemento es igual a `element`. Un heap nil no tiene
+//     elementos.
+func (m *Heap[T]) Contains(element T, eq func(a T, b T) bool) bool {
+	if m == nil {
+		return false
+	}
+	m.guardia.entrar("Contains")
+	defer m.guardia.salir()
+	if eq == nil {
+		return m.buscar(element) >= 0
+	}
+	for _, e := range m.elements {
+		if eq(e, element) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Equals indica si dos heaps tienen los mismos elementos en el mismo orden
+// del arreglo interno, es decir, el mismo layout. Dos heaps con los mismos
+// elementos pero armados en distinto orden pueden no ser iguales. No compara
+// las funciones de comparación.
+//
+// Uso:
+//
+//	if !obtenido.Equals(esperado, nil) {
+//		t.Errorf("se esperaba %v y se obtuvo %v", esperado.Values(), obtenido.Values())
+//	}
+//
+// Parámetros:
+//   - `other` heap con el que se compara.
+//   - `eq` función de igualdad. Si es nil, dos elementos son iguales cuando la
+//     función de comparación del heap da cero.
+//
+// Retorna:
+//   - true si ambos heaps tienen el mismo tamaño y los mismos elementos en
+//     cada posición. Dos heaps nil son iguales; un heap nil no es igual a uno
+//     que no lo es, aunque esté vacío.
+func (m *Heap[T]) Equals(other *Heap[T], eq func(a T, b T) bool) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	m.guardia.entrar("Equals")
+	defer m.guardia.salir()
+	if eq == nil {
+		eq = func(a, b T) bool { return m.compare(a, b) == 0 }
+	}
+	valores := other.Values()
+	if len(valores) != len(m.elements) {
+		return false
+	}
+	for i, e := range m.elements {
+		if !eq(e, valores[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SonIguales es Equals para heaps de tipos con orden natural, en los que dos
+// elementos son iguales cuando cmp.Compare da cero.
+//
+// Uso:
+//
+//	iguales := heap.SonIguales(h1, h2)
+func SonIguales[T Ordered](a, b *Heap[T]) bool {
+	return a.Equals(b, func(x, y T) bool { return cmp.Compare(x, y) == 0 })
+}
+
 // Insert agrega un elemento al heap.
 //
 // Uso:
@@ -90,24 +394,136 @@ func (m *Heap[T]) Size() int {
 // Parámetros:
 //
 //	element: elemento a agregar al heap.
+//
+// Insertar en un heap nil produce un panic que envuelve a ErrHeapNil.
 func (m *Heap[T]) Insert(element T) {
+	if m == nil {
+		panic(fmt.Errorf(Localizar("insertar: %w", "insert: %w"), ErrHeapNil))
+	}
+	m.guardia.entrar("Insert")
+	defer m.guardia.salir()
 	m.elements = append(m.elements, element)
+	m.registrarInsercion(element)
 	m.upHeap(len(m.elements) - 1)
 }
 
+// Clear elimina todos los elementos del heap. Conserva la memoria ya
+// reservada, así que reutilizar el heap no vuelve a pedir memoria hasta
+// superar el tamaño que tenía.
+//
+// Uso:
+//
+//	heap.Clear()
+//
+// Limpiar un heap nil no tiene efecto.
+func (m *Heap[T]) Clear() {
+	if m == nil {
+		return
+	}
+	m.guardia.entrar("Clear")
+	defer m.guardia.salir()
+	// se ponen en cero para no retener los elementos que el heap ya no usa
+	clear(m.elements)
+	m.elements = m.elements[:0]
+}
+
+// Reserve agranda el arreglo interno, si hace falta, para que entren `n`
+// elementos más sin volver a pedir memoria, como slices.Grow. Conviene
+// llamarlo antes de una carga masiva cuando el heap ya existe.
+//
+// Uso:
+//
+//	heap.Reserve(len(lote))
+//	for _, v := range lote {
+//		heap.Insert(v)
+//	}
+//
+// Parámetros:
+//   - `n` cantidad de elementos que se van a agregar. Si es negativo o ya hay
+//     lugar no tiene efecto.
+//
+// Reservar en un heap nil no tiene efecto.
+func (m *Heap[T]) Reserve(n int) {
+	if m == nil || n <= 0 {
+		return
+	}
+	m.guardia.entrar("Reserve")
+	defer m.guardia.salir()
+	m.elements = slices.Grow(m.elements, n)
+}
+
+// ShrinkToFit libera la memoria que el arreglo interno tiene reservada de
+// más, copiando los elementos a un arreglo de su mismo tamaño. El arreglo no
+// se achica solo al extraer elementos, porque eso desharía lo que se pidió
+// con Reserve o con los constructores con capacidad; conviene llamar a
+// ShrinkToFit en heaps de larga vida después de vaciar buena parte de ellos.
+// Es O(n).
+//
+// Uso:
+//
+//	_, _ = heap.PopN(heap.Size() - 10)
+//	heap.ShrinkToFit()
+//
+// Achicar un heap nil no tiene efecto.
+func (m *Heap[T]) ShrinkToFit() {
+	if m == nil {
+		return
+	}
+	m.guardia.entrar("ShrinkToFit")
+	defer m.guardia.salir()
+	if cap(m.elements) == len(m.elements) {
+		return
+	}
+	ajustado := make([]T, len(m.elements))
+	copy(ajustado, m.elements)
+	m.elements = ajustado
+}
+
+// Invertir convierte el heap de mínimos en uno de máximos o viceversa, en
+// O(n): invierte la función de comparación y reordena con heapify. Es más
+// rápido que extraer todos los elementos e insertarlos en un heap nuevo, que
+// es O(n log n). Los heaps personalizados siguen siéndolo, con el orden
+// invertido.
+//
+// Uso:
+//
+//	heap := heap.NuevoMonticuloMinDesdeArreglo(datos)
+//	heap.Invertir() // ahora la cima es el máximo
+//
+// Invertir un heap nil no tiene efecto.
+func (m *Heap[T]) Invertir() {
+	if m == nil {
+		return
+	}
+	m.guardia.entrar("Invertir")
+	defer m.guardia.salir()
+	compare := m.compare
+	m.compare = func(a T, b T) int { return compare(b, a) }
+	switch m.tipo {
+	case HeapDeMinimos:
+		m.tipo = HeapDeMaximos
+	case HeapDeMaximos:
+		m.tipo = HeapDeMinimos
+	}
+	m.heapify()
+}
+
 // upHeap reordena el heap hacia arriba.
 //
 // Parámetros:
 //   - `i` índice del elemento a reordenar.
 func (m *Heap[T]) upHeap(i int) {
+	niveles := 0
 	for i > 0 {
 		parent := (i - 1) / 2
-		if m.compare(m.elements[i], m.elements[parent]) > 0 {
+		if m.comparar(m.elements[i], m.elements[parent]) > 0 {
 			break
 		}
-		m.elements[i], m.elements[parent] = m.elements[parent], m.elements[i]
+		m.intercambiar(i, parent)
 		i = parent
+		niveles++
 	}
+	m.registrarReacomodo(niveles)
 }
 
 // Remove elimina y retorna el elemento en la cima del heap.
@@ -120,34 +536,218 @@ func (m *Heap[T]) upHeap(i int) {
 //
 // Retorna:
 //   - el elemento en la cima del heap.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos, o a
+//     ErrHeapNil si el heap es nil.
 func (m *Heap[T]) Remove() (T, error) {
 	var element T
+	if m == nil {
+		return element, fmt.Errorf(Localizar("extraer: %w", "remove: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Remove")
+	defer m.guardia.salir()
 	if m.Size() == 0 {
-		return element, errors.New("heap vacío")
+		return element, fmt.Errorf(Localizar("extraer: %w", "remove: %w"), ErrHeapVacio)
 	}
 	element = m.elements[0]
 	m.elements[0] = m.elements[m.Size()-1]
 	m.elements = m.elements[:m.Size()-1]
+	m.registrarExtraccion(element)
 	m.downHeap(0)
 
 	return element, nil
 }
 
+// Replace extrae la cima y agrega un elemento nuevo con un único recorrido
+// hacia abajo: el nuevo elemento ocupa el lugar de la cima y se reubica. Hace
+// la mitad de trabajo que un Remove seguido de un Insert, por lo que es la
+// operación habitual para mantener los k mayores de una secuencia en un heap
+// de mínimos. El elemento retornado puede ser menos prioritario que el nuevo.
+//
+// Uso:
+//
+//	if v > cima {
+//		heap.Replace(v)
+//	}
+//
+// Parámetros:
+//   - `element` elemento a agregar.
+//
+// Retorna:
+//   - el elemento que estaba en la cima.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos, en
+//     cuyo caso `element` no se agrega.
+func (m *Heap[T]) Replace(element T) (T, error) {
+	var cima T
+	if m == nil {
+		return cima, fmt.Errorf(Localizar("reemplazar: %w", "replace: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Replace")
+	defer m.guardia.salir()
+	if len(m.elements) == 0 {
+		return cima, fmt.Errorf(Localizar("reemplazar: %w", "replace: %w"), ErrHeapVacio)
+	}
+	cima = m.elements[0]
+	m.elements[0] = element
+	m.registrarExtraccion(cima)
+	m.registrarInsercion(element)
+	m.downHeap(0)
+
+	return cima, nil
+}
+
+// Delete elimina un elemento cualquiera del heap: lo busca, lo reemplaza por
+// el último y reubica a este hacia arriba o hacia abajo. La búsqueda es O(n)
+// y la reubicación O(log n).
+//
+// Uso:
+//
+//	err := heap.Delete(5)
+//
+// Parámetros:
+//   - `element` elemento a eliminar. Se elimina el primero que se encuentre
+//     para el que la función de comparación da cero.
+//
+// Retorna:
+//   - un error que envuelve a ErrElementoInexistente si no hay un elemento
+//     equivalente, o a ErrHeapNil si el heap es nil.
+func (m *Heap[T]) Delete(element T) error {
+	if m == nil {
+		return fmt.Errorf(Localizar("eliminar: %w", "delete: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Delete")
+	defer m.guardia.salir()
+	i := m.buscar(element)
+	if i < 0 {
+		return fmt.Errorf(Localizar("eliminar %v: %w", "delete %v: %w"), element, ErrElementoInexistente)
+	}
+	m.eliminarEn(i)
+
+	return nil
+}
+
+// RemoveWhere elimina todos los elementos que cumplen el predicado con una
+// sola pasada por el arreglo y un único heapify, en O(n) sin importar
+// cuántos se eliminen. Eliminarlos de a uno con Delete costaría O(n) por
+// cada uno. Sirve para descartar de una vez tareas o eventos cancelados.
+//
+// Uso:
+//
+//	canceladas := cola.RemoveWhere(func(t Tarea) bool { return t.Cancelada })
+//
+// Parámetros:
+//   - `pred` predicado que cumplen los elementos a eliminar.
+//
+// Retorna:
+//   - la cantidad de elementos eliminados. Un heap nil no tiene elementos.
+func (m *Heap[T]) RemoveWhere(pred func(T) bool) int {
+	if m == nil {
+		return 0
+	}
+	m.guardia.entrar("RemoveWhere")
+	defer m.guardia.salir()
+	conservados := m.elements[:0]
+	for _, e := range m.elements {
+		if !pred(e) {
+			conservados = append(conservados, e)
+		}
+	}
+	eliminados := len(m.elements) - len(conservados)
+	if eliminados == 0 {
+		return 0
+	}
+	// se borran las posiciones que quedaron libres para no retener punteros
+	clear(m.elements[len(conservados):])
+	m.elements = conservados
+	m.heapify()
+
+	return eliminados
+}
+
+// Update reemplaza un elemento por otro y lo reubica con un único
+// recorrido hacia arriba o hacia abajo, según la nueva prioridad sea mayor o
+// menor. Es la forma de cambiar la prioridad de un elemento sin reconstruir
+// el heap. La búsqueda es O(n) y la reubicación O(log n).
+//
+// Uso:
+//
+//	err := heap.Update(tarea, tareaConNuevaPrioridad)
+//
+// Parámetros:
+//   - `old` elemento a reemplazar. Se reemplaza el primero que se encuentre
+//     para el que la función de comparación da cero.
+//   - `new` elemento que lo reemplaza.
+//
+// Retorna:
+//   - un error que envuelve a ErrElementoInexistente si no hay un elemento
+//     equivalente a `old`, o a ErrHeapNil si el heap es nil.
+func (m *Heap[T]) Update(old, new T) error {
+	if m == nil {
+		return fmt.Errorf(Localizar("actualizar: %w", "update: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Update")
+	defer m.guardia.salir()
+	i := m.buscar(old)
+	if i < 0 {
+		return fmt.Errorf(Localizar("actualizar %v: %w", "update %v: %w"), old, ErrElementoInexistente)
+	}
+	m.reemplazar(i, new)
+
+	return nil
+}
+
+// buscar retorna la posición de un elemento equivalente a `element`, o -1.
+func (m *Heap[T]) buscar(element T) int {
+	for i, e := range m.elements {
+		if m.comparar(e, element) == 0 {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// reemplazar cambia el elemento de la posición i y lo reubica hacia arriba o
+// hacia abajo según haga falta.
+func (m *Heap[T]) reemplazar(i int, element T) {
+	m.elements[i] = element
+	if i > 0 && m.comparar(element, m.elements[(i-1)/2]) < 0 {
+		m.upHeap(i)
+	} else {
+		m.downHeap(i)
+	}
+}
+
+// eliminarEn quita el elemento de la posición i reemplazándolo por el último.
+func (m *Heap[T]) eliminarEn(i int) T {
+	element := m.elements[i]
+	ultimo := len(m.elements) - 1
+	m.elements[i] = m.elements[ultimo]
+	var cero T
+	m.elements[ultimo] = cero
+	m.elements = m.elements[:ultimo]
+	if i < ultimo {
+		m.reemplazar(i, m.elements[i])
+	}
+
+	return element
+}
+
 // downHeap reordena el heap hacia abajo.
 //
 // Parámetros:
 //   - `i` índice del elemento a reordenar.
 func (m *Heap[T]) downHeap(i int) {
+	niveles := 0
 	for {
 		left := 2*i + 1
 		right := 2*i + 2
 		smallest := i
 
-		if left < m.Size() && m.compare(m.elements[left], m.elements[smallest]) < 0 {
+		if left < m.Size() && m.comparar(m.elements[left], m.elements[smallest]) < 0 {
 			smallest = left
 		}
 
-		if right < m.Size() && m.compare(m.elements[right], m.elements[smallest]) < 0 {
+		if right < m.Size() && m.comparar(m.elements[right], m.elements[smallest]) < 0 {
 			smallest = right
 		}
 
@@ -155,64 +755,254 @@ func (m *Heap[T]) downHeap(i int) {
 			break
 		}
 
-		m.elements[i], m.elements[smallest] = m.elements[smallest], m.elements[i]
+		m.intercambiar(i, smallest)
 		i = smallest
+		niveles++
 	}
+	m.registrarReacomodo(niveles)
 }
 
-func NuevoMonticuloMaxDesdeArreglo[T types.Ordered](arr []T) *Heap[T] {
-    // Crear un nuevo heap de máximos
-    heap := NewMaxHeap[T]()
-    
-    // Insertar cada elemento del arreglo en el heap
-    for _, element := range arr {
-        heap.Insert(element)
-    }
-    
-    return heap
+// heapify reordena todo el arreglo para que cumpla la propiedad de heap,
+// aplicando downHeap desde el último nodo con hijos hasta la raíz. Es O(n).
+func (m *Heap[T]) heapify() {
+	for i := len(m.elements)/2 - 1; i >= 0; i-- {
+		m.downHeap(i)
+	}
 }
 
-func EnesimoMaximo[T types.Ordered](heap *Heap[T], n int) (T, error) {
+// NuevoMonticuloMaxDesdeArreglo crea un heap de máximos con los elementos de
+// un arreglo desordenado. Usa el algoritmo de Floyd: copia el arreglo y
+// aplica downHeap desde el último nodo con hijos hasta la raíz, lo que cuesta
+// O(n) en lugar del O(n log n) de insertar los elementos de a uno.
+//
+// Uso:
+//
+//	heap := heap.NuevoMonticuloMaxDesdeArreglo([]int{3, 1, 6, 5, 2, 4})
+//
+// Parámetros:
+//   - `arr` elementos del heap. Se copian, así que modificar el arreglo
+//     después no afecta al heap.
+//
+// Retorna:
+//   - un puntero a un heap de máximos con los elementos del arreglo.
+func NuevoMonticuloMaxDesdeArreglo[T Ordered](arr []T) *Heap[T] {
+	heap := NewMaxHeap[T]()
+	heap.elements = append(heap.elements, arr...)
+	heap.heapify()
+
+	return heap
+}
+
+// NuevoMonticuloMinDesdeArreglo crea un heap de mínimos con los elementos de
+// un arreglo desordenado, en O(n), igual que NuevoMonticuloMaxDesdeArreglo.
+//
+// Uso:
+//
+//	heap := heap.NuevoMonticuloMinDesdeArreglo([]int{3, 1, 6, 5, 2, 4})
+//
+// Parámetros:
+//   - `arr` elementos del heap. Se copian, así que modificar el arreglo
+//     después no afecta al heap.
+//
+// Retorna:
+//   - un puntero a un heap de mínimos con los elementos del arreglo.
+func NuevoMonticuloMinDesdeArreglo[T Ordered](arr []T) *Heap[T] {
+	heap := NewMinHeap[T]()
+	heap.elements = append(heap.elements, arr...)
+	heap.heapify()
+
+	return heap
+}
+
+// NuevoMonticuloDesdeArregloConComparador crea un heap con los elementos de
+// un arreglo desordenado y una función de comparación personalizada, en
+// O(n), igual que NuevoMonticuloMaxDesdeArreglo.
+//
+// Uso:
+//
+//	guardia := heap.NuevoMonticuloDesdeArregloConComparador(pacientes, func(a, b Paciente) int {
+//		return b.Prioridad - a.Prioridad
+//	})
+//
+// Parámetros:
+//   - `arr` elementos del heap. Se copian, así que modificar el arreglo
+//     después no afecta al heap (salvo que los elementos sean punteros).
+//   - `comp` función de comparación, con la misma convención que NewGenericHeap.
+//
+// Retorna:
+//   - un puntero a un heap con los elementos del arreglo.
+//
+// Si `comp` es nil se produce un panic, como en NewGenericHeap.
+func NuevoMonticuloDesdeArregloConComparador[T any](arr []T, comp func(a T, b T) int) *Heap[T] {
+	heap := NewGenericHeap(comp)
+	heap.elements = append(heap.elements, arr...)
+	heap.heapify()
+
+	return heap
+}
+
+// AdoptarArreglo crea un heap que usa como almacenamiento el mismo arreglo
+// que recibe, ordenándolo en el lugar con heapify en O(n) y sin pedir
+// memoria para los elementos. Sirve para conjuntos de datos grandes, donde
+// copiar el arreglo duplicaría la memoria.
+//
+// El heap pasa a ser dueño del arreglo: después de llamar a esta función el
+// arreglo no debe usarse ni modificarse, porque el heap lo reordena y lo
+// sobrescribe con cada operación.
+//
+// Uso:
+//
+//	heap := heap.AdoptarArreglo(lecturas, cmp.Compare[float64])
+//	lecturas = nil // a partir de acá el arreglo es del heap
+//
+// Parámetros:
+//   - `arr` arreglo a adoptar.
+//   - `comp` función de comparación, con la misma convención que NewGenericHeap.
+//
+// Retorna:
+//   - un puntero a un heap con los elementos del arreglo.
+func AdoptarArreglo[T any](arr []T, comp func(a T, b T) int) *Heap[T] {
+	heap := NewGenericHeap(comp)
+	if arr != nil {
+		heap.elements = arr
+	}
+	heap.heapify()
+
+	return heap
+}
+
+// EnesimoMaximo retorna el n-ésimo elemento en orden de prioridad: en un
+// heap de máximos, el n-ésimo mayor. El heap no se modifica y no se copia:
+// se recorre el árbol con un heap auxiliar de candidatos, como PeekN, así
+// que el costo es O(n log n) sin importar el tamaño del heap.
+//
+// Uso:
+//
+//	tercero, err := heap.EnesimoMaximo(maximos, 3)
+//
+// Parámetros:
+//   - `heap` heap a consultar.
+//   - `n` posición buscada, empezando en 1 para la cima.
+//
+// Retorna:
+//   - el n-ésimo elemento.
+//   - un error que envuelve a ErrFueraDeRango si `n` no está entre 1 y la
+//     cantidad de elementos, o a ErrHeapNil si el heap es nil.
+func EnesimoMaximo[T Ordered](heap *Heap[T], n int) (T, error) {
 	var maximo T
-	var err error
+	if heap == nil {
+		return maximo, fmt.Errorf(Localizar("enésimo máximo: %w", "nth maximum: %w"), ErrHeapNil)
+	}
 	if n < 1 || n > heap.Size() {
-		return maximo, errors.New("n debe estar en el rango de 1 a M")
+		return maximo, fmt.Errorf(Localizar("%w: se pidió n = %d en un heap de %d elementos",
+			"%w: asked for n = %d in a heap of %d elements"), ErrFueraDeRango, n, heap.Size())
 	}
 
-	// Cre una copia del heap para no modificar el original
-	copiaHeap := &Heap[T]{compare: heap.compare, elements: make([]T, len(heap.elements))}
-	copy(copiaHeap.elements, heap.elements)
+	primeros, err := heap.PeekN(n)
+	if err != nil {
+		return maximo, fmt.Errorf(Localizar("enésimo máximo %d: %w", "nth maximum %d: %w"), n, err)
+	}
 
-	for i := 0; i < n; i++ {
-		maximo, err = copiaHeap.Remove()
+	return primeros[n-1], nil
+}
+
+// EnesimoMinimo retorna el n-ésimo menor elemento del heap según el orden
+// natural, sea el heap de mínimos o de máximos. Es la contraparte de
+// EnesimoMaximo, con el mismo contrato. El heap no se modifica. Si es de
+// mínimos se usa PeekN, en O(n log n) sin importar el tamaño del heap; en
+// otro caso se arma un heap de mínimos con una copia de los elementos en O(m)
+// y se extraen n elementos.
+//
+// Uso:
+//
+//	segundoMenor, err := heap.EnesimoMinimo(maximos, 2)
+//
+// Parámetros:
+//   - `heap` heap a consultar.
+//   - `n` posición buscada, empezando en 1 para el mínimo.
+//
+// Retorna:
+//   - el n-ésimo menor elemento.
+//   - un error que envuelve a ErrFueraDeRango si `n` no está entre 1 y la
+//     cantidad de elementos, o a ErrHeapNil si el heap es nil.
+func EnesimoMinimo[T Ordered](heap *Heap[T], n int) (T, error) {
+	var minimo T
+	if heap == nil {
+		return minimo, fmt.Errorf(Localizar("enésimo mínimo: %w", "nth minimum: %w"), ErrHeapNil)
+	}
+	if n < 1 || n > heap.Size() {
+		return minimo, fmt.Errorf(Localizar("%w: se pidió n = %d en un heap de %d elementos",
+			"%w: asked for n = %d in a heap of %d elements"), ErrFueraDeRango, n, heap.Size())
+	}
+
+	if heap.Kind() == HeapDeMinimos {
+		primeros, err := heap.PeekN(n)
 		if err != nil {
-			return maximo, err
+			return minimo, fmt.Errorf(Localizar("enésimo mínimo %d: %w", "nth minimum %d: %w"), n, err)
 		}
+
+		return primeros[n-1], nil
+	}
+
+	minimos := NuevoMonticuloMinDesdeArreglo(heap.Values())
+	for i := 0; i < n; i++ {
+		minimo, _ = minimos.Remove()
 	}
 
-	return maximo, nil
+	return minimo, nil
 }
 
-func CombinarMonticulos[T types.Ordered](heap1, heap2 *Heap[T]) *Heap[T] {
-	// Determinar el tipo de heap
-	var combinedHeap *Heap[T]
-	if heap1.Size() > 1 && heap1.compare(heap1.elements[0], heap1.elements[1]) > 0 {
-		// Si el primer elemento es mayor que el segundo, crear un nuevo max-heap
-		combinedHeap = NewMaxHeap[T]()
-	} else {
-		// Si el primer elemento es menor que el segundo o si hay solo un elemento, crear un nuevo min-heap
-		combinedHeap = NewMinHeap[T]()
-	}
+// CombinarMonticulos crea un heap con los elementos de los dos heaps, que no
+// se modifican. Es CombinarMonticulosGenericos para heaps de tipos con orden
+// natural.
+//
+// Uso:
+//
+//	combinado := heap.CombinarMonticulos(heap1, heap2)
+func CombinarMonticulos[T Ordered](heap1, heap2 *Heap[T]) *Heap[T] {
+	return CombinarMonticulosGenericos(heap1, heap2)
+}
 
-	// Insertar todos los elementos del primer heap en el combinado
-	for _, element := range heap1.elements {
-		combinedHeap.Insert(element)
+// CombinarMonticulosGenericos crea un heap con los elementos de los dos
+// heaps, que no se modifican, en O(n + m): concatena los arreglos y los
+// reordena con heapify. Acepta heaps de cualquier tipo, como los
+// creados con NewGenericHeap. El resultado usa la función de comparación y el
+// tipo del primero, así que conserva su orden aunque sea personalizado; los
+// elementos del segundo se reordenan según ese orden, por lo que los heaps no
+// necesitan tener la misma función de comparación.
+//
+// Uso:
+//
+//	guardia := heap.CombinarMonticulosGenericos(guardiaManana, guardiaTarde)
+//
+// Parámetros:
+//   - `heap1` heap cuyo orden se usa para el resultado.
+//   - `heap2` heap con los demás elementos.
+//
+// Retorna:
+//   - un puntero al heap combinado. Un heap nil se combina como si estuviera
+//     vacío y, si ambos son nil, el resultado es nil.
+func CombinarMonticulosGenericos[T any](heap1, heap2 *Heap[T]) *Heap[T] {
+	// Un heap nil se combina como si estuviera vacío
+	if heap1 == nil {
+		heap1, heap2 = heap2, heap1
 	}
-
-	// Insertar todos los elementos del segundo heap en el combinado
-	for _, element := range heap2.elements {
-		combinedHeap.Insert(element)
+	if heap1 == nil {
+		return nil
 	}
+	if heap2 == nil {
+		heap2 = &Heap[T]{compare: heap1.compare, tipo: heap1.tipo}
+	}
+
+	// El combinado usa la función de comparación del primer heap, así que
+	// tiene su mismo orden aunque sea personalizado
+	combinedHeap := &Heap[T]{compare: heap1.compare, elements: make([]T, 0, heap1.Size()+heap2.Size()), tipo: heap1.tipo}
+
+	// Se concatenan ambos arreglos y se reordena con un solo heapify, en
+	// O(n + m) en lugar de insertar los elementos de a uno
+	combinedHeap.elements = append(combinedHeap.elements, heap1.elements...)
+	combinedHeap.elements = append(combinedHeap.elements, heap2.elements...)
+	combinedHeap.heapify()
 
 	return combinedHeap
-}
\ No newline at end of file
+}
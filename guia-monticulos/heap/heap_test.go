@@ -0,0 +1,513 @@
+package heap
+
+import (
+	"cmp"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeapNilSize(t *testing.T) {
+	var m *Heap[int]
+	assert.Equal(t, 0, m.Size())
+}
+
+func TestHeapNilRemove(t *testing.T) {
+	var m *Heap[int]
+	_, err := m.Remove()
+	assert.ErrorIs(t, err, ErrHeapNil)
+}
+
+func TestHeapNilInsert(t *testing.T) {
+	var m *Heap[int]
+	assert.PanicsWithError(t, "insertar: heap nil", func() { m.Insert(1) })
+}
+
+func TestHeapNilCompare(t *testing.T) {
+	var m *Heap[int]
+	assert.PanicsWithError(t, "comparar: heap nil", func() { m.Compare(1, 2) })
+}
+
+func TestNewGenericHeapComparadorNil(t *testing.T) {
+	assert.PanicsWithValue(t, "heap: la función de comparación no puede ser nil", func() {
+		NewGenericHeap[int](nil)
+	})
+}
+
+func TestEnesimoMaximoHeapNil(t *testing.T) {
+	_, err := EnesimoMaximo[int](nil, 3)
+	assert.ErrorIs(t, err, ErrHeapNil)
+}
+
+func TestCombinarMonticulosConNil(t *testing.T) {
+	h := NewMaxHeap[int]()
+	h.Insert(3)
+	h.Insert(9)
+	h.Insert(5)
+
+	combinado := CombinarMonticulos(nil, h)
+	assert.Equal(t, 3, combinado.Size())
+
+	combinado = CombinarMonticulos(h, nil)
+	assert.Equal(t, 3, combinado.Size())
+
+	assert.Nil(t, CombinarMonticulos[int](nil, nil))
+}
+
+func minimoConCmp[T cmp.Ordered](valores ...T) T {
+	h := NewMinHeap[T]()
+	for _, v := range valores {
+		h.Insert(v)
+	}
+	min, _ := h.Remove()
+
+	return min
+}
+
+func maximoConCmp[T cmp.Ordered](valores ...T) T {
+	h := NewMaxHeap[T]()
+	for _, v := range valores {
+		h.Insert(v)
+	}
+	max, _ := h.Remove()
+
+	return max
+}
+
+func TestConstructoresAceptanCmpOrdered(t *testing.T) {
+	assert.Equal(t, 1.5, minimoConCmp(3.0, 1.5, 2.0))
+	assert.Equal(t, "z", maximoConCmp("a", "z", "m"))
+	assert.Equal(t, 2, enesimoMaximoDesdeArreglo(2, 1, 3, 2))
+}
+
+func enesimoMaximoDesdeArreglo[T cmp.Ordered](n int, valores ...T) T {
+	v, _ := EnesimoMaximo(NuevoMonticuloMaxDesdeArreglo(valores), n)
+
+	return v
+}
+
+func TestComparadorPorDefectoOrdenaNaNPrimero(t *testing.T) {
+	h := NewMinHeap[float64]()
+	h.Insert(2.0)
+	h.Insert(math.NaN())
+	h.Insert(-1.0)
+
+	v, _ := h.Remove()
+	assert.True(t, math.IsNaN(v))
+	v, _ = h.Remove()
+	assert.Equal(t, -1.0, v)
+	v, _ = h.Remove()
+	assert.Equal(t, 2.0, v)
+}
+
+func TestValuesRetornaUnaCopiaEnOrdenDelArreglo(t *testing.T) {
+	h := NewMaxHeap[int]()
+	for _, v := range []int{1, 5, 3} {
+		h.Insert(v)
+	}
+
+	valores := h.Values()
+	assert.Equal(t, []int{5, 1, 3}, valores)
+	valores[0] = 0
+	assert.Equal(t, []int{5, 1, 3}, h.Values())
+
+	var nilHeap *Heap[int]
+	assert.Nil(t, nilHeap.Values())
+}
+
+func TestCompareUsaElComparadorDelHeap(t *testing.T) {
+	assert.Negative(t, NewMinHeap[int]().Compare(1, 2))
+	assert.Positive(t, NewMaxHeap[int]().Compare(1, 2))
+}
+
+func TestPeekNoModificaElHeap(t *testing.T) {
+	h := NewMaxHeap[int]()
+	for _, v := range []int{3, 9, 4} {
+		h.Insert(v)
+	}
+
+	v, err := h.Peek()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 9, v)
+	assert.Equal(t, []int{9, 3, 4}, h.Values())
+}
+
+func TestPeekHeapVacioYNil(t *testing.T) {
+	_, err := NewMinHeap[int]().Peek()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+	assert.EqualError(t, err, "consultar: heap vacío")
+
+	var m *Heap[int]
+	_, err = m.Peek()
+	assert.ErrorIs(t, err, ErrHeapNil)
+}
+
+func TestIsEmpty(t *testing.T) {
+	h := NewMinHeap[int]()
+	assert.True(t, h.IsEmpty())
+
+	h.Insert(1)
+	assert.False(t, h.IsEmpty())
+
+	_, _ = h.Remove()
+	assert.True(t, h.IsEmpty())
+
+	var m *Heap[int]
+	assert.True(t, m.IsEmpty())
+}
+
+func TestClearConservaLaCapacidad(t *testing.T) {
+	h := NewMinHeap[int]()
+	for i := 0; i < 100; i++ {
+		h.Insert(i)
+	}
+	capacidad := cap(h.elements)
+
+	h.Clear()
+
+	assert.True(t, h.IsEmpty())
+	assert.Equal(t, capacidad, cap(h.elements))
+	h.Insert(7)
+	h.Insert(3)
+	v, _ := h.Peek()
+	assert.Equal(t, 3, v)
+
+	var m *Heap[int]
+	assert.NotPanics(t, m.Clear)
+}
+
+func TestReserveEvitaAgrandarElArreglo(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{3, 1, 2})
+
+	h.Reserve(100)
+	assert.GreaterOrEqual(t, cap(h.elements), 103)
+	capacidad := cap(h.elements)
+	for i := 0; i < 100; i++ {
+		h.Insert(i)
+	}
+	assert.Equal(t, capacidad, cap(h.elements))
+	assert.Equal(t, 103, h.Size())
+
+	// si ya hay lugar no cambia nada
+	h.Reserve(0)
+	h.Reserve(-5)
+	assert.Equal(t, capacidad, cap(h.elements))
+
+	var m *Heap[int]
+	assert.NotPanics(t, func() { m.Reserve(10) })
+}
+
+func TestShrinkToFitLiberaLaCapacidadSobrante(t *testing.T) {
+	h := NewMinHeap[int]()
+	for i := 1000; i > 0; i-- {
+		h.Insert(i)
+	}
+	_, err := h.PopN(990)
+	assert.NoError(t, err)
+
+	h.ShrinkToFit()
+	assert.Equal(t, 10, cap(h.elements))
+	assert.Equal(t, []int{991, 992, 993, 994, 995, 996, 997, 998, 999, 1000}, h.ToSortedSlice())
+
+	h.Clear()
+	h.ShrinkToFit()
+	assert.Equal(t, 0, cap(h.elements))
+	h.Insert(1)
+	assert.Equal(t, 1, h.Size())
+
+	var m *Heap[int]
+	assert.NotPanics(t, m.ShrinkToFit)
+}
+
+func TestClearNoRetieneLosElementos(t *testing.T) {
+	h := NewGenericHeap(func(a, b *int) int { return *a - *b })
+	uno := 1
+	h.Insert(&uno)
+
+	h.Clear()
+
+	assert.Nil(t, h.elements[:1][0])
+}
+
+func TestDeleteReubicaAlUltimo(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{1, 10, 2, 11, 12, 3, 4})
+
+	// el 4 reemplaza al 11 y tiene que subir por encima del 10
+	assert.NoError(t, h.Delete(11))
+	assert.Equal(t, []int{1, 4, 2, 10, 12, 3}, h.Values())
+
+	// el 3 reemplaza al 1 y tiene que bajar
+	assert.NoError(t, h.Delete(1))
+	assert.Equal(t, []int{2, 4, 3, 10, 12}, h.Values())
+
+	assert.NoError(t, h.Delete(12))
+	assert.Equal(t, []int{2, 3, 4, 10}, extraerTodos(h))
+}
+
+func TestDeleteInexistenteYNil(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{1, 2})
+
+	err := h.Delete(7)
+	assert.ErrorIs(t, err, ErrElementoInexistente)
+	assert.EqualError(t, err, "eliminar 7: elemento inexistente")
+	assert.Equal(t, 2, h.Size())
+
+	var nulo *Heap[int]
+	assert.ErrorIs(t, nulo.Delete(1), ErrHeapNil)
+}
+
+func TestUpdateSubeOBajaSegunLaNuevaPrioridad(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{1, 10, 2, 11, 12, 3, 4})
+
+	// el 12 pasa a ser el menor y tiene que llegar a la cima
+	assert.NoError(t, h.Update(12, 0))
+	assert.Equal(t, []int{0, 1, 2, 11, 10, 3, 4}, h.Values())
+
+	// el 0 pasa a ser el mayor y tiene que bajar hasta una hoja
+	assert.NoError(t, h.Update(0, 20))
+	assert.Equal(t, []int{1, 10, 2, 11, 20, 3, 4}, h.Values())
+
+	assert.Equal(t, []int{1, 2, 3, 4, 10, 11, 20}, extraerTodos(h))
+}
+
+func TestUpdateInexistenteYNil(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{1, 2})
+
+	err := h.Update(7, 0)
+	assert.ErrorIs(t, err, ErrElementoInexistente)
+	assert.EqualError(t, err, "actualizar 7: elemento inexistente")
+	assert.Equal(t, []int{1, 2}, h.Values())
+
+	var nulo *Heap[int]
+	assert.ErrorIs(t, nulo.Update(1, 0), ErrHeapNil)
+}
+
+func TestReplaceRetornaLaCimaYReubicaElNuevo(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{1, 3, 2, 7, 4})
+
+	cima, err := h.Replace(5)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cima)
+	assert.Equal(t, []int{2, 3, 5, 7, 4}, h.Values())
+
+	// el nuevo elemento puede quedar en la cima y retornarse en el siguiente
+	cima, err = h.Replace(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cima)
+	assert.Equal(t, []int{0, 3, 4, 5, 7}, extraerTodos(h))
+}
+
+func TestReplaceMantieneLosKMayores(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{4, 9, 1})
+	for _, v := range []int{7, 2, 12, 5, 8} {
+		if cima, _ := h.Peek(); v > cima {
+			_, err := h.Replace(v)
+			assert.NoError(t, err)
+		}
+	}
+	assert.Equal(t, []int{8, 9, 12}, extraerTodos(h))
+}
+
+func TestReplaceVacioYNil(t *testing.T) {
+	h := NewMinHeap[int]()
+	_, err := h.Replace(1)
+	assert.ErrorIs(t, err, ErrHeapVacio)
+	assert.Equal(t, 0, h.Size())
+
+	var nulo *Heap[int]
+	_, err = nulo.Replace(1)
+	assert.ErrorIs(t, err, ErrHeapNil)
+}
+
+func TestEqualsComparaElLayout(t *testing.T) {
+	a := NuevoMonticuloMinDesdeArreglo([]int{3, 1, 2})
+	b := NuevoMonticuloMinDesdeArreglo([]int{2, 3, 1})
+	assert.True(t, a.Equals(b, nil))
+	assert.True(t, SonIguales(a, b))
+
+	c := NewMinHeap[int]()
+	for _, v := range []int{1, 3, 2} {
+		c.Insert(v)
+	}
+	c.Insert(4)
+	a.Insert(4)
+	assert.True(t, a.Equals(c, nil))
+	assert.True(t, SonIguales(a, c))
+	// mismos elementos con otro layout
+	d := NuevoMonticuloMinDesdeArreglo([]int{1, 2, 3, 4})
+	assert.False(t, SonIguales(a, d))
+	assert.False(t, SonIguales(a, b))
+}
+
+func TestEqualsConFuncionDeIgualdad(t *testing.T) {
+	porEdad := func(a, b Persona) int { return a.edad - b.edad }
+	a := NewGenericHeap(porEdad)
+	b := NewGenericHeap(porEdad)
+	a.Insert(Persona{nombre: "Ana", edad: 30})
+	b.Insert(Persona{nombre: "Beto", edad: 30})
+
+	assert.True(t, a.Equals(b, nil))
+	assert.False(t, a.Equals(b, func(x, y Persona) bool { return x == y }))
+}
+
+func TestEqualsConHeapsNil(t *testing.T) {
+	var nulo *Heap[int]
+	assert.True(t, SonIguales(nulo, nil))
+	assert.False(t, SonIguales(nulo, NewMinHeap[int]()))
+	assert.False(t, SonIguales(NewMinHeap[int](), nulo))
+	assert.True(t, SonIguales(NewMinHeap[int](), NewMaxHeap[int]()))
+}
+
+func TestRemoveWhereEliminaTodosLosQueCumplen(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{9, 4, 7, 2, 6, 1, 8, 3})
+
+	assert.Equal(t, 4, h.RemoveWhere(func(v int) bool { return v%2 == 0 }))
+	assert.Equal(t, 4, h.Size())
+	assert.Equal(t, []int{1, 3, 7, 9}, extraerTodos(h))
+}
+
+func TestRemoveWhereSinCoincidenciasNoModificaElHeap(t *testing.T) {
+	h := NuevoMonticuloMaxDesdeArreglo([]int{5, 1, 3})
+	antes := h.Values()
+
+	assert.Zero(t, h.RemoveWhere(func(v int) bool { return v > 10 }))
+	assert.Equal(t, antes, h.Values())
+	assert.Equal(t, 3, h.RemoveWhere(func(int) bool { return true }))
+	assert.True(t, h.IsEmpty())
+
+	var nulo *Heap[int]
+	assert.Zero(t, nulo.RemoveWhere(func(int) bool { return true }))
+}
+
+func TestConstructoresConCapacidad(t *testing.T) {
+	minimos := NewMinHeapWithCapacity[int](100)
+	assert.Equal(t, 0, minimos.Size())
+	assert.Equal(t, 100, cap(minimos.elements))
+	for i := 100; i > 0; i-- {
+		minimos.Insert(i)
+	}
+	assert.Equal(t, 100, cap(minimos.elements))
+	v, _ := minimos.Peek()
+	assert.Equal(t, 1, v)
+
+	maximos := NewMaxHeapWithCapacity[int](10)
+	maximos.Insert(1)
+	maximos.Insert(2)
+	v, _ = maximos.Peek()
+	assert.Equal(t, 2, v)
+	assert.Equal(t, "heap de máximos [2 1]", maximos.String())
+
+	generico := NewGenericHeapWithCapacity(func(a, b string) int { return len(a) - len(b) }, 5)
+	assert.Equal(t, 5, cap(generico.elements))
+	assert.Panics(t, func() { NewGenericHeapWithCapacity[int](nil, 5) })
+
+	assert.Equal(t, 0, cap(NewMinHeapWithCapacity[int](-1).elements))
+}
+
+func TestKindSeRegistraAlCrearElHeap(t *testing.T) {
+	assert.Equal(t, HeapDeMinimos, NewMinHeap[int]().Kind())
+	assert.Equal(t, HeapDeMaximos, NewMaxHeap[int]().Kind())
+	assert.Equal(t, HeapDeMaximos, NuevoMonticuloMaxDesdeArreglo([]int{1}).Kind())
+	assert.Equal(t, HeapDeMinimos, NewHeap(WithMinOrdering[int]()).Kind())
+	assert.Equal(t, HeapPersonalizado, NewGenericHeap(cmp.Compare[int]).Kind())
+	assert.Equal(t, HeapDeMaximos, NewMaxHeap[int]().Clone().Kind())
+
+	var nulo *Heap[int]
+	assert.Equal(t, HeapPersonalizado, nulo.Kind())
+}
+
+func TestCombinarMonticulosUsaElTipoDelPrimero(t *testing.T) {
+	// con un solo elemento no se puede deducir el tipo mirando el arreglo
+	maximos := NuevoMonticuloMaxDesdeArreglo([]int{5})
+	combinado := CombinarMonticulos(maximos, NuevoMonticuloMinDesdeArreglo([]int{1, 9}))
+
+	assert.Equal(t, HeapDeMaximos, combinado.Kind())
+	assert.Equal(t, []int{9, 5, 1}, extraerTodos(combinado))
+}
+
+func TestInvertirConvierteMinimosEnMaximos(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{4, 9, 1, 7, 3})
+
+	h.Invertir()
+	assert.Equal(t, HeapDeMaximos, h.Kind())
+	h.Insert(5)
+	assert.Equal(t, []int{9, 7, 5, 4, 3, 1}, h.ToSortedSlice())
+
+	h.Invertir()
+	assert.Equal(t, HeapDeMinimos, h.Kind())
+	assert.Equal(t, []int{1, 3, 4, 5, 7, 9}, extraerTodos(h))
+}
+
+func TestInvertirHeapPersonalizado(t *testing.T) {
+	h := NuevoMonticuloDesdeArregloConComparador([]string{"bb", "a", "ccc"}, func(a, b string) int {
+		return len(a) - len(b)
+	})
+
+	h.Invertir()
+	assert.Equal(t, HeapPersonalizado, h.Kind())
+	assert.Equal(t, []string{"ccc", "bb", "a"}, extraerTodos(h))
+
+	var nulo *Heap[int]
+	assert.NotPanics(t, nulo.Invertir)
+}
+
+func TestIsValidDetectaViolaciones(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{5, 3, 8, 1, 9, 2})
+	assert.True(t, h.IsValid())
+
+	h.elements[4] = 0
+	assert.False(t, h.IsValid())
+
+	maximos := NewMaxHeap[int]()
+	maximos.elements = []int{1, 2}
+	assert.False(t, maximos.IsValid())
+
+	assert.True(t, NewMinHeap[int]().IsValid())
+	var nulo *Heap[int]
+	assert.True(t, nulo.IsValid())
+}
+
+func TestCombinarMonticulosConUnSoloElemento(t *testing.T) {
+	minimos := NuevoMonticuloMinDesdeArreglo([]int{5})
+	maximos := NuevoMonticuloMaxDesdeArreglo([]int{7})
+
+	combinado := CombinarMonticulos(minimos, maximos)
+	assert.Equal(t, HeapDeMinimos, combinado.Kind())
+	assert.Equal(t, []int{5, 7}, extraerTodos(combinado))
+
+	combinado = CombinarMonticulos(maximos, minimos)
+	assert.Equal(t, HeapDeMaximos, combinado.Kind())
+	assert.Equal(t, []int{7, 5}, extraerTodos(combinado))
+}
+
+func TestCombinarMonticulosConservaElComparadorPersonalizado(t *testing.T) {
+	// pares primero y, entre ellos, de menor a mayor
+	paresPrimero := func(a, b int) int {
+		if a%2 != b%2 {
+			return a%2 - b%2
+		}
+		return a - b
+	}
+	heap1 := NuevoMonticuloDesdeArregloConComparador([]int{3, 8, 1}, paresPrimero)
+	heap2 := NuevoMonticuloMaxDesdeArreglo([]int{4, 5})
+
+	combinado := CombinarMonticulos(heap1, heap2)
+
+	assert.Equal(t, HeapPersonalizado, combinado.Kind())
+	assert.True(t, combinado.IsValid())
+	assert.Equal(t, []int{4, 8, 1, 3, 5}, extraerTodos(combinado))
+	assert.Equal(t, 3, heap1.Size())
+	assert.Equal(t, 2, heap2.Size())
+}
+
+func TestCombinarMonticulosUsaHeapify(t *testing.T) {
+	heap1 := NuevoMonticuloMinDesdeArreglo([]int{5, 9, 7})
+	heap2 := NuevoMonticuloMinDesdeArreglo([]int{1, 3})
+
+	// [5 9 7 1 3]: el 9 baja al lugar del 1 y después el 5 baja dos niveles
+	combinado := CombinarMonticulos(heap1, heap2)
+	assert.Equal(t, []int{1, 3, 7, 9, 5}, combinado.Values())
+	assert.Equal(t, []int{5, 9, 7}, heap1.Values())
+}
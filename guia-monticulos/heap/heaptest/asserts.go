@@ -0,0 +1,77 @@
+package heaptest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// AssertHeapValido verifica que cada padre del arreglo interno del heap salga
+// antes o junto con sus hijos según el comparador del heap.
+//
+// Uso:
+//
+//	heaptest.AssertHeapValido(t, h)
+//
+// Retorna:
+//   - true si el heap cumple la propiedad; si no, marca el test como fallido
+//     indicando el primer par padre/hijo que la viola.
+func AssertHeapValido[T any](t testing.TB, h *heap.Heap[T]) bool {
+	t.Helper()
+	elementos := h.Values()
+	for i := 1; i < len(elementos); i++ {
+		padre := (i - 1) / 2
+		if h.Compare(elementos[padre], elementos[i]) > 0 {
+			return assert.Fail(t, "el heap no cumple la propiedad de heap",
+				"el padre %v (posición %d) debería salir antes que el hijo %v (posición %d) en %v",
+				elementos[padre], padre, elementos[i], i, elementos)
+		}
+	}
+
+	return true
+}
+
+// AssertContieneExactamente verifica que el heap tenga exactamente los
+// elementos dados, con sus repeticiones, sin importar el orden interno.
+//
+// Uso:
+//
+//	heaptest.AssertContieneExactamente(t, h, []int{3, 1, 2})
+//
+// Retorna:
+//   - true si los elementos coinciden; si no, marca el test como fallido.
+func AssertContieneExactamente[T any](t testing.TB, h *heap.Heap[T], elems []T) bool {
+	t.Helper()
+
+	return assert.ElementsMatch(t, elems, h.Values())
+}
+
+// AssertExtraeEnOrden verifica que al vaciar el heap los elementos salgan en
+// el orden dado. Trabaja sobre una copia, por lo que el heap no se modifica.
+//
+// Uso:
+//
+//	heaptest.AssertExtraeEnOrden(t, h, []int{1, 2, 3})
+//
+// Retorna:
+//   - true si el orden de extracción coincide; si no, marca el test como
+//     fallido.
+func AssertExtraeEnOrden[T any](t testing.TB, h *heap.Heap[T], esperado []T) bool {
+	t.Helper()
+	copia := heap.NewGenericHeap(h.Compare)
+	for _, e := range h.Values() {
+		copia.Insert(e)
+	}
+	obtenido := make([]T, 0, copia.Size())
+	for copia.Size() > 0 {
+		e, err := copia.Remove()
+		if !assert.NoError(t, err) {
+			return false
+		}
+		obtenido = append(obtenido, e)
+	}
+
+	return assert.Equal(t, esperado, obtenido, "orden de extracción")
+}
@@ -0,0 +1,74 @@
+package heaptest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// registro captura las fallas que reportan las aserciones, para poder
+// verificar que detectan heaps incorrectos sin hacer fallar al test.
+type registro struct {
+	testing.TB
+	fallas []string
+}
+
+func (r *registro) Helper() {}
+
+func (r *registro) Errorf(format string, args ...any) {
+	r.fallas = append(r.fallas, fmt.Sprintf(format, args...))
+}
+
+func heapDesde(valores ...int) *heap.Heap[int] {
+	h := heap.NewMinHeap[int]()
+	for _, v := range valores {
+		h.Insert(v)
+	}
+
+	return h
+}
+
+func TestAssertsSobreHeapCorrecto(t *testing.T) {
+	h := heapDesde(5, 3, 8, 1, 3)
+
+	assert.True(t, AssertHeapValido(t, h))
+	assert.True(t, AssertContieneExactamente(t, h, []int{3, 1, 8, 5, 3}))
+	assert.True(t, AssertExtraeEnOrden(t, h, []int{1, 3, 3, 5, 8}))
+	assert.Equal(t, 5, h.Size(), "AssertExtraeEnOrden no debe vaciar el heap")
+}
+
+func TestAssertHeapValidoDetectaViolacion(t *testing.T) {
+	invertido := false
+	h := heap.NewGenericHeap(func(a, b int) int {
+		if invertido {
+			return b - a
+		}
+		return a - b
+	})
+	for _, v := range []int{1, 2, 3} {
+		h.Insert(v)
+	}
+	invertido = true
+
+	r := &registro{TB: t}
+	assert.False(t, AssertHeapValido(r, h))
+	assert.Len(t, r.fallas, 1)
+	assert.Contains(t, r.fallas[0], "el padre 1 (posición 0) debería salir antes que el hijo 2 (posición 1)")
+}
+
+func TestAssertContieneExactamenteDetectaDiferencias(t *testing.T) {
+	r := &registro{TB: t}
+
+	assert.False(t, AssertContieneExactamente(r, heapDesde(1, 2, 2), []int{1, 2}))
+	assert.Len(t, r.fallas, 1)
+}
+
+func TestAssertExtraeEnOrdenDetectaDiferencias(t *testing.T) {
+	r := &registro{TB: t}
+
+	assert.False(t, AssertExtraeEnOrden(r, heapDesde(2, 1), []int{2, 1}))
+	assert.Len(t, r.fallas, 1)
+}
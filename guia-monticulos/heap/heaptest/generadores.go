@@ -0,0 +1,114 @@
+package heaptest
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// Orientacion indica si un heap extrae primero el mínimo o el máximo.
+type Orientacion int
+
+const (
+	// Minimo corresponde a un heap de mínimos.
+	Minimo Orientacion = iota
+	// Maximo corresponde a un heap de máximos.
+	Maximo
+)
+
+// String retorna el nombre de la orientación.
+func (o Orientacion) String() string {
+	if o == Maximo {
+		return "máximo"
+	}
+
+	return "mínimo"
+}
+
+// HeapAleatorio es un heap de enteros válido generado al azar, junto con los
+// datos necesarios para escribir propiedades sobre él. Implementa
+// quick.Generator, por lo que puede usarse directamente como parámetro de las
+// funciones verificadas con testing/quick.
+//
+// Uso:
+//
+//	quick.Check(func(h heaptest.HeapAleatorio) bool {
+//		return h.Heap.Size() == len(h.Elementos)
+//	}, nil)
+type HeapAleatorio struct {
+	Heap        *heap.Heap[int]
+	Orientacion Orientacion
+	// Compare es la función de comparación con la que se creó el heap.
+	Compare func(a, b int) int
+	// Elementos son los valores insertados, en el orden de inserción.
+	Elementos []int
+}
+
+// Generate crea un heap con hasta `size` elementos y orientación al azar.
+func (HeapAleatorio) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(generar(r, size, Orientacion(r.Intn(2))))
+}
+
+// HeapMinAleatorio es un HeapAleatorio que siempre es un heap de mínimos.
+type HeapMinAleatorio struct {
+	HeapAleatorio
+}
+
+// Generate crea un heap de mínimos con hasta `size` elementos.
+func (HeapMinAleatorio) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(HeapMinAleatorio{generar(r, size, Minimo)})
+}
+
+// HeapMaxAleatorio es un HeapAleatorio que siempre es un heap de máximos.
+type HeapMaxAleatorio struct {
+	HeapAleatorio
+}
+
+// Generate crea un heap de máximos con hasta `size` elementos.
+func (HeapMaxAleatorio) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(HeapMaxAleatorio{generar(r, size, Maximo)})
+}
+
+// NuevoHeapAleatorio genera un heap al azar sin pasar por testing/quick, para
+// usarlo desde tests tabulares o benchmarks.
+//
+// Uso:
+//
+//	h := heaptest.NuevoHeapAleatorio(rand.New(rand.NewSource(1)), 100, heaptest.Maximo)
+//
+// Parámetros:
+//   - `r` generador de números aleatorios.
+//   - `size` cantidad máxima de elementos.
+//   - `orientacion` orientación del heap.
+//
+// Retorna:
+//   - el heap generado junto con su comparador y los elementos insertados.
+func NuevoHeapAleatorio(r *rand.Rand, size int, orientacion Orientacion) HeapAleatorio {
+	return generar(r, size, orientacion)
+}
+
+func generar(r *rand.Rand, size int, orientacion Orientacion) HeapAleatorio {
+	h := HeapAleatorio{Orientacion: orientacion}
+	if orientacion == Maximo {
+		h.Heap = heap.NewMaxHeap[int]()
+		h.Compare = func(a, b int) int { return cmp.Compare(b, a) }
+	} else {
+		h.Heap = heap.NewMinHeap[int]()
+		h.Compare = cmp.Compare[int]
+	}
+
+	n := 0
+	if size > 0 {
+		n = r.Intn(size + 1)
+	}
+	// el rango de valores es chico a propósito, para que haya repetidos
+	h.Elementos = make([]int, n)
+	for i := range h.Elementos {
+		h.Elementos[i] = r.Intn(2*size+1) - size
+		h.Heap.Insert(h.Elementos[i])
+	}
+
+	return h
+}
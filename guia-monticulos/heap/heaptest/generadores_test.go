@@ -0,0 +1,68 @@
+package heaptest
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeapAleatorioTamanio(t *testing.T) {
+	propiedad := func(h HeapAleatorio) bool {
+		return h.Heap.Size() == len(h.Elementos)
+	}
+
+	assert.NoError(t, quick.Check(propiedad, nil))
+}
+
+func TestHeapAleatorioRemoveEsMonotono(t *testing.T) {
+	propiedad := func(h HeapAleatorio) bool {
+		anterior, err := h.Heap.Remove()
+		for err == nil {
+			var actual int
+			actual, err = h.Heap.Remove()
+			if err == nil && h.Compare(anterior, actual) > 0 {
+				return false
+			}
+			anterior = actual
+		}
+
+		return h.Heap.Size() == 0
+	}
+
+	assert.NoError(t, quick.Check(propiedad, &quick.Config{MaxCount: 200}))
+}
+
+func TestHeapMinYMaxAleatorio(t *testing.T) {
+	esMinimo := func(h HeapMinAleatorio) bool {
+		if len(h.Elementos) == 0 {
+			return true
+		}
+		min := h.Elementos[0]
+		for _, e := range h.Elementos {
+			if e < min {
+				min = e
+			}
+		}
+		v, _ := h.Heap.Remove()
+
+		return h.Orientacion == Minimo && v == min
+	}
+	esMaximo := func(h HeapMaxAleatorio) bool {
+		if len(h.Elementos) == 0 {
+			return true
+		}
+		max := h.Elementos[0]
+		for _, e := range h.Elementos {
+			if e > max {
+				max = e
+			}
+		}
+		v, _ := h.Heap.Remove()
+
+		return h.Orientacion == Maximo && v == max
+	}
+
+	assert.NoError(t, quick.Check(esMinimo, nil))
+	assert.NoError(t, quick.Check(esMaximo, nil))
+}
@@ -0,0 +1,222 @@
+// Package heaptest provee herramientas para verificar implementaciones de
+// colas de prioridad comparándolas contra una implementación de referencia,
+// y aserciones para tests que no dependen de los campos internos del heap.
+package heaptest
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PriorityQueue es la interfaz mínima que debe cumplir una cola de prioridad
+// para poder verificarse con este paquete. La satisfacen heap.Heap y cualquier
+// variante que exponga las mismas operaciones.
+type PriorityQueue[T any] interface {
+	Insert(element T)
+	Remove() (T, error)
+	Size() int
+}
+
+// Actualizable la implementan las colas que permiten reemplazar un elemento
+// por otro, como heap.Heap con Update. EjecutarContraOraculo la requiere para
+// aplicar operaciones de tipo Actualizar.
+type Actualizable[T any] interface {
+	Update(old, new T) error
+}
+
+// Oraculo es una cola de prioridad de referencia implementada sobre un slice
+// ordenado. Es lenta pero obviamente correcta, y sirve para contrastar
+// implementaciones más eficientes.
+type Oraculo[T any] struct {
+	elements []T
+	compare  func(a T, b T) int
+}
+
+// NewOraculo crea un oráculo vacío que ordena con la función de comparación
+// dada, con la misma convención que heap.NewGenericHeap: el primero en salir
+// es el menor según `compare`.
+//
+// Uso:
+//
+//	o := heaptest.NewOraculo(func(a, b int) int { return a - b })
+//
+// Parámetros:
+//   - `compare` función de comparación.
+//
+// Retorna:
+//   - un puntero a un oráculo vacío.
+func NewOraculo[T any](compare func(a T, b T) int) *Oraculo[T] {
+	return &Oraculo[T]{compare: compare}
+}
+
+// Insert agrega un elemento manteniendo el slice ordenado. O(n)
+func (o *Oraculo[T]) Insert(element T) {
+	i := sort.Search(len(o.elements), func(i int) bool {
+		return o.compare(o.elements[i], element) > 0
+	})
+	o.elements = append(o.elements, element)
+	copy(o.elements[i+1:], o.elements[i:])
+	o.elements[i] = element
+}
+
+// Remove elimina y retorna el primer elemento según la función de comparación.
+func (o *Oraculo[T]) Remove() (T, error) {
+	var element T
+	if len(o.elements) == 0 {
+		return element, fmt.Errorf("oráculo vacío")
+	}
+	element = o.elements[0]
+	o.elements = o.elements[1:]
+
+	return element, nil
+}
+
+// Update reemplaza el primer elemento equivalente a `old` según la función de
+// comparación por `new`. O(n)
+func (o *Oraculo[T]) Update(old, new T) error {
+	for i, e := range o.elements {
+		if o.compare(e, old) == 0 {
+			o.elements = append(o.elements[:i], o.elements[i+1:]...)
+			o.Insert(new)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("oráculo: no hay un elemento equivalente a %v", old)
+}
+
+// Size retorna la cantidad de elementos del oráculo.
+func (o *Oraculo[T]) Size() int {
+	return len(o.elements)
+}
+
+// TipoOperacion identifica una operación de una cola de prioridad.
+type TipoOperacion int
+
+const (
+	// Insertar agrega el valor de la operación.
+	Insertar TipoOperacion = iota
+	// Remover extrae el elemento de mayor prioridad.
+	Remover
+	// Actualizar reemplaza un elemento equivalente a Anterior por Valor.
+	Actualizar
+)
+
+// String retorna el nombre de la operación.
+func (t TipoOperacion) String() string {
+	switch t {
+	case Insertar:
+		return "Insert"
+	case Remover:
+		return "Remove"
+	case Actualizar:
+		return "Update"
+	}
+
+	return fmt.Sprintf("TipoOperacion(%d)", int(t))
+}
+
+// Operacion es un paso de una secuencia de operaciones.
+type Operacion[T any] struct {
+	Tipo  TipoOperacion
+	Valor T // se usa en Insertar y Actualizar
+	// Anterior es el elemento a reemplazar; sólo se usa en Actualizar.
+	Anterior T
+}
+
+// EjecutarContraOraculo aplica la secuencia de operaciones a la cola y a un
+// oráculo con la misma función de comparación, y verifica después de cada paso
+// que ambos coincidan.
+//
+// Dos elementos extraídos se consideran equivalentes si `compare` los considera
+// iguales, de modo que no importa cómo desempata cada implementación. Las
+// operaciones Actualizar requieren que la cola implemente Actualizable, y
+// verifican que la cola y el oráculo fallen en los mismos casos.
+//
+// Uso:
+//
+//	err := heaptest.EjecutarContraOraculo[int](heap.NewMinHeap[int](), cmp.Compare[int], ops)
+//
+// Parámetros:
+//   - `pq` cola de prioridad a verificar. Debe estar vacía.
+//   - `compare` función de comparación con la que fue creada la cola.
+//   - `ops` secuencia de operaciones a aplicar.
+//
+// Retorna:
+//   - nil si la cola se comportó igual que el oráculo; en otro caso, un error
+//     que describe el primer paso en el que difieren.
+func EjecutarContraOraculo[T any](pq PriorityQueue[T], compare func(a T, b T) int, ops []Operacion[T]) error {
+	oraculo := NewOraculo(compare)
+	if pq.Size() != 0 {
+		return fmt.Errorf("la cola debe estar vacía, tiene %d elementos", pq.Size())
+	}
+
+	for i, op := range ops {
+		switch op.Tipo {
+		case Insertar:
+			pq.Insert(op.Valor)
+			oraculo.Insert(op.Valor)
+		case Remover:
+			obtenido, errObtenido := pq.Remove()
+			esperado, errEsperado := oraculo.Remove()
+			if (errObtenido == nil) != (errEsperado == nil) {
+				return fmt.Errorf("paso %d (%v): error %v, se esperaba %v", i, op.Tipo, errObtenido, errEsperado)
+			}
+			if errEsperado == nil && compare(obtenido, esperado) != 0 {
+				return fmt.Errorf("paso %d (%v): se obtuvo %v, se esperaba %v", i, op.Tipo, obtenido, esperado)
+			}
+		case Actualizar:
+			a, ok := pq.(Actualizable[T])
+			if !ok {
+				return fmt.Errorf("paso %d (%v): la cola no implementa Update", i, op.Tipo)
+			}
+			errObtenido := a.Update(op.Anterior, op.Valor)
+			errEsperado := oraculo.Update(op.Anterior, op.Valor)
+			if (errObtenido == nil) != (errEsperado == nil) {
+				return fmt.Errorf("paso %d (%v %v por %v): error %v, se esperaba %v", i, op.Tipo, op.Anterior, op.Valor, errObtenido, errEsperado)
+			}
+		default:
+			return fmt.Errorf("paso %d: operación desconocida %v", i, op.Tipo)
+		}
+
+		if pq.Size() != oraculo.Size() {
+			return fmt.Errorf("paso %d (%v): tamaño %d, se esperaba %d", i, op.Tipo, pq.Size(), oraculo.Size())
+		}
+	}
+
+	return nil
+}
+
+// DecodificarOperaciones convierte una secuencia arbitraria de bytes, como las
+// que genera el fuzzer de Go, en operaciones sobre enteros. Cada byte par
+// inserta su mitad; de los impares, los de resto 1 módulo 4 remueven y los de
+// resto 3 reemplazan b/4 por 127-2*(b/4), lo que produce secuencias con
+// valores repetidos, extracciones sobre la cola vacía y actualizaciones de
+// elementos que pueden no estar.
+//
+// Uso:
+//
+//	ops := heaptest.DecodificarOperaciones([]byte{4, 8, 1, 2})
+//
+// Parámetros:
+//   - `data` bytes a decodificar.
+//
+// Retorna:
+//   - la secuencia de operaciones.
+func DecodificarOperaciones(data []byte) []Operacion[int] {
+	ops := make([]Operacion[int], 0, len(data))
+	for _, b := range data {
+		switch {
+		case b%2 == 0:
+			ops = append(ops, Operacion[int]{Tipo: Insertar, Valor: int(b / 2)})
+		case b%4 == 1:
+			ops = append(ops, Operacion[int]{Tipo: Remover})
+		default:
+			anterior := int(b / 4)
+			ops = append(ops, Operacion[int]{Tipo: Actualizar, Anterior: anterior, Valor: 127 - 2*anterior})
+		}
+	}
+
+	return ops
+}
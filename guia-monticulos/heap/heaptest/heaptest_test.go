@@ -0,0 +1,129 @@
+package heaptest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func compararEnteros(a, b int) int {
+	return a - b
+}
+
+// colaLIFO no respeta prioridades: sirve para verificar que el arnés detecte
+// implementaciones incorrectas.
+type colaLIFO struct {
+	elements []int
+}
+
+func (c *colaLIFO) Insert(element int) {
+	c.elements = append(c.elements, element)
+}
+
+func (c *colaLIFO) Remove() (int, error) {
+	if len(c.elements) == 0 {
+		return 0, assert.AnError
+	}
+	e := c.elements[len(c.elements)-1]
+	c.elements = c.elements[:len(c.elements)-1]
+
+	return e, nil
+}
+
+func (c *colaLIFO) Size() int {
+	return len(c.elements)
+}
+
+func TestOraculo(t *testing.T) {
+	o := NewOraculo(compararEnteros)
+	for _, v := range []int{5, 1, 4, 1, 3} {
+		o.Insert(v)
+	}
+
+	for _, esperado := range []int{1, 1, 3, 4, 5} {
+		v, err := o.Remove()
+		assert.NoError(t, err)
+		assert.Equal(t, esperado, v)
+	}
+	_, err := o.Remove()
+	assert.Error(t, err)
+}
+
+func TestOraculoUpdate(t *testing.T) {
+	o := NewOraculo(compararEnteros)
+	for _, v := range []int{5, 1, 4} {
+		o.Insert(v)
+	}
+
+	assert.NoError(t, o.Update(4, 0))
+	assert.Error(t, o.Update(4, 2))
+	for _, esperado := range []int{0, 1, 5} {
+		v, err := o.Remove()
+		assert.NoError(t, err)
+		assert.Equal(t, esperado, v)
+	}
+}
+
+func TestEjecutarContraOraculoConOraculo(t *testing.T) {
+	ops := DecodificarOperaciones([]byte{10, 4, 1, 8, 11, 1, 15, 1, 1, 6})
+
+	assert.NoError(t, EjecutarContraOraculo[int](NewOraculo(compararEnteros), compararEnteros, ops))
+}
+
+func TestEjecutarContraOraculoDetectaErrores(t *testing.T) {
+	ops := []Operacion[int]{
+		{Tipo: Insertar, Valor: 1},
+		{Tipo: Insertar, Valor: 2},
+		{Tipo: Remover},
+	}
+
+	err := EjecutarContraOraculo[int](&colaLIFO{}, compararEnteros, ops)
+	assert.EqualError(t, err, "paso 2 (Remove): se obtuvo 2, se esperaba 1")
+}
+
+func TestEjecutarContraOraculoConUpdate(t *testing.T) {
+	ops := []Operacion[int]{
+		{Tipo: Insertar, Valor: 5},
+		{Tipo: Insertar, Valor: 3},
+		{Tipo: Actualizar, Anterior: 5, Valor: 1},
+		{Tipo: Actualizar, Anterior: 7, Valor: 0},
+		{Tipo: Remover},
+		{Tipo: Remover},
+	}
+
+	assert.NoError(t, EjecutarContraOraculo[int](NewOraculo(compararEnteros), compararEnteros, ops))
+	err := EjecutarContraOraculo[int](&colaLIFO{}, compararEnteros, ops)
+	assert.EqualError(t, err, "paso 2 (Update): la cola no implementa Update")
+}
+
+// colaQueIgnoraUpdate es un oráculo cuyo Update nunca falla, aunque el
+// elemento no esté.
+type colaQueIgnoraUpdate struct {
+	*Oraculo[int]
+}
+
+func (c colaQueIgnoraUpdate) Update(old, new int) error {
+	_ = c.Oraculo.Update(old, new)
+	return nil
+}
+
+func TestEjecutarContraOraculoDetectaErroresDeUpdate(t *testing.T) {
+	ops := []Operacion[int]{
+		{Tipo: Insertar, Valor: 5},
+		{Tipo: Actualizar, Anterior: 7, Valor: 0},
+	}
+
+	err := EjecutarContraOraculo[int](colaQueIgnoraUpdate{NewOraculo(compararEnteros)}, compararEnteros, ops)
+	assert.EqualError(t, err, "paso 1 (Update 7 por 0): error <nil>, se esperaba oráculo: no hay un elemento equivalente a 7")
+}
+
+func TestDecodificarOperaciones(t *testing.T) {
+	ops := DecodificarOperaciones([]byte{8, 1, 0, 11})
+
+	assert.Equal(t, []Operacion[int]{
+		{Tipo: Insertar, Valor: 4},
+		{Tipo: Remover},
+		{Tipo: Insertar, Valor: 0},
+		{Tipo: Actualizar, Anterior: 2, Valor: 123},
+	}, ops)
+}
@@ -0,0 +1,187 @@
+package heaptest
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+// OperacionesAleatorias genera una secuencia pseudoaleatoria reproducible de
+// operaciones sobre enteros: la misma semilla produce siempre la misma
+// secuencia. Alterna rachas de inserciones y de extracciones para que la cola
+// crezca y se vacíe varias veces, y usa un rango de valores acotado para
+// provocar repetidos.
+//
+// Uso:
+//
+//	ops := heaptest.OperacionesAleatorias(1000, 42)
+//
+// Parámetros:
+//   - `n` cantidad de operaciones.
+//   - `seed` semilla del generador.
+//
+// Retorna:
+//   - la secuencia de operaciones.
+func OperacionesAleatorias(n int, seed int64) []Operacion[int] {
+	r := rand.New(rand.NewSource(seed))
+	ops := make([]Operacion[int], 0, n)
+	for len(ops) < n {
+		// cada racha favorece inserciones o extracciones
+		probInsertar := 0.3
+		if r.Intn(2) == 0 {
+			probInsertar = 0.7
+		}
+		for racha := 1 + r.Intn(50); racha > 0 && len(ops) < n; racha-- {
+			if r.Float64() < probInsertar {
+				ops = append(ops, Operacion[int]{Tipo: Insertar, Valor: r.Intn(1000) - 500})
+			} else {
+				ops = append(ops, Operacion[int]{Tipo: Remover})
+			}
+		}
+	}
+
+	return ops
+}
+
+// Validable la implementan las colas que pueden verificar su propia
+// invariante, como heap.Heap con IsValid. StressTest la consulta después de
+// cada paso si la cola la implementa.
+type Validable interface {
+	IsValid() bool
+}
+
+// StressTest ejecuta sobre la cola una mezcla reproducible de `ops`
+// operaciones y verifica después de cada paso que los tamaños coincidan con
+// los de un modelo, que extraer de la cola vacía devuelva un error, que se
+// extraiga siempre el mínimo o siempre el máximo de los elementos presentes y,
+// si la cola implementa Validable, que conserve su invariante.
+//
+// No recibe la función de comparación: el sentido del orden se deduce de la
+// primera extracción que lo distingue, así que sirve tanto para heaps de
+// mínimos como de máximos. Para colas con otro orden hay que usar
+// StressTestConComparador.
+//
+// Uso:
+//
+//	err := heaptest.StressTest(heap.NewMaxHeap[int](), 10000, 1)
+//
+// Parámetros:
+//   - `h` cola a verificar. Debe estar vacía.
+//   - `ops` cantidad de operaciones.
+//   - `seed` semilla, para poder reproducir una falla.
+//
+// Retorna:
+//   - nil si todas las verificaciones pasaron; en otro caso, un error que
+//     incluye la semilla y el primer paso que falló.
+func StressTest(h PriorityQueue[int], ops int, seed int64) error {
+	if ops < 0 {
+		return errors.New("la cantidad de operaciones no puede ser negativa")
+	}
+	if h.Size() != 0 {
+		return fmt.Errorf("semilla %d: la cola debe estar vacía, tiene %d elementos", seed, h.Size())
+	}
+
+	modelo := NewOraculo(cmp.Compare[int])
+	// 1 si la cola extrae mínimos, -1 si extrae máximos, 0 si todavía no se sabe
+	sentido := 0
+	for i, op := range OperacionesAleatorias(ops, seed) {
+		if err := pasoStress(h, modelo, &sentido, op); err != nil {
+			return fmt.Errorf("semilla %d, paso %d (%v): %w", seed, i, op.Tipo, err)
+		}
+	}
+
+	return nil
+}
+
+// StressTestConComparador es como StressTest pero contrasta cada extracción
+// con un oráculo que ordena con `compare`, de modo que sirve para colas con
+// cualquier orden.
+//
+// Uso:
+//
+//	err := heaptest.StressTestConComparador(heap.NewGenericHeap(porEdad), porEdad, 10000, 1)
+//
+// Parámetros:
+//   - `h` cola a verificar. Debe estar vacía.
+//   - `compare` función de comparación con la que fue creada la cola.
+//   - `ops` cantidad de operaciones.
+//   - `seed` semilla, para poder reproducir una falla.
+//
+// Retorna:
+//   - nil si todas las verificaciones pasaron; en otro caso, un error que
+//     incluye la semilla y el primer paso que falló.
+func StressTestConComparador(h PriorityQueue[int], compare func(a, b int) int, ops int, seed int64) error {
+	if ops < 0 {
+		return errors.New("la cantidad de operaciones no puede ser negativa")
+	}
+	if err := EjecutarContraOraculo(h, compare, OperacionesAleatorias(ops, seed)); err != nil {
+		return fmt.Errorf("semilla %d, %w", seed, err)
+	}
+
+	return nil
+}
+
+// pasoStress aplica una operación a la cola y al modelo y verifica el
+// resultado.
+func pasoStress(h PriorityQueue[int], modelo *Oraculo[int], sentido *int, op Operacion[int]) error {
+	switch op.Tipo {
+	case Insertar:
+		h.Insert(op.Valor)
+		modelo.Insert(op.Valor)
+	case Remover:
+		obtenido, err := h.Remove()
+		if modelo.Size() == 0 {
+			if err == nil {
+				return fmt.Errorf("se obtuvo %d de una cola vacía", obtenido)
+			}
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error %v con %d elementos", err, modelo.Size())
+		}
+		if err := extraerDelModelo(modelo, sentido, obtenido); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("operación desconocida %v", op.Tipo)
+	}
+
+	if h.Size() != modelo.Size() {
+		return fmt.Errorf("tamaño %d, se esperaba %d", h.Size(), modelo.Size())
+	}
+	if v, ok := h.(Validable); ok && !v.IsValid() {
+		return errors.New("la cola no cumple su invariante")
+	}
+
+	return nil
+}
+
+// extraerDelModelo quita del modelo el extremo que corresponde al sentido de
+// la cola, fijando el sentido si todavía no se conocía.
+func extraerDelModelo(modelo *Oraculo[int], sentido *int, obtenido int) error {
+	minimo := modelo.elements[0]
+	maximo := modelo.elements[len(modelo.elements)-1]
+	switch {
+	case *sentido == 0 && obtenido == minimo && minimo != maximo:
+		*sentido = 1
+	case *sentido == 0 && obtenido == maximo && minimo != maximo:
+		*sentido = -1
+	case *sentido == 0 && obtenido == minimo:
+		// todos los elementos son iguales, el sentido sigue sin conocerse
+	case *sentido == 0:
+		return fmt.Errorf("se obtuvo %d, se esperaba %d o %d", obtenido, minimo, maximo)
+	case *sentido > 0 && obtenido != minimo:
+		return fmt.Errorf("se obtuvo %d, se esperaba %d", obtenido, minimo)
+	case *sentido < 0 && obtenido != maximo:
+		return fmt.Errorf("se obtuvo %d, se esperaba %d", obtenido, maximo)
+	}
+
+	if *sentido < 0 {
+		modelo.elements = modelo.elements[:len(modelo.elements)-1]
+	} else {
+		modelo.elements = modelo.elements[1:]
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package heaptest
+
+import (
+	"testing"
+
+	"untref/ayp2/monticulo/heap"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperacionesAleatoriasReproducibles(t *testing.T) {
+	assert.Equal(t, OperacionesAleatorias(500, 7), OperacionesAleatorias(500, 7))
+	assert.NotEqual(t, OperacionesAleatorias(500, 7), OperacionesAleatorias(500, 8))
+	assert.Len(t, OperacionesAleatorias(123, 1), 123)
+}
+
+func TestStressTestVariantesDelHeap(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		assert.NoError(t, StressTest(heap.NewMinHeap[int](), 5000, seed))
+		assert.NoError(t, StressTest(heap.NewMaxHeap[int](), 5000, seed))
+		assert.NoError(t, StressTest(heap.NewGenericHeap(compararEnteros), 5000, seed))
+	}
+}
+
+func TestStressTestDetectaErrores(t *testing.T) {
+	err := StressTest(&colaLIFO{}, 1000, 3)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "semilla 3, paso ")
+}
+
+// heapQueSeRompe es un heap correcto que, a partir de cierta cantidad de
+// verificaciones, informa que dejó de cumplir su invariante.
+type heapQueSeRompe struct {
+	*heap.Heap[int]
+	verificaciones int
+}
+
+func (h *heapQueSeRompe) IsValid() bool {
+	h.verificaciones++
+
+	return h.verificaciones <= 10
+}
+
+func TestStressTestVerificaLaInvariante(t *testing.T) {
+	err := StressTest(&heapQueSeRompe{Heap: heap.NewMinHeap[int]()}, 100, 2)
+
+	assert.EqualError(t, err, "semilla 2, paso 10 (Insert): la cola no cumple su invariante")
+}
+
+func TestStressTestConComparador(t *testing.T) {
+	// ordena por paridad y luego por valor, que no es ni de mínimos ni de máximos
+	compare := func(a, b int) int {
+		if a&1 != b&1 {
+			return a&1 - b&1
+		}
+
+		return a - b
+	}
+	for seed := int64(1); seed <= 3; seed++ {
+		assert.NoError(t, StressTestConComparador(heap.NewGenericHeap(compare), compare, 2000, seed))
+	}
+	assert.Error(t, StressTest(heap.NewGenericHeap(compare), 2000, 1))
+
+	err := StressTestConComparador(&colaLIFO{}, compararEnteros, 1000, 3)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "semilla 3, paso ")
+}
+
+func TestStressTestOperacionesNegativas(t *testing.T) {
+	assert.Error(t, StressTest(heap.NewMinHeap[int](), -1, 1))
+	assert.Error(t, StressTestConComparador(heap.NewMinHeap[int](), compararEnteros, -1, 1))
+}
@@ -0,0 +1,201 @@
+package heap
+
+import "fmt"
+
+var (
+	// ErrNadaParaDeshacer indica que se llamó a Undo sin operaciones registradas.
+	ErrNadaParaDeshacer error = &errorLocalizado{es: "no hay operaciones para deshacer", en: "nothing to undo"}
+	// ErrNadaParaRehacer indica que se llamó a Redo sin operaciones deshechas.
+	ErrNadaParaRehacer error = &errorLocalizado{es: "no hay operaciones para rehacer", en: "nothing to redo"}
+)
+
+// registro guarda lo necesario para deshacer una operación: las posiciones
+// del arreglo que la operación sobrescribió con su valor anterior y el largo
+// que tenía el arreglo. Insert y Remove solo modifican un camino de la raíz a
+// una hoja, así que cada registro ocupa O(log n).
+type registro[T any] struct {
+	op         Operacion[T]
+	largo      int
+	posiciones []int
+	anteriores []T
+}
+
+// HeapConHistorial envuelve un heap y registra cada Insert y Remove para
+// poder deshacerlos y rehacerlos. Undo deja el arreglo interno exactamente
+// como estaba, no solo con los mismos elementos.
+type HeapConHistorial[T any] struct {
+	heap     *Heap[T]
+	deshacer []registro[T]
+	rehacer  []Operacion[T]
+}
+
+// ConHistorial crea un heap con historial a partir de uno existente. Las
+// operaciones hechas sobre `h` sin pasar por el historial no se registran y
+// dejan inválido lo registrado hasta ese momento.
+//
+// Uso:
+//
+//	h := heap.ConHistorial(heap.NewMinHeap[int]())
+//	h.Insert(5)
+//	_ = h.Undo()
+//
+// Parámetros:
+//   - `h` heap a envolver.
+//
+// Retorna:
+//   - un puntero al heap con historial vacío.
+func ConHistorial[T any](h *Heap[T]) *HeapConHistorial[T] {
+	if h == nil {
+		panic(fmt.Errorf(Localizar("con historial: %w", "with history: %w"), ErrHeapNil))
+	}
+
+	return &HeapConHistorial[T]{heap: h}
+}
+
+// Heap retorna el heap envuelto.
+func (h *HeapConHistorial[T]) Heap() *Heap[T] {
+	return h.heap
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (h *HeapConHistorial[T]) Size() int {
+	return h.heap.Size()
+}
+
+// Insert agrega un elemento y registra la operación. Descarta las
+// operaciones que se podían rehacer.
+func (h *HeapConHistorial[T]) Insert(element T) {
+	h.rehacer = nil
+	h.insertar(element)
+}
+
+func (h *HeapConHistorial[T]) insertar(element T) {
+	m := h.heap
+	r := registro[T]{op: Operacion[T]{Tipo: OpInsert, Valor: element}, largo: len(m.elements)}
+	// upHeap solo puede modificar a los ancestros de la nueva hoja
+	for i := len(m.elements); i > 0; {
+		i = (i - 1) / 2
+		r.posiciones = append(r.posiciones, i)
+		r.anteriores = append(r.anteriores, m.elements[i])
+	}
+	m.Insert(element)
+	h.deshacer = append(h.deshacer, r)
+}
+
+// Remove elimina y retorna el elemento en la cima y registra la operación.
+// Descarta las operaciones que se podían rehacer. Un Remove sobre el heap
+// vacío no se registra.
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (h *HeapConHistorial[T]) Remove() (T, error) {
+	if h.heap.Size() == 0 {
+		return h.heap.Remove()
+	}
+	h.rehacer = nil
+
+	return h.remover()
+}
+
+func (h *HeapConHistorial[T]) remover() (T, error) {
+	m := h.heap
+	n := len(m.elements)
+	r := registro[T]{largo: n}
+	for _, i := range m.caminoDeBajada() {
+		r.posiciones = append(r.posiciones, i)
+		r.anteriores = append(r.anteriores, m.elements[i])
+	}
+	r.posiciones = append(r.posiciones, n-1)
+	r.anteriores = append(r.anteriores, m.elements[n-1])
+
+	element, err := m.Remove()
+	if err != nil {
+		return element, err
+	}
+	r.op = Operacion[T]{Tipo: OpRemove, Valor: element}
+	h.deshacer = append(h.deshacer, r)
+
+	return element, nil
+}
+
+// caminoDeBajada retorna las posiciones que modificaría un Remove: simula el
+// downHeap del último elemento desde la raíz sin tocar el arreglo.
+func (m *Heap[T]) caminoDeBajada() []int {
+	n := len(m.elements) - 1
+	if n <= 0 {
+		return []int{0}
+	}
+	bajando := m.elements[n]
+	camino := []int{0}
+	for i := 0; ; {
+		left, right := 2*i+1, 2*i+2
+		smallest, valor := i, bajando
+		if left < n && m.compare(m.elements[left], valor) < 0 {
+			smallest, valor = left, m.elements[left]
+		}
+		if right < n && m.compare(m.elements[right], valor) < 0 {
+			smallest = right
+		}
+		if smallest == i {
+			return camino
+		}
+		camino = append(camino, smallest)
+		i = smallest
+	}
+}
+
+// Undo deshace la última operación registrada.
+//
+// Retorna:
+//   - un error que envuelve a ErrNadaParaDeshacer si no hay operaciones.
+func (h *HeapConHistorial[T]) Undo() error {
+	if len(h.deshacer) == 0 {
+		return fmt.Errorf(Localizar("deshacer: %w", "undo: %w"), ErrNadaParaDeshacer)
+	}
+	r := h.deshacer[len(h.deshacer)-1]
+	h.deshacer = h.deshacer[:len(h.deshacer)-1]
+
+	m := h.heap
+	if r.largo > len(m.elements) {
+		m.elements = append(m.elements, make([]T, r.largo-len(m.elements))...)
+	}
+	m.elements = m.elements[:r.largo]
+	for k, i := range r.posiciones {
+		m.elements[i] = r.anteriores[k]
+	}
+	h.rehacer = append(h.rehacer, r.op)
+
+	return nil
+}
+
+// Redo vuelve a aplicar la última operación deshecha.
+//
+// Retorna:
+//   - un error que envuelve a ErrNadaParaRehacer si no hay operaciones
+//     deshechas, o si después de deshacer se hizo una operación nueva.
+func (h *HeapConHistorial[T]) Redo() error {
+	if len(h.rehacer) == 0 {
+		return fmt.Errorf(Localizar("rehacer: %w", "redo: %w"), ErrNadaParaRehacer)
+	}
+	op := h.rehacer[len(h.rehacer)-1]
+	h.rehacer = h.rehacer[:len(h.rehacer)-1]
+	if op.Tipo == OpInsert {
+		h.insertar(op.Valor)
+		return nil
+	}
+	_, err := h.remover()
+
+	return err
+}
+
+// Operaciones retorna las operaciones registradas que se pueden deshacer, de
+// la más vieja a la más reciente, para depurar una secuencia.
+func (h *HeapConHistorial[T]) Operaciones() []Operacion[T] {
+	ops := make([]Operacion[T], len(h.deshacer))
+	for i, r := range h.deshacer {
+		ops[i] = r.op
+	}
+
+	return ops
+}
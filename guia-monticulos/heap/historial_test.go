@@ -0,0 +1,78 @@
+package heap
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistorialUndoRedo(t *testing.T) {
+	h := ConHistorial(NewMinHeap[int]())
+	h.Insert(5)
+	h.Insert(3)
+	h.Insert(8)
+	v, err := h.Remove()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, []Operacion[int]{
+		{OpInsert, 5}, {OpInsert, 3}, {OpInsert, 8}, {OpRemove, 3},
+	}, h.Operaciones())
+
+	assert.NoError(t, h.Undo())
+	assert.Equal(t, []int{3, 5, 8}, h.Heap().Values())
+	assert.NoError(t, h.Undo())
+	assert.Equal(t, []int{3, 5}, h.Heap().Values())
+
+	assert.NoError(t, h.Redo())
+	assert.Equal(t, []int{3, 5, 8}, h.Heap().Values())
+	assert.NoError(t, h.Redo())
+	assert.Equal(t, []int{5, 8}, h.Heap().Values())
+	assert.ErrorIs(t, h.Redo(), ErrNadaParaRehacer)
+}
+
+func TestHistorialOperacionNuevaDescartaRedo(t *testing.T) {
+	h := ConHistorial(NewMaxHeap[int]())
+	h.Insert(1)
+	assert.NoError(t, h.Undo())
+
+	h.Insert(2)
+
+	assert.ErrorIs(t, h.Redo(), ErrNadaParaRehacer)
+	assert.NoError(t, h.Undo())
+	assert.ErrorIs(t, h.Undo(), ErrNadaParaDeshacer)
+	assert.Equal(t, 0, h.Size())
+}
+
+func TestHistorialRemoveVacioNoSeRegistra(t *testing.T) {
+	h := ConHistorial(NewMinHeap[int]())
+
+	_, err := h.Remove()
+
+	assert.ErrorIs(t, err, ErrHeapVacio)
+	assert.Empty(t, h.Operaciones())
+}
+
+func TestHistorialUndoRestauraElArregloExacto(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	h := ConHistorial(NewMinHeap[int]())
+	var estados [][]int
+
+	for i := 0; i < 500; i++ {
+		estados = append(estados, h.Heap().Values())
+		if h.Size() > 0 && r.Intn(3) == 0 {
+			_, _ = h.Remove()
+		} else {
+			h.Insert(r.Intn(100))
+		}
+	}
+	final := h.Heap().Values()
+
+	for i := len(estados) - 1; i >= 0; i-- {
+		assert.NoError(t, h.Undo())
+		assert.Equal(t, estados[i], h.Heap().Values())
+	}
+	for h.Redo() == nil {
+	}
+	assert.Equal(t, final, h.Heap().Values())
+}
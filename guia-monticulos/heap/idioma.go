@@ -0,0 +1,94 @@
+package heap
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Idioma identifica el idioma de los mensajes de error del paquete.
+type Idioma string
+
+const (
+	// Espanol es el idioma por defecto.
+	Espanol Idioma = "es"
+	// Ingles es el idioma para usar el paquete en proyectos en inglés.
+	Ingles Idioma = "en"
+)
+
+// VariableIdioma es la variable de entorno que se consulta al iniciar el
+// programa para elegir el idioma. Acepta valores como "en" o "en_US.UTF-8".
+const VariableIdioma = "HEAP_IDIOMA"
+
+var idiomaActual atomic.Value
+
+func init() {
+	idiomaActual.Store(Espanol)
+	if v, ok := os.LookupEnv(VariableIdioma); ok {
+		_ = SetIdioma(Idioma(v))
+	}
+}
+
+// SetIdioma cambia el idioma de los mensajes de error que se creen a partir
+// de ese momento. Los errores ya creados conservan su mensaje; sólo los
+// centinelas, como ErrHeapVacio, lo resuelven al llamar a Error.
+//
+// Uso:
+//
+//	err := heap.SetIdioma(heap.Ingles)
+//
+// Parámetros:
+//   - `idioma` idioma a usar. Se acepta un sufijo de región, como "en_US".
+//
+// Retorna:
+//   - un error si el idioma no está soportado; en ese caso no cambia nada.
+func SetIdioma(idioma Idioma) error {
+	codigo := strings.ToLower(string(idioma))
+	if i := strings.IndexAny(codigo, "_-."); i >= 0 {
+		codigo = codigo[:i]
+	}
+	switch Idioma(codigo) {
+	case Espanol, Ingles:
+		idiomaActual.Store(Idioma(codigo))
+		return nil
+	}
+
+	return fmt.Errorf(Localizar("idioma no soportado: %q", "unsupported language: %q"), idioma)
+}
+
+// IdiomaActual retorna el idioma en uso.
+func IdiomaActual() Idioma {
+	return idiomaActual.Load().(Idioma)
+}
+
+// Localizar retorna el texto correspondiente al idioma en uso. Lo usan los
+// paquetes que dependen de heap para que sus mensajes sigan el mismo idioma.
+//
+// Uso:
+//
+//	msg := heap.Localizar("heap vacío", "empty heap")
+//
+// Parámetros:
+//   - `es` texto en español.
+//   - `en` texto en inglés.
+//
+// Retorna:
+//   - `en` si el idioma en uso es Ingles, `es` en otro caso.
+func Localizar(es, en string) string {
+	if IdiomaActual() == Ingles {
+		return en
+	}
+
+	return es
+}
+
+// errorLocalizado es un error cuyo mensaje depende del idioma en uso. Se usa
+// como puntero para que errors.Is compare por identidad.
+type errorLocalizado struct {
+	es, en string
+}
+
+func (e *errorLocalizado) Error() string {
+	return Localizar(e.es, e.en)
+}
@@ -0,0 +1,80 @@
+package heap
+
+import (
+	"cmp"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func usarIdioma(t *testing.T, idioma Idioma) {
+	t.Helper()
+	anterior := IdiomaActual()
+	assert.NoError(t, SetIdioma(idioma))
+	t.Cleanup(func() { _ = SetIdioma(anterior) })
+}
+
+func TestIdiomaPorDefecto(t *testing.T) {
+	usarIdioma(t, Espanol)
+	_, err := NewMinHeap[int]().Remove()
+	assert.EqualError(t, err, "extraer: heap vacío")
+}
+
+func TestIdiomaIngles(t *testing.T) {
+	usarIdioma(t, Ingles)
+	_, err := NewMinHeap[int]().Remove()
+	assert.EqualError(t, err, "remove: empty heap")
+	assert.True(t, errors.Is(err, ErrHeapVacio))
+
+	_, err = EnesimoMaximo(NuevoMonticuloMaxDesdeArreglo([]int{1}), 3)
+	assert.EqualError(t, err, "n out of range: asked for n = 3 in a heap of 1 elements")
+	assert.ErrorIs(t, err, ErrFueraDeRango)
+}
+
+func TestPrefijosDeOperacionLocalizados(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{1, 2})
+
+	usarIdioma(t, Espanol)
+	assert.EqualError(t, h.Delete(7), "eliminar 7: elemento inexistente")
+	assert.EqualError(t, h.Update(7, 0), "actualizar 7: elemento inexistente")
+	_, err := h.PopN(3)
+	assert.EqualError(t, err, "extraer n: n fuera de rango: se pidieron 3 elementos de un heap de 2")
+
+	usarIdioma(t, Ingles)
+	assert.EqualError(t, h.Delete(7), "delete 7: missing element")
+	assert.EqualError(t, h.Update(7, 0), "update 7: missing element")
+	_, err = h.PopN(3)
+	assert.EqualError(t, err, "pop n: n out of range: asked for 3 elements from a heap of 2")
+}
+
+func TestPrefijosEnInglesEnTodoElPaquete(t *testing.T) {
+	usarIdioma(t, Ingles)
+
+	_, err := NewMinHeap[int]().Peek()
+	assert.EqualError(t, err, "peek: empty heap")
+	assert.EqualError(t, ConHistorial(NewMinHeap[int]()).Undo(), "undo: nothing to undo")
+
+	indexado := NewHeapIndexado[string](cmp.Compare[int])
+	assert.NoError(t, indexado.Insert("a", 1))
+	assert.EqualError(t, indexado.Insert("a", 2), "insert a: duplicate key")
+
+	cola := NewColaBloqueante(cmp.Compare[int])
+	cola.Close()
+	assert.EqualError(t, cola.Put(1), "put: closed queue")
+	var nulo *Heap[int]
+	assert.EqualError(t, nulo.Transaction(nil), "transaction: nil heap")
+	assert.PanicsWithError(t, "insert: nil heap", func() { nulo.Insert(1) })
+}
+
+func TestSetIdiomaAceptaRegion(t *testing.T) {
+	usarIdioma(t, "en_US.UTF-8")
+	assert.Equal(t, Ingles, IdiomaActual())
+}
+
+func TestSetIdiomaNoSoportado(t *testing.T) {
+	usarIdioma(t, Espanol)
+	err := SetIdioma("fr")
+	assert.EqualError(t, err, `idioma no soportado: "fr"`)
+	assert.Equal(t, Espanol, IdiomaActual())
+}
@@ -0,0 +1,272 @@
+package heap
+
+import "fmt"
+
+var (
+	// ErrClaveDuplicada indica que se insertó una clave que ya está en el heap.
+	ErrClaveDuplicada error = &errorLocalizado{es: "clave duplicada", en: "duplicate key"}
+	// ErrClaveInexistente indica que se pidió una clave que no está en el heap.
+	ErrClaveInexistente error = &errorLocalizado{es: "clave inexistente", en: "missing key"}
+)
+
+// nodoIndexado es un elemento del heap indexado junto con su clave.
+type nodoIndexado[K comparable, T any] struct {
+	clave K
+	valor T
+}
+
+// HeapIndexado es un heap binario en el que cada elemento tiene una clave
+// única. Además de las operaciones de Heap permite consultar, modificar y
+// eliminar un elemento cualquiera a partir de su clave en O(log n), lo que lo
+// hace útil para algoritmos como Dijkstra o Prim (decrease-key) y para
+// estructuras donde los elementos se cancelan o cambian de prioridad.
+type HeapIndexado[K comparable, T any] struct {
+	elements []nodoIndexado[K, T]
+	// posición de cada clave en elements
+	posiciones map[K]int
+	// misma convención que Heap.compare
+	compare func(a T, b T) int
+	guardia guardia
+}
+
+// NewHeapIndexado crea un heap indexado vacío.
+//
+// Uso:
+//
+//	h := heap.NewHeapIndexado[string](func(a, b int) int { return a - b })
+//
+// Parámetros:
+//   - `comp` función de comparación, con la misma convención que NewGenericHeap.
+//
+// Retorna:
+//   - un puntero a un heap indexado vacío.
+func NewHeapIndexado[K comparable, T any](comp func(a T, b T) int) *HeapIndexado[K, T] {
+	if comp == nil {
+		panic(Localizar("heap: la función de comparación no puede ser nil", "heap: comparison function must not be nil"))
+	}
+
+	return &HeapIndexado[K, T]{posiciones: make(map[K]int), compare: comp}
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (m *HeapIndexado[K, T]) Size() int {
+	if m == nil {
+		return 0
+	}
+	m.guardia.entrar("Size")
+	defer m.guardia.salir()
+
+	return len(m.elements)
+}
+
+// Contains indica si la clave está en el heap.
+func (m *HeapIndexado[K, T]) Contains(clave K) bool {
+	_, ok := m.Get(clave)
+	return ok
+}
+
+// Get retorna el elemento asociado a la clave.
+//
+// Retorna:
+//   - el elemento.
+//   - false si la clave no está en el heap.
+func (m *HeapIndexado[K, T]) Get(clave K) (T, bool) {
+	var valor T
+	if m == nil {
+		return valor, false
+	}
+	m.guardia.entrar("Get")
+	defer m.guardia.salir()
+	i, ok := m.posiciones[clave]
+	if !ok {
+		return valor, false
+	}
+
+	return m.elements[i].valor, true
+}
+
+// Claves retorna las claves de los elementos del heap, en el orden en que
+// están almacenados (no en orden de prioridad).
+func (m *HeapIndexado[K, T]) Claves() []K {
+	if m == nil {
+		return nil
+	}
+	m.guardia.entrar("Claves")
+	defer m.guardia.salir()
+	claves := make([]K, len(m.elements))
+	for i, n := range m.elements {
+		claves[i] = n.clave
+	}
+
+	return claves
+}
+
+// Insert agrega un elemento con su clave.
+//
+// Uso:
+//
+//	err := h.Insert("a", 5)
+//
+// Retorna:
+//   - un error que envuelve a ErrClaveDuplicada si la clave ya está en el heap.
+func (m *HeapIndexado[K, T]) Insert(clave K, valor T) error {
+	if m == nil {
+		return fmt.Errorf(Localizar("insertar: %w", "insert: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Insert")
+	defer m.guardia.salir()
+	if _, ok := m.posiciones[clave]; ok {
+		return fmt.Errorf(Localizar("insertar %v: %w", "insert %v: %w"), clave, ErrClaveDuplicada)
+	}
+	m.elements = append(m.elements, nodoIndexado[K, T]{clave: clave, valor: valor})
+	m.posiciones[clave] = len(m.elements) - 1
+	m.upHeap(len(m.elements) - 1)
+
+	return nil
+}
+
+// Peek retorna el elemento en la cima del heap y su clave, sin eliminarlo.
+//
+// Retorna:
+//   - la clave y el elemento de la cima.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (m *HeapIndexado[K, T]) Peek() (K, T, error) {
+	var clave K
+	var valor T
+	if m == nil {
+		return clave, valor, fmt.Errorf(Localizar("consultar: %w", "peek: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Peek")
+	defer m.guardia.salir()
+	if len(m.elements) == 0 {
+		return clave, valor, fmt.Errorf(Localizar("consultar: %w", "peek: %w"), ErrHeapVacio)
+	}
+
+	return m.elements[0].clave, m.elements[0].valor, nil
+}
+
+// Remove elimina y retorna el elemento en la cima del heap y su clave.
+//
+// Retorna:
+//   - la clave y el elemento de la cima.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (m *HeapIndexado[K, T]) Remove() (K, T, error) {
+	var clave K
+	var valor T
+	if m == nil {
+		return clave, valor, fmt.Errorf(Localizar("extraer: %w", "remove: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Remove")
+	defer m.guardia.salir()
+	if len(m.elements) == 0 {
+		return clave, valor, fmt.Errorf(Localizar("extraer: %w", "remove: %w"), ErrHeapVacio)
+	}
+	raiz := m.eliminarEn(0)
+
+	return raiz.clave, raiz.valor, nil
+}
+
+// Update reemplaza el elemento asociado a la clave y lo reubica, ya sea que
+// su prioridad suba (decrease-key en un heap de mínimos) o baje.
+//
+// Uso:
+//
+//	err := h.Update("a", 1)
+//
+// Retorna:
+//   - un error que envuelve a ErrClaveInexistente si la clave no está en el heap.
+func (m *HeapIndexado[K, T]) Update(clave K, valor T) error {
+	if m == nil {
+		return fmt.Errorf(Localizar("actualizar: %w", "update: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Update")
+	defer m.guardia.salir()
+	i, ok := m.posiciones[clave]
+	if !ok {
+		return fmt.Errorf(Localizar("actualizar %v: %w", "update %v: %w"), clave, ErrClaveInexistente)
+	}
+	m.elements[i].valor = valor
+	m.reubicar(i)
+
+	return nil
+}
+
+// Delete elimina el elemento asociado a la clave, esté donde esté.
+//
+// Retorna:
+//   - el elemento eliminado.
+//   - un error que envuelve a ErrClaveInexistente si la clave no está en el heap.
+func (m *HeapIndexado[K, T]) Delete(clave K) (T, error) {
+	var valor T
+	if m == nil {
+		return valor, fmt.Errorf(Localizar("eliminar: %w", "delete: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Delete")
+	defer m.guardia.salir()
+	i, ok := m.posiciones[clave]
+	if !ok {
+		return valor, fmt.Errorf(Localizar("eliminar %v: %w", "delete %v: %w"), clave, ErrClaveInexistente)
+	}
+
+	return m.eliminarEn(i).valor, nil
+}
+
+// eliminarEn quita el nodo de la posición i reemplazándolo por el último.
+func (m *HeapIndexado[K, T]) eliminarEn(i int) nodoIndexado[K, T] {
+	nodo := m.elements[i]
+	ultimo := len(m.elements) - 1
+	m.intercambiar(i, ultimo)
+	m.elements = m.elements[:ultimo]
+	delete(m.posiciones, nodo.clave)
+	if i < ultimo {
+		m.reubicar(i)
+	}
+
+	return nodo
+}
+
+// reubicar mueve el nodo de la posición i hacia arriba o hacia abajo.
+func (m *HeapIndexado[K, T]) reubicar(i int) {
+	if i > 0 && m.compare(m.elements[i].valor, m.elements[(i-1)/2].valor) < 0 {
+		m.upHeap(i)
+	} else {
+		m.downHeap(i)
+	}
+}
+
+func (m *HeapIndexado[K, T]) intercambiar(i, j int) {
+	m.elements[i], m.elements[j] = m.elements[j], m.elements[i]
+	m.posiciones[m.elements[i].clave] = i
+	m.posiciones[m.elements[j].clave] = j
+}
+
+func (m *HeapIndexado[K, T]) upHeap(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if m.compare(m.elements[i].valor, m.elements[parent].valor) >= 0 {
+			break
+		}
+		m.intercambiar(i, parent)
+		i = parent
+	}
+}
+
+func (m *HeapIndexado[K, T]) downHeap(i int) {
+	for {
+		left := 2*i + 1
+		right := 2*i + 2
+		smallest := i
+
+		if left < len(m.elements) && m.compare(m.elements[left].valor, m.elements[smallest].valor) < 0 {
+			smallest = left
+		}
+		if right < len(m.elements) && m.compare(m.elements[right].valor, m.elements[smallest].valor) < 0 {
+			smallest = right
+		}
+		if smallest == i {
+			break
+		}
+		m.intercambiar(i, smallest)
+		i = smallest
+	}
+}
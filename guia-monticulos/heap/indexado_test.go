@@ -0,0 +1,142 @@
+package heap
+
+import (
+	"cmp"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func indexadoValido[K comparable, T any](t *testing.T, h *HeapIndexado[K, T]) {
+	t.Helper()
+	for i := 1; i < len(h.elements); i++ {
+		assert.LessOrEqual(t, h.compare(h.elements[(i-1)/2].valor, h.elements[i].valor), 0)
+	}
+	assert.Len(t, h.posiciones, len(h.elements))
+	for i, n := range h.elements {
+		assert.Equal(t, i, h.posiciones[n.clave])
+	}
+}
+
+func TestHeapIndexadoInsertYRemove(t *testing.T) {
+	h := NewHeapIndexado[string](cmp.Compare[int])
+	assert.NoError(t, h.Insert("c", 3))
+	assert.NoError(t, h.Insert("a", 1))
+	assert.NoError(t, h.Insert("b", 2))
+
+	clave, valor, err := h.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", clave)
+	assert.Equal(t, 1, valor)
+
+	for _, esperada := range []string{"a", "b", "c"} {
+		clave, _, err := h.Remove()
+		assert.NoError(t, err)
+		assert.Equal(t, esperada, clave)
+	}
+	_, _, err = h.Remove()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+	_, _, err = h.Peek()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+}
+
+func TestHeapIndexadoClaveDuplicada(t *testing.T) {
+	h := NewHeapIndexado[string](cmp.Compare[int])
+	assert.NoError(t, h.Insert("a", 1))
+
+	err := h.Insert("a", 2)
+	assert.ErrorIs(t, err, ErrClaveDuplicada)
+	assert.EqualError(t, err, "insertar a: clave duplicada")
+	assert.Equal(t, 1, h.Size())
+}
+
+func TestHeapIndexadoUpdate(t *testing.T) {
+	h := NewHeapIndexado[string](cmp.Compare[int])
+	for i, c := range []string{"a", "b", "c", "d", "e"} {
+		assert.NoError(t, h.Insert(c, (i+1)*10))
+	}
+
+	assert.NoError(t, h.Update("e", 5))
+	clave, _, _ := h.Peek()
+	assert.Equal(t, "e", clave)
+
+	assert.NoError(t, h.Update("e", 100))
+	clave, _, _ = h.Peek()
+	assert.Equal(t, "a", clave)
+	v, ok := h.Get("e")
+	assert.True(t, ok)
+	assert.Equal(t, 100, v)
+	indexadoValido(t, h)
+
+	err := h.Update("z", 1)
+	assert.ErrorIs(t, err, ErrClaveInexistente)
+	assert.EqualError(t, err, "actualizar z: clave inexistente")
+}
+
+func TestHeapIndexadoDelete(t *testing.T) {
+	h := NewHeapIndexado[int](cmp.Compare[int])
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, h.Insert(i, 9-i))
+	}
+
+	v, err := h.Delete(4)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, v)
+	assert.False(t, h.Contains(4))
+	assert.ElementsMatch(t, []int{0, 1, 2, 3, 5, 6, 7, 8, 9}, h.Claves())
+	assert.Equal(t, 9, h.Size())
+	indexadoValido(t, h)
+
+	_, err = h.Delete(4)
+	assert.ErrorIs(t, err, ErrClaveInexistente)
+}
+
+func TestHeapIndexadoOperacionesAleatorias(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := NewHeapIndexado[int](cmp.Compare[int])
+	valores := map[int]int{}
+	for i := 0; i < 2000; i++ {
+		clave := r.Intn(50)
+		switch r.Intn(4) {
+		case 0:
+			if h.Insert(clave, r.Intn(1000)) == nil {
+				v, _ := h.Get(clave)
+				valores[clave] = v
+			}
+		case 1:
+			if h.Update(clave, r.Intn(1000)) == nil {
+				v, _ := h.Get(clave)
+				valores[clave] = v
+			}
+		case 2:
+			if _, err := h.Delete(clave); err == nil {
+				delete(valores, clave)
+			}
+		case 3:
+			if c, v, err := h.Remove(); err == nil {
+				for _, otro := range valores {
+					assert.LessOrEqual(t, v, otro)
+				}
+				delete(valores, c)
+			}
+		}
+		assert.Equal(t, len(valores), h.Size())
+	}
+	indexadoValido(t, h)
+}
+
+func TestHeapIndexadoNil(t *testing.T) {
+	var h *HeapIndexado[string, int]
+
+	assert.Equal(t, 0, h.Size())
+	assert.False(t, h.Contains("a"))
+	assert.Nil(t, h.Claves())
+	assert.ErrorIs(t, h.Insert("a", 1), ErrHeapNil)
+	assert.ErrorIs(t, h.Update("a", 1), ErrHeapNil)
+	_, err := h.Delete("a")
+	assert.ErrorIs(t, err, ErrHeapNil)
+	_, _, err = h.Remove()
+	assert.ErrorIs(t, err, ErrHeapNil)
+	assert.Panics(t, func() { NewHeapIndexado[string, int](nil) })
+}
@@ -0,0 +1,132 @@
+package heap
+
+// Estadisticas son los contadores de operaciones de un heap, para verificar
+// empíricamente los costos: que Insert y Remove hacen O(log n) comparaciones
+// e intercambios, o que armar un heap con heapify hace O(n). Se activan con
+// EnableStats y se consultan con Stats.
+type Estadisticas struct {
+	// llamadas a la función de comparación al reacomodar y al buscar
+	// elementos
+	Comparaciones int
+	// intercambios de elementos al reacomodar
+	Intercambios int
+	// elementos agregados con Insert o Replace
+	Inserciones int
+	// elementos extraídos con Remove (también desde PopN o Drain) o Replace
+	Extracciones int
+	// reacomodos hacia arriba o hacia abajo, uno por inserción o extracción
+	// y uno por cada nodo con hijos en un heapify
+	Reacomodos int
+	// niveles del árbol recorridos en total por los reacomodos
+	Niveles int
+	// niveles recorridos por el reacomodo más largo
+	MaxNiveles int
+}
+
+// EnableStats empieza a contar las operaciones del heap, con los contadores
+// en cero. Mientras no se llame, contar no tiene costo. Las copias del heap
+// (Clone, Filter, etc.) no heredan los contadores.
+//
+// Uso:
+//
+//	heap.EnableStats()
+//	heap.Insert(7)
+//	fmt.Println(heap.Stats().Comparaciones)
+//
+// Activar las estadísticas de un heap nil no tiene efecto.
+func (m *Heap[T]) EnableStats() {
+	if m == nil {
+		return
+	}
+	m.guardia.entrar("EnableStats")
+	defer m.guardia.salir()
+	m.stats = &Estadisticas{}
+}
+
+// Stats retorna los contadores acumulados desde EnableStats o desde el
+// último ResetStats.
+//
+// Retorna:
+//   - una copia de los contadores, todos en cero si las estadísticas no
+//     están activadas.
+func (m *Heap[T]) Stats() Estadisticas {
+	if m == nil {
+		return Estadisticas{}
+	}
+	m.guardia.entrar("Stats")
+	defer m.guardia.salir()
+	if m.stats == nil {
+		return Estadisticas{}
+	}
+
+	return *m.stats
+}
+
+// ResetStats pone los contadores en cero, por ejemplo para medir solo una
+// parte de un algoritmo. Si las estadísticas no están activadas no tiene
+// efecto.
+func (m *Heap[T]) ResetStats() {
+	if m == nil {
+		return
+	}
+	m.guardia.entrar("ResetStats")
+	defer m.guardia.salir()
+	if m.stats != nil {
+		*m.stats = Estadisticas{}
+	}
+}
+
+// comparar aplica la función de comparación, la cuenta y la notifica.
+func (m *Heap[T]) comparar(a T, b T) int {
+	resultado := m.compare(a, b)
+	if m.stats != nil {
+		m.stats.Comparaciones++
+	}
+	for _, o := range m.observadores {
+		o.observador.OnCompare(a, b, resultado)
+	}
+
+	return resultado
+}
+
+// intercambiar intercambia los elementos de las posiciones i y j, lo cuenta
+// y lo notifica.
+func (m *Heap[T]) intercambiar(i, j int) {
+	m.elements[i], m.elements[j] = m.elements[j], m.elements[i]
+	if m.stats != nil {
+		m.stats.Intercambios++
+	}
+	for _, o := range m.observadores {
+		o.observador.OnSwap(i, j)
+	}
+}
+
+// registrarInsercion cuenta y notifica un elemento agregado.
+func (m *Heap[T]) registrarInsercion(element T) {
+	if m.stats != nil {
+		m.stats.Inserciones++
+	}
+	for _, o := range m.observadores {
+		o.observador.OnInsert(element)
+	}
+}
+
+// registrarExtraccion cuenta y notifica la extracción de la cima.
+func (m *Heap[T]) registrarExtraccion(element T) {
+	if m.stats != nil {
+		m.stats.Extracciones++
+	}
+	for _, o := range m.observadores {
+		o.observador.OnRemove(element)
+	}
+}
+
+// registrarReacomodo cuenta un reacomodo que recorrió `niveles` niveles.
+func (m *Heap[T]) registrarReacomodo(niveles int) {
+	if m.stats == nil {
+		return
+	}
+	m.stats.Reacomodos++
+	m.stats.Niveles += niveles
+	m.stats.MaxNiveles = max(m.stats.MaxNiveles, niveles)
+}
@@ -0,0 +1,89 @@
+package heap
+
+import (
+	"math/bits"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsCuentaInsertYRemove(t *testing.T) {
+	h := NewMinHeap[int]()
+	h.EnableStats()
+
+	// 3, 2, 1: el 2 sube un nivel y el 1 sube un nivel
+	for _, v := range []int{3, 2, 1} {
+		h.Insert(v)
+	}
+	assert.Equal(t, Estadisticas{
+		Comparaciones: 2,
+		Intercambios:  2,
+		Inserciones:   3,
+		Reacomodos:    3,
+		Niveles:       2,
+		MaxNiveles:    1,
+	}, h.Stats())
+
+	h.ResetStats()
+	_, _ = h.Remove()
+	// el arreglo es [1 3 2]: el 2 pasa a la raíz, se compara con su único
+	// hijo, el 3, y no baja
+	assert.Equal(t, Estadisticas{
+		Comparaciones: 1,
+		Extracciones:  1,
+		Reacomodos:    1,
+	}, h.Stats())
+}
+
+func TestStatsInsertYRemoveSonLogaritmicos(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := NewMinHeap[int]()
+	h.EnableStats()
+	for i := 0; i < 1<<12; i++ {
+		h.Insert(r.Int())
+	}
+	altura := bits.Len(uint(h.Size()))
+
+	assert.LessOrEqual(t, h.Stats().MaxNiveles, altura)
+	h.ResetStats()
+	for h.Size() > 0 {
+		_, _ = h.Remove()
+	}
+	s := h.Stats()
+	assert.LessOrEqual(t, s.MaxNiveles, altura)
+	assert.LessOrEqual(t, s.Comparaciones, 2*altura*s.Extracciones)
+}
+
+func TestStatsHeapifyEsLineal(t *testing.T) {
+	n := 1 << 12
+	h := NewMinHeap[int]()
+	h.EnableStats()
+	h.elements = rand.New(rand.NewSource(1)).Perm(n)
+
+	h.heapify()
+
+	s := h.Stats()
+	assert.Equal(t, n/2, s.Reacomodos)
+	assert.LessOrEqual(t, s.Niveles, n)
+	assert.LessOrEqual(t, s.Comparaciones, 2*n)
+}
+
+func TestStatsDesactivadas(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{3, 1, 2})
+	h.Insert(0)
+	h.ResetStats()
+	assert.Equal(t, Estadisticas{}, h.Stats())
+
+	// las copias no heredan los contadores
+	h.EnableStats()
+	copia := h.Clone()
+	copia.Insert(5)
+	assert.Equal(t, Estadisticas{}, copia.Stats())
+	assert.Equal(t, Estadisticas{}, h.Stats())
+
+	var nulo *Heap[int]
+	assert.NotPanics(t, nulo.EnableStats)
+	assert.NotPanics(t, nulo.ResetStats)
+	assert.Equal(t, Estadisticas{}, nulo.Stats())
+}
@@ -0,0 +1,168 @@
+package heap
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Iterator retorna un iterador sobre los elementos en el orden del arreglo
+// interno (por niveles, de izquierda a derecha), no en orden de prioridad.
+// Recorre una copia tomada al crearlo, así que no lo afectan los cambios
+// posteriores del heap.
+//
+// Uso:
+//
+//	for it := heap.Iterator(); it.HasNext(); {
+//		v, _ := it.Next()
+//		fmt.Println(v)
+//	}
+//
+// Retorna:
+//   - el iterador. Un heap nil se recorre como uno vacío.
+func (m *Heap[T]) Iterator() Iterator[T] {
+	return &iteradorArreglo[T]{elementos: m.Values()}
+}
+
+// SortedIterator retorna un iterador sobre los elementos en orden de
+// prioridad, sin modificar el heap. Los elementos se extraen a medida que se
+// piden: cada Next cuesta O(log k), donde k es la cantidad de elementos ya
+// recorridos, así que leer solo los primeros es barato aunque el heap sea
+// grande. Recorre una copia tomada al crearlo, así que no lo afectan los
+// cambios posteriores del heap.
+//
+// Uso:
+//
+//	for it := heap.SortedIterator(); it.HasNext(); {
+//		v, _ := it.Next()
+//		fmt.Println(v)
+//	}
+//
+// Retorna:
+//   - el iterador. Un heap nil se recorre como uno vacío.
+func (m *Heap[T]) SortedIterator() Iterator[T] {
+	if m == nil {
+		return &iteradorArreglo[T]{}
+	}
+
+	return nuevoIteradorOrdenado(m.Values(), m.compare)
+}
+
+// All retorna una secuencia de los elementos en el orden del arreglo
+// interno, como Iterator, para recorrerla con range.
+//
+// Uso:
+//
+//	for v := range heap.All() {
+//		fmt.Println(v)
+//	}
+//
+// Retorna:
+//   - la secuencia. Cada recorrido usa una copia de los elementos tomada al
+//     empezar, así que modificar el heap dentro del ciclo no lo afecta.
+func (m *Heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range m.Values() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// InOrder retorna una secuencia de los elementos en orden de prioridad, como
+// SortedIterator, para recorrerla con range. Cortar el ciclo antes de
+// terminar evita ordenar el resto de los elementos.
+//
+// Uso:
+//
+//	for v := range heap.InOrder() {
+//		if v > limite {
+//			break
+//		}
+//		fmt.Println(v)
+//	}
+//
+// Retorna:
+//   - la secuencia. Cada recorrido usa una copia de los elementos tomada al
+//     empezar, así que modificar el heap dentro del ciclo no lo afecta.
+func (m *Heap[T]) InOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := m.SortedIterator(); it.HasNext(); {
+			v, _ := it.Next()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ForEach aplica `f` a cada elemento en el orden del arreglo interno, hasta
+// que `f` retorne false. A diferencia de All no copia los elementos, así que
+// `f` no debe modificar el heap.
+//
+// Uso:
+//
+//	var vencidas int
+//	tareas.ForEach(func(t Tarea) bool {
+//		if t.Vencida() {
+//			vencidas++
+//		}
+//		return true
+//	})
+//
+// Parámetros:
+//   - `f` función a aplicar. Retorna false para cortar el recorrido.
+func (m *Heap[T]) ForEach(f func(T) bool) {
+	if m == nil {
+		return
+	}
+	m.guardia.entrar("ForEach")
+	defer m.guardia.salir()
+	for _, e := range m.elements {
+		if !f(e) {
+			return
+		}
+	}
+}
+
+// iteradorOrdenado recorre en orden de prioridad el arreglo de un heap sin
+// modificarlo. El siguiente elemento en orden es siempre un hijo de alguno de
+// los ya retornados, así que alcanza con un heap auxiliar de candidatos.
+type iteradorOrdenado[T any] struct {
+	elementos []T
+	// posiciones de elementos que pueden ser la siguiente en orden
+	candidatos *Heap[int]
+}
+
+func nuevoIteradorOrdenado[T any](elementos []T, compare func(a T, b T) int) *iteradorOrdenado[T] {
+	it := &iteradorOrdenado[T]{
+		elementos: elementos,
+		candidatos: NewGenericHeap(func(i, j int) int {
+			return compare(elementos[i], elementos[j])
+		}),
+	}
+	if len(elementos) > 0 {
+		it.candidatos.Insert(0)
+	}
+
+	return it
+}
+
+func (it *iteradorOrdenado[T]) HasNext() bool {
+	return it.candidatos.Size() > 0
+}
+
+func (it *iteradorOrdenado[T]) Next() (T, error) {
+	i, err := it.candidatos.Remove()
+	if err != nil {
+		var cero T
+		return cero, fmt.Errorf(Localizar("siguiente: %w", "next: %w"), ErrIteradorAgotado)
+	}
+	for _, hijo := range []int{2*i + 1, 2*i + 2} {
+		if hijo < len(it.elementos) {
+			it.candidatos.Insert(hijo)
+		}
+	}
+
+	return it.elementos[i], nil
+}
@@ -0,0 +1,114 @@
+package heap
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIteratorRecorreElArregloInterno(t *testing.T) {
+	h := NewMaxHeap[int]()
+	for _, v := range []int{1, 5, 3, 4} {
+		h.Insert(v)
+	}
+
+	it := h.Iterator()
+	h.Insert(10)
+	assert.Equal(t, []int{5, 4, 3, 1}, drenar(t, it))
+	assert.False(t, it.HasNext())
+	_, err := it.Next()
+	assert.ErrorIs(t, err, ErrIteradorAgotado)
+}
+
+func TestIteratorDeHeapVacioYNil(t *testing.T) {
+	assert.False(t, NewMinHeap[int]().Iterator().HasNext())
+
+	var nulo *Heap[int]
+	assert.False(t, nulo.Iterator().HasNext())
+}
+
+func TestSortedIteratorRecorreEnOrdenSinModificarElHeap(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{8, 3, 5, 1, 9, 2, 7, 3})
+	antes := h.Values()
+
+	it := h.SortedIterator()
+	primero, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, primero)
+	assert.Equal(t, antes, h.Values())
+
+	// el iterador no ve los cambios posteriores
+	h.Insert(0)
+	assert.Equal(t, []int{2, 3, 3, 5, 7, 8, 9}, drenar(t, it))
+	_, err = it.Next()
+	assert.ErrorIs(t, err, ErrIteradorAgotado)
+}
+
+func TestSortedIteratorDeHeapVacioYNil(t *testing.T) {
+	assert.False(t, NewMaxHeap[int]().SortedIterator().HasNext())
+
+	var nulo *Heap[int]
+	assert.False(t, nulo.SortedIterator().HasNext())
+}
+
+func TestAllRecorreElArregloInternoConRange(t *testing.T) {
+	h := NuevoMonticuloMaxDesdeArreglo([]int{1, 5, 3, 4})
+
+	var recorridos []int
+	for v := range h.All() {
+		h.Insert(v * 10)
+		recorridos = append(recorridos, v)
+	}
+	assert.Equal(t, []int{5, 4, 3, 1}, recorridos)
+	assert.Equal(t, 8, h.Size())
+
+	// cada recorrido toma una copia nueva
+	assert.Equal(t, h.Values(), slices.Collect(h.All()))
+}
+
+func TestInOrderRecorreEnOrdenDePrioridad(t *testing.T) {
+	h := NuevoMonticuloMinDesdeArreglo([]int{8, 3, 5, 1, 9, 2})
+
+	assert.Equal(t, []int{1, 2, 3, 5, 8, 9}, slices.Collect(h.InOrder()))
+
+	var menores []int
+	for v := range h.InOrder() {
+		if v > 3 {
+			break
+		}
+		menores = append(menores, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, menores)
+	assert.Equal(t, 6, h.Size())
+}
+
+func TestAllEInOrderDeHeapNil(t *testing.T) {
+	var nulo *Heap[int]
+	assert.Empty(t, slices.Collect(nulo.All()))
+	assert.Empty(t, slices.Collect(nulo.InOrder()))
+}
+
+func TestForEachRecorreHastaQueLaFuncionRetorneFalse(t *testing.T) {
+	h := NuevoMonticuloMaxDesdeArreglo([]int{1, 5, 3, 4})
+
+	var todos []int
+	h.ForEach(func(v int) bool {
+		todos = append(todos, v)
+		return true
+	})
+	assert.Equal(t, h.Values(), todos)
+
+	var primeros []int
+	h.ForEach(func(v int) bool {
+		primeros = append(primeros, v)
+		return len(primeros) < 2
+	})
+	assert.Equal(t, []int{5, 4}, primeros)
+
+	var nulo *Heap[int]
+	nulo.ForEach(func(int) bool {
+		t.Fatal("un heap nil no tiene elementos")
+		return true
+	})
+}
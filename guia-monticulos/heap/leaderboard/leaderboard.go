@@ -0,0 +1,168 @@
+// Package leaderboard mantiene los N mejores puntajes de un juego. Los
+// jugadores del ranking se guardan en un heap de mínimos indexado por nombre:
+// en la cima está el peor de los N, que es el que se compara con cada puntaje
+// nuevo, y el índice permite actualizar el puntaje de un jugador (update-key)
+// en O(log N).
+package leaderboard
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// Entrada es un jugador del ranking.
+type Entrada struct {
+	Jugador string
+	Puntaje int
+	// orden en que el jugador entró al ranking; a igual puntaje queda mejor
+	// ubicado el que entró antes
+	ingreso int
+}
+
+// mejor indica si `a` está mejor ubicado que `b` en el ranking.
+func mejor(a, b Entrada) bool {
+	if a.Puntaje != b.Puntaje {
+		return a.Puntaje > b.Puntaje
+	}
+
+	return a.ingreso < b.ingreso
+}
+
+// Leaderboard es un ranking de tamaño fijo. Los jugadores que quedan afuera
+// se olvidan: si vuelven a puntuar, entran como nuevos.
+type Leaderboard struct {
+	n        int
+	ranking  *heap.HeapIndexado[string, Entrada]
+	ingresos int
+}
+
+// New crea un ranking vacío con lugar para `n` jugadores.
+//
+// Uso:
+//
+//	lb, err := leaderboard.New(10)
+//
+// Retorna:
+//   - un puntero al ranking.
+//   - un error si `n` no es positivo.
+func New(n int) (*Leaderboard, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("tamaño inválido: %d", n)
+	}
+
+	return &Leaderboard{
+		n: n,
+		// en la cima, el peor ubicado
+		ranking: heap.NewHeapIndexado[string](func(a, b Entrada) int {
+			if mejor(b, a) {
+				return -1
+			}
+			if mejor(a, b) {
+				return 1
+			}
+			return 0
+		}),
+	}, nil
+}
+
+// Submit registra el puntaje de un jugador. Si ya está en el ranking su
+// puntaje se reemplaza, aunque sea menor, conservando su antigüedad. Si no
+// está, entra solo si hay lugar o si supera al peor ubicado, que sale del
+// ranking.
+//
+// Uso:
+//
+//	entro := lb.Submit("ana", 1200)
+//
+// Retorna:
+//   - true si el jugador quedó en el ranking.
+func (l *Leaderboard) Submit(jugador string, puntaje int) bool {
+	if e, ok := l.ranking.Get(jugador); ok {
+		e.Puntaje = puntaje
+		_ = l.ranking.Update(jugador, e)
+		return true
+	}
+
+	nueva := Entrada{Jugador: jugador, Puntaje: puntaje, ingreso: l.ingresos + 1}
+	if l.ranking.Size() == l.n {
+		_, peor, _ := l.ranking.Peek()
+		if !mejor(nueva, peor) {
+			return false
+		}
+		_, _, _ = l.ranking.Remove()
+	}
+	l.ingresos++
+	_ = l.ranking.Insert(jugador, nueva)
+
+	return true
+}
+
+// ErrJugadorInexistente indica que el jugador no está en el ranking.
+var ErrJugadorInexistente = errors.New("el jugador no está en el ranking")
+
+// Remove saca a un jugador del ranking.
+//
+// Retorna:
+//   - un error que envuelve a ErrJugadorInexistente si no estaba.
+func (l *Leaderboard) Remove(jugador string) error {
+	if _, err := l.ranking.Delete(jugador); err != nil {
+		return fmt.Errorf("%w: %s", ErrJugadorInexistente, jugador)
+	}
+
+	return nil
+}
+
+// Puntaje retorna el puntaje de un jugador del ranking.
+//
+// Retorna:
+//   - el puntaje.
+//   - false si el jugador no está en el ranking.
+func (l *Leaderboard) Puntaje(jugador string) (int, bool) {
+	e, ok := l.ranking.Get(jugador)
+	return e.Puntaje, ok
+}
+
+// Ranking retorna la posición de un jugador, empezando en 1. Cuesta O(N).
+//
+// Retorna:
+//   - la posición.
+//   - false si el jugador no está en el ranking.
+func (l *Leaderboard) Ranking(jugador string) (int, bool) {
+	e, ok := l.ranking.Get(jugador)
+	if !ok {
+		return 0, false
+	}
+	posicion := 1
+	for _, otro := range l.ranking.Claves() {
+		if otra, _ := l.ranking.Get(otro); mejor(otra, e) {
+			posicion++
+		}
+	}
+
+	return posicion, true
+}
+
+// Top retorna los jugadores del ranking, del mejor al peor.
+func (l *Leaderboard) Top() []Entrada {
+	entradas := make([]Entrada, 0, l.ranking.Size())
+	for _, jugador := range l.ranking.Claves() {
+		e, _ := l.ranking.Get(jugador)
+		entradas = append(entradas, e)
+	}
+	slices.SortFunc(entradas, func(a, b Entrada) int {
+		if mejor(a, b) {
+			return -1
+		}
+		return 1
+	})
+
+	return entradas
+}
+
+// Len retorna la cantidad de jugadores en el ranking.
+func (l *Leaderboard) Len() int {
+	return l.ranking.Size()
+}
@@ -0,0 +1,78 @@
+package leaderboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func jugadores(entradas []Entrada) []string {
+	r := make([]string, len(entradas))
+	for i, e := range entradas {
+		r[i] = e.Jugador
+	}
+
+	return r
+}
+
+func TestTopNConservaLosMejores(t *testing.T) {
+	lb, err := New(3)
+	assert.NoError(t, err)
+
+	assert.True(t, lb.Submit("ana", 50))
+	assert.True(t, lb.Submit("beto", 80))
+	assert.True(t, lb.Submit("caro", 30))
+	assert.True(t, lb.Submit("dani", 60))
+	assert.False(t, lb.Submit("eli", 10))
+
+	assert.Equal(t, []string{"beto", "dani", "ana"}, jugadores(lb.Top()))
+	_, ok := lb.Puntaje("caro")
+	assert.False(t, ok)
+	assert.Equal(t, 3, lb.Len())
+}
+
+func TestActualizarPuntaje(t *testing.T) {
+	lb, _ := New(3)
+	lb.Submit("ana", 50)
+	lb.Submit("beto", 80)
+	lb.Submit("caro", 30)
+
+	assert.True(t, lb.Submit("caro", 100))
+	assert.True(t, lb.Submit("beto", 20))
+
+	assert.Equal(t, []string{"caro", "ana", "beto"}, jugadores(lb.Top()))
+	puntaje, _ := lb.Puntaje("beto")
+	assert.Equal(t, 20, puntaje)
+	posicion, ok := lb.Ranking("beto")
+	assert.True(t, ok)
+	assert.Equal(t, 3, posicion)
+}
+
+func TestEmpatesPorAntiguedad(t *testing.T) {
+	lb, _ := New(2)
+	lb.Submit("ana", 50)
+	lb.Submit("beto", 50)
+
+	assert.False(t, lb.Submit("caro", 50), "a igual puntaje no desplaza a los más antiguos")
+	assert.Equal(t, []string{"ana", "beto"}, jugadores(lb.Top()))
+
+	lb.Submit("ana", 40)
+	lb.Submit("ana", 50)
+	assert.Equal(t, []string{"ana", "beto"}, jugadores(lb.Top()), "actualizar no cambia la antigüedad")
+
+	posicion, _ := lb.Ranking("beto")
+	assert.Equal(t, 2, posicion)
+	_, ok := lb.Ranking("caro")
+	assert.False(t, ok)
+}
+
+func TestRemoveYErrores(t *testing.T) {
+	_, err := New(0)
+	assert.EqualError(t, err, "tamaño inválido: 0")
+
+	lb, _ := New(1)
+	lb.Submit("ana", 10)
+	assert.NoError(t, lb.Remove("ana"))
+	assert.ErrorIs(t, lb.Remove("ana"), ErrJugadorInexistente)
+	assert.True(t, lb.Submit("beto", 1))
+}
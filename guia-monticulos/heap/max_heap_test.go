@@ -1,6 +1,7 @@
 package heap
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,7 @@ func TestMaxHeapRemoveMaxVacio(t *testing.T) {
 	m := NewMaxHeap[int]()
 	_, err := m.Remove()
 	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, ErrHeapVacio)
 }
 
 // Gracias a visualgo.net/en/heap
@@ -61,7 +63,7 @@ func TestMaxHeapCrearInsertarYExtraer(t *testing.T) {
 	// Verificaciones a medida que vamos insertando
 	for i := 0; i < len(secuenciaDeInsercion); i++ {
 		m.Insert(secuenciaDeInsercion[i])
-		assert.Equal(t, ordenEsperadoDespuesDeInsertar[i], m.elements)
+		assert.Equal(t, ordenEsperadoDespuesDeInsertar[i], m.Values())
 	}
 
 	ordenEsperadoDespuesDeEliminar := [][]int{
@@ -79,10 +81,11 @@ func TestMaxHeapCrearInsertarYExtraer(t *testing.T) {
 
 	for i := 0; i < len(secuenciaDeInsercion); i++ {
 		_, err := m.Remove()
-		assert.Equal(t, ordenEsperadoDespuesDeEliminar[i], m.elements)
+		assert.Equal(t, ordenEsperadoDespuesDeEliminar[i], m.Values())
 		assert.NoError(t, err)
 	}
 }
+
 // Test para verificar que el heap contiene todos los elementos del arreglo de entrada.
 func TestNuevoMonticuloMaxDesdeArreglo_ContieneTodosLosElementos(t *testing.T) {
 	arr := []int{3, 1, 6, 5, 2, 4}
@@ -95,6 +98,9 @@ func TestNuevoMonticuloMaxDesdeArreglo_ContieneTodosLosElementos(t *testing.T) {
 func TestNuevoMonticuloMaxDesdeArreglo_PropiedadesMaxHeap(t *testing.T) {
 	arr := []int{3, 1, 6, 5, 2, 4}
 	heap := NuevoMonticuloMaxDesdeArreglo(arr)
+	elementos := heap.Values()
+
+	assert.True(t, heap.IsValid())
 
 	// Verificar que cada padre es mayor o igual a sus hijos
 	for i := 0; i < heap.Size()/2; i++ {
@@ -102,11 +108,11 @@ func TestNuevoMonticuloMaxDesdeArreglo_PropiedadesMaxHeap(t *testing.T) {
 		right := 2*i + 2
 
 		if left < heap.Size() {
-			assert.True(t, heap.compare(heap.elements[i], heap.elements[left]) >= 0, "El padre debe ser mayor o igual que el hijo izquierdo")
+			assert.GreaterOrEqual(t, elementos[i], elementos[left], "El padre debe ser mayor o igual que el hijo izquierdo")
 		}
 
 		if right < heap.Size() {
-			assert.True(t, heap.compare(heap.elements[i], heap.elements[right]) >= 0, "El padre debe ser mayor o igual que el hijo derecho")
+			assert.GreaterOrEqual(t, elementos[i], elementos[right], "El padre debe ser mayor o igual que el hijo derecho")
 		}
 	}
 }
@@ -135,6 +141,29 @@ func TestEnesimoMaximo_Valido(t *testing.T) {
 	assert.Equal(t, 4, tercerMaximo)
 }
 
+// TestEnesimoMaximo_NoModificaElHeap verifica que se trabaja sobre una copia
+func TestEnesimoMaximo_NoModificaElHeap(t *testing.T) {
+	heap := NuevoMonticuloMaxDesdeArreglo([]int{3, 1, 6, 5, 2, 4})
+	antes := heap.Values()
+
+	_, err := EnesimoMaximo(heap, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, antes, heap.Values())
+}
+
+// TestEnesimoMaximo_HeapGrande verifica el resultado contra el arreglo ordenado
+func TestEnesimoMaximo_HeapGrande(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	valores := r.Perm(5000)
+	heap := NuevoMonticuloMaxDesdeArreglo(valores)
+
+	for _, n := range []int{1, 2, 3, 10, 100, 5000} {
+		v, err := EnesimoMaximo(heap, n)
+		assert.NoError(t, err)
+		assert.Equal(t, 5000-n, v)
+	}
+}
+
 // TestEnesimoMaximo_FueraDeRango verifica cuando n está fuera del rango
 func TestEnesimoMaximo_FueraDeRango(t *testing.T) {
 	heap := NewMaxHeap[int]()
@@ -148,6 +177,8 @@ func TestEnesimoMaximo_FueraDeRango(t *testing.T) {
 	// Intentar obtener el 7mo máximo de un heap con solo 6 elementos
 	_, err := EnesimoMaximo(heap, 7)
 	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrFueraDeRango)
+	assert.EqualError(t, err, "n fuera de rango: se pidió n = 7 en un heap de 6 elementos")
 }
 
 // TestEnesimoMaximo_HeapVacio verifica cuando el heap está vacío
@@ -157,6 +188,7 @@ func TestEnesimoMaximo_HeapVacio(t *testing.T) {
 	// Intentar obtener el 1er máximo de un heap vacío
 	_, err := EnesimoMaximo(heap, 1)
 	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrFueraDeRango)
 }
 
 func TestCombinarMonticulos_MinHeapYMinHeap(t *testing.T) {
@@ -176,7 +208,7 @@ func TestCombinarMonticulos_MinHeapYMinHeap(t *testing.T) {
 	combinedHeap := CombinarMonticulos(heap1, heap2)
 
 	// Verificar que el montículo combinado es un min-heap
-	assert.True(t, combinedHeap.compare(combinedHeap.elements[0], combinedHeap.elements[1]) <= 0)
+	assert.True(t, combinedHeap.Compare(combinedHeap.Values()[0], combinedHeap.Values()[1]) <= 0)
 }
 
 func TestCombinarMonticulos_MaxHeapYMaxHeap(t *testing.T) {
@@ -196,7 +228,8 @@ func TestCombinarMonticulos_MaxHeapYMaxHeap(t *testing.T) {
 	combinedHeap := CombinarMonticulos(heap1, heap2)
 
 	// Verificar que el montículo combinado es un max-heap
-	assert.True(t, combinedHeap.compare(combinedHeap.elements[0], combinedHeap.elements[1]) >= 0)
+	assert.Equal(t, HeapDeMaximos, combinedHeap.Kind())
+	assert.GreaterOrEqual(t, combinedHeap.Values()[0], combinedHeap.Values()[1])
 }
 
 func TestCombinarMonticulos_MinHeapYMaxHeap(t *testing.T) {
@@ -217,5 +250,16 @@ func TestCombinarMonticulos_MinHeapYMaxHeap(t *testing.T) {
 
 	// Verificar que el primer elemento del montículo combinado sea menor que el segundo para un min-heap
 	// y mayor para un max-heap
-	assert.True(t, combinedHeap.compare(combinedHeap.elements[0], combinedHeap.elements[1]) <= 0) // Para un min-heap
-}
\ No newline at end of file
+	assert.True(t, combinedHeap.Compare(combinedHeap.Values()[0], combinedHeap.Values()[1]) <= 0) // Para un min-heap
+}
+
+func TestNuevoMonticuloMaxDesdeArreglo_UsaHeapifyDeFloyd(t *testing.T) {
+	arr := []int{3, 1, 6, 5, 2, 4}
+	heap := NuevoMonticuloMaxDesdeArreglo(arr)
+
+	// el 6 ya es mayor que su hijo, el 1 baja al lugar del 5 y el 3 baja dos
+	// niveles, pasando por el lugar del 6 hasta el del 4
+	assert.Equal(t, []int{6, 5, 4, 1, 2, 3}, heap.Values())
+	// el arreglo original no se modifica
+	assert.Equal(t, []int{3, 1, 6, 5, 2, 4}, arr)
+}
@@ -0,0 +1,194 @@
+package heap
+
+import "fmt"
+
+// Iterator es un iterador sobre una secuencia. Cualquier tipo con estos
+// métodos, como los iteradores de binarytree, se puede usar con Merge.
+type Iterator[T any] interface {
+	HasNext() bool
+	Next() (T, error)
+}
+
+// cabeza es el próximo elemento de una de las fuentes de un merge.
+type cabeza[T any] struct {
+	valor  T
+	fuente int
+}
+
+// MergeIterator recorre en orden la fusión de varias secuencias ordenadas.
+// Se obtiene con Merge.
+type MergeIterator[T any] struct {
+	fuentes []Iterator[T]
+	cabezas *Heap[cabeza[T]]
+	// error de una fuente, que se informa en la siguiente llamada a Next
+	err error
+}
+
+// Merge fusiona k secuencias ordenadas en una sola, en O(log k) por
+// elemento. Las fuentes se leen a medida que se avanza, por lo que pueden ser
+// arbitrariamente largas (por ejemplo, archivos que no entran en memoria).
+// A igual prioridad sale primero el elemento de la fuente de menor índice.
+//
+// Uso:
+//
+//	it := heap.Merge(cmp.Compare[int], fuente1, fuente2, fuente3)
+//	for it.HasNext() {
+//		v, err := it.Next()
+//		...
+//	}
+//
+// Parámetros:
+//   - `compare` función de comparación con la que están ordenadas las
+//     fuentes, con la misma convención que NewGenericHeap.
+//   - `fuentes` iteradores ordenados según `compare`.
+//
+// Retorna:
+//   - un iterador sobre la fusión.
+func Merge[T any](compare func(a T, b T) int, fuentes ...Iterator[T]) *MergeIterator[T] {
+	m := &MergeIterator[T]{
+		fuentes: fuentes,
+		cabezas: NewGenericHeap(func(a, b cabeza[T]) int {
+			if c := compare(a.valor, b.valor); c != 0 {
+				return c
+			}
+			return a.fuente - b.fuente
+		}),
+	}
+	for i := range fuentes {
+		m.avanzar(i)
+	}
+
+	return m
+}
+
+// avanzar agrega al heap el próximo elemento de la fuente i, si lo tiene.
+func (m *MergeIterator[T]) avanzar(i int) {
+	if c, ok := m.leer(i); ok {
+		m.cabezas.Insert(c)
+	}
+}
+
+// leer retorna el próximo elemento de la fuente i, o false si no tiene más o
+// si falló.
+func (m *MergeIterator[T]) leer(i int) (cabeza[T], bool) {
+	if m.err != nil || m.fuentes[i] == nil || !m.fuentes[i].HasNext() {
+		return cabeza[T]{}, false
+	}
+	v, err := m.fuentes[i].Next()
+	if err != nil {
+		m.err = fmt.Errorf(Localizar("fusionar: fuente %d: %w", "merge: source %d: %w"), i, err)
+		return cabeza[T]{}, false
+	}
+
+	return cabeza[T]{valor: v, fuente: i}, true
+}
+
+// HasNext indica si quedan elementos (o un error por informar).
+func (m *MergeIterator[T]) HasNext() bool {
+	return m.err != nil || m.cabezas.Size() > 0
+}
+
+// Next retorna el menor de los elementos pendientes.
+//
+// Retorna:
+//   - el elemento.
+//   - el error de una fuente, que corta la fusión, o un error que envuelve a
+//     ErrHeapVacio si no quedan elementos.
+func (m *MergeIterator[T]) Next() (T, error) {
+	if m.err != nil {
+		var cero T
+		return cero, m.err
+	}
+	c, err := m.cabezas.Peek()
+	if err != nil {
+		return c.valor, fmt.Errorf(Localizar("fusionar: %w", "merge: %w"), ErrHeapVacio)
+	}
+	// lo habitual es que la fuente tenga otro elemento, que ocupa el lugar de
+	// la cima con un solo recorrido del heap
+	if siguiente, ok := m.leer(c.fuente); ok {
+		_, _ = m.cabezas.Replace(siguiente)
+	} else {
+		_, _ = m.cabezas.Remove()
+	}
+
+	return c.valor, nil
+}
+
+// UniqueIterator recorre la fusión de varias secuencias ordenadas salteando
+// los duplicados consecutivos. Se obtiene con MergeUnique o MergeUniqueFunc.
+type UniqueIterator[T any] struct {
+	merge *MergeIterator[T]
+	igual func(a T, b T) bool
+	// próximo elemento a entregar, ya leído del merge para saber si existe
+	siguiente T
+	err       error
+	hay       bool
+}
+
+// MergeUnique fusiona k secuencias ordenadas como Merge, pero entrega una
+// sola vez cada grupo de elementos equivalentes según `compare`: el primero
+// del grupo, que es el de la fuente de menor índice. Sirve, por ejemplo, para
+// unir listas de postings de un índice invertido.
+//
+// Uso:
+//
+//	it := heap.MergeUnique(cmp.Compare[int], postings1, postings2)
+//
+// Parámetros:
+//   - `compare` función de comparación con la que están ordenadas las fuentes.
+//   - `fuentes` iteradores ordenados según `compare`.
+//
+// Retorna:
+//   - un iterador sobre la fusión sin duplicados.
+func MergeUnique[T any](compare func(a T, b T) int, fuentes ...Iterator[T]) *UniqueIterator[T] {
+	return MergeUniqueFunc(compare, func(a, b T) bool { return compare(a, b) == 0 }, fuentes...)
+}
+
+// MergeUniqueFunc es como MergeUnique pero decide qué elementos son
+// duplicados con la función `igual`, que solo se aplica a elementos
+// consecutivos de la fusión. Sirve cuando el orden es más grueso que la
+// igualdad, por ejemplo al ordenar documentos por puntaje y considerar
+// duplicados a los que tienen el mismo ID.
+func MergeUniqueFunc[T any](compare func(a T, b T) int, igual func(a T, b T) bool, fuentes ...Iterator[T]) *UniqueIterator[T] {
+	u := &UniqueIterator[T]{merge: Merge(compare, fuentes...), igual: igual}
+	u.leer()
+
+	return u
+}
+
+// leer trae el próximo elemento del merge.
+func (u *UniqueIterator[T]) leer() {
+	u.hay = u.merge.HasNext()
+	if u.hay {
+		u.siguiente, u.err = u.merge.Next()
+	}
+}
+
+// HasNext indica si quedan elementos (o un error por informar).
+func (u *UniqueIterator[T]) HasNext() bool {
+	return u.hay
+}
+
+// Next retorna el próximo elemento distinto del anterior.
+//
+// Retorna:
+//   - el elemento.
+//   - el error de una fuente, que corta la fusión, o un error que envuelve a
+//     ErrHeapVacio si no quedan elementos.
+func (u *UniqueIterator[T]) Next() (T, error) {
+	if !u.hay {
+		var cero T
+		return cero, fmt.Errorf(Localizar("fusionar: %w", "merge: %w"), ErrHeapVacio)
+	}
+	actual, err := u.siguiente, u.err
+	if err != nil {
+		// el merge sigue informando el error, así que no hay que avanzar
+		return actual, err
+	}
+	u.leer()
+	for u.hay && u.err == nil && u.igual(actual, u.siguiente) {
+		u.leer()
+	}
+
+	return actual, nil
+}
@@ -0,0 +1,145 @@
+package heap
+
+import (
+	"cmp"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sliceIterator recorre un slice y opcionalmente falla al llegar a una posición.
+type sliceIterator[T any] struct {
+	valores []T
+	i       int
+	fallaEn int
+}
+
+func iterar[T any](valores ...T) *sliceIterator[T] {
+	return &sliceIterator[T]{valores: valores, fallaEn: -1}
+}
+
+func (s *sliceIterator[T]) HasNext() bool {
+	return s.i < len(s.valores)
+}
+
+func (s *sliceIterator[T]) Next() (T, error) {
+	if s.i == s.fallaEn {
+		var cero T
+		return cero, errors.New("fuente rota")
+	}
+	s.i++
+
+	return s.valores[s.i-1], nil
+}
+
+func drenar[T any](t *testing.T, it Iterator[T]) []T {
+	t.Helper()
+	var r []T
+	for it.HasNext() {
+		v, err := it.Next()
+		assert.NoError(t, err)
+		r = append(r, v)
+	}
+
+	return r
+}
+
+func TestMergeFusionaEnOrden(t *testing.T) {
+	it := Merge(cmp.Compare[int], iterar(1, 4, 7), iterar(2, 5, 8), iterar[int](), iterar(0, 3, 6, 9))
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, drenar[int](t, it))
+	_, err := it.Next()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+}
+
+func TestMergeEsEstablePorFuente(t *testing.T) {
+	type registro struct {
+		clave  int
+		origen string
+	}
+	porClave := func(a, b registro) int { return a.clave - b.clave }
+
+	it := Merge(porClave,
+		iterar(registro{1, "b"}, registro{2, "b"}),
+		iterar(registro{1, "a"}, registro{2, "a"}))
+
+	assert.Equal(t, []registro{{1, "b"}, {1, "a"}, {2, "b"}, {2, "a"}}, drenar[registro](t, it))
+}
+
+func TestMergeSinFuentes(t *testing.T) {
+	assert.False(t, Merge(cmp.Compare[string]).HasNext())
+}
+
+func TestMergeInformaErroresDeLasFuentes(t *testing.T) {
+	rota := iterar(2, 4, 6)
+	rota.fallaEn = 1
+	it := Merge(cmp.Compare[int], iterar(1, 3, 5), rota)
+
+	for _, esperado := range []int{1, 2} {
+		v, err := it.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, esperado, v)
+	}
+	assert.True(t, it.HasNext())
+	_, err := it.Next()
+	assert.EqualError(t, err, "fusionar: fuente 1: fuente rota")
+}
+
+func TestMergeAceptaCualquierIterator(t *testing.T) {
+	var fuente Iterator[int] = iterar(1, 2)
+
+	assert.Equal(t, []int{1, 2}, drenar[int](t, Merge(cmp.Compare[int], fuente)))
+}
+
+func TestMergeUniqueEliminaDuplicados(t *testing.T) {
+	it := MergeUnique(cmp.Compare[int], iterar(1, 3, 3, 7), iterar(3, 4, 7), iterar(1, 9))
+
+	assert.Equal(t, []int{1, 3, 4, 7, 9}, drenar[int](t, it))
+	_, err := it.Next()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+}
+
+func TestMergeUniqueConservaElDeLaPrimeraFuente(t *testing.T) {
+	type posting struct {
+		doc    int
+		fuente string
+	}
+	porDoc := func(a, b posting) int { return a.doc - b.doc }
+
+	it := MergeUnique(porDoc,
+		iterar(posting{1, "a"}, posting{5, "a"}),
+		iterar(posting{1, "b"}, posting{2, "b"}, posting{5, "b"}))
+
+	assert.Equal(t, []posting{{1, "a"}, {2, "b"}, {5, "a"}}, drenar[posting](t, it))
+}
+
+func TestMergeUniqueFuncConIgualdadPropia(t *testing.T) {
+	type doc struct {
+		puntaje int
+		id      string
+	}
+	// ordenados por puntaje descendente; duplicados son los del mismo id
+	porPuntaje := func(a, b doc) int { return b.puntaje - a.puntaje }
+	mismoID := func(a, b doc) bool { return a.id == b.id }
+
+	it := MergeUniqueFunc(porPuntaje, mismoID,
+		iterar(doc{9, "x"}, doc{5, "y"}, doc{5, "z"}),
+		iterar(doc{9, "x"}, doc{5, "z"}))
+
+	assert.Equal(t, []doc{{9, "x"}, {5, "y"}, {5, "z"}}, drenar[doc](t, it))
+}
+
+func TestMergeUniqueInformaErroresDeLasFuentes(t *testing.T) {
+	rota := iterar(2, 2, 4)
+	rota.fallaEn = 2
+
+	it := MergeUnique(cmp.Compare[int], rota)
+
+	v, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+	assert.True(t, it.HasNext())
+	_, err = it.Next()
+	assert.EqualError(t, err, "fusionar: fuente 0: fuente rota")
+}
@@ -1,6 +1,7 @@
 package heap
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,7 @@ func TestMinHeapRemoveMaxVacio(t *testing.T) {
 	m := NewMinHeap[int]()
 	_, err := m.Remove()
 	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, ErrHeapVacio)
 }
 
 // Gracias a visualgo.net/en/heap
@@ -61,7 +63,7 @@ func TestMinHeapCrearInsertarYExtraer(t *testing.T) {
 	// Verificaciones a medida que vamos insertando
 	for i := 0; i < len(secuenciaDeInsercion); i++ {
 		m.Insert(secuenciaDeInsercion[i])
-		assert.Equal(t, ordenEsperadoDespuesDeInsertar[i], m.elements)
+		assert.Equal(t, ordenEsperadoDespuesDeInsertar[i], m.Values())
 	}
 
 	ordenEsperadoDespuesDeEliminar := [][]int{
@@ -79,7 +81,78 @@ func TestMinHeapCrearInsertarYExtraer(t *testing.T) {
 
 	for i := 0; i < len(secuenciaDeInsercion); i++ {
 		_, err := m.Remove()
-		assert.Equal(t, ordenEsperadoDespuesDeEliminar[i], m.elements)
+		assert.Equal(t, ordenEsperadoDespuesDeEliminar[i], m.Values())
 		assert.NoError(t, err)
 	}
 }
+
+func TestNuevoMonticuloMinDesdeArreglo_ContieneTodosLosElementos(t *testing.T) {
+	arr := []int{3, 1, 6, 5, 2, 4}
+	heap := NuevoMonticuloMinDesdeArreglo(arr)
+
+	assert.Equal(t, len(arr), heap.Size())
+	assert.Equal(t, []int{1, 2, 4, 5, 3, 6}, heap.Values())
+}
+
+func TestNuevoMonticuloMinDesdeArreglo_ExtraeEnOrden(t *testing.T) {
+	heap := NuevoMonticuloMinDesdeArreglo([]string{"pera", "ananá", "uva", "banana", "kiwi"})
+
+	assert.Equal(t, []string{"ananá", "banana", "kiwi", "pera", "uva"}, extraerTodos(heap))
+}
+
+func TestNuevoMonticuloMinDesdeArreglo_ArregloVacio(t *testing.T) {
+	heap := NuevoMonticuloMinDesdeArreglo([]int{})
+
+	assert.Equal(t, 0, heap.Size())
+	heap.Insert(2)
+	heap.Insert(1)
+	v, _ := heap.Peek()
+	assert.Equal(t, 1, v)
+}
+
+func TestEnesimoMinimo_EnHeapDeMaximos(t *testing.T) {
+	heap := NuevoMonticuloMaxDesdeArreglo([]int{3, 1, 6, 5, 2, 4})
+	antes := heap.Values()
+
+	for n := 1; n <= 6; n++ {
+		v, err := EnesimoMinimo(heap, n)
+		assert.NoError(t, err)
+		assert.Equal(t, n, v)
+	}
+	assert.Equal(t, antes, heap.Values())
+}
+
+func TestEnesimoMinimo_EnHeapDeMinimos(t *testing.T) {
+	heap := NuevoMonticuloMinDesdeArreglo([]int{30, 10, 20})
+
+	v, err := EnesimoMinimo(heap, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, v)
+}
+
+func TestEnesimoMinimo_HeapDeMinimosGrande(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	heap := NuevoMonticuloMinDesdeArreglo(r.Perm(5000))
+	antes := heap.Values()
+
+	for _, n := range []int{1, 2, 3, 10, 100, 5000} {
+		v, err := EnesimoMinimo(heap, n)
+		assert.NoError(t, err)
+		assert.Equal(t, n-1, v)
+	}
+	assert.Equal(t, antes, heap.Values())
+}
+
+func TestEnesimoMinimo_FueraDeRangoYNil(t *testing.T) {
+	heap := NuevoMonticuloMaxDesdeArreglo([]int{1, 2})
+
+	_, err := EnesimoMinimo(heap, 3)
+	assert.ErrorIs(t, err, ErrFueraDeRango)
+	assert.EqualError(t, err, "n fuera de rango: se pidió n = 3 en un heap de 2 elementos")
+	_, err = EnesimoMinimo(heap, 0)
+	assert.ErrorIs(t, err, ErrFueraDeRango)
+
+	_, err = EnesimoMinimo[int](nil, 1)
+	assert.ErrorIs(t, err, ErrHeapNil)
+	assert.EqualError(t, err, "enésimo mínimo: heap nil")
+}
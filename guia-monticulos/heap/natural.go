@@ -0,0 +1,125 @@
+package heap
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NaturalCompare compara dos strings en orden natural: las secuencias de
+// dígitos se comparan por su valor numérico, de modo que "item2" queda antes
+// que "item10". El resto de los caracteres se compara por su código. Se puede
+// pasar directamente a NewGenericHeap.
+//
+// Uso:
+//
+//	archivos := heap.NewGenericHeap(heap.NaturalCompare)
+//
+// Retorna:
+//   - un valor negativo si `a` va antes que `b`, cero si son iguales y un
+//     valor positivo si `a` va después.
+//
+// Los números no tienen límite de largo. Si dos strings solo difieren en los
+// ceros a la izquierda ("a01" y "a1") va antes el que tiene menos, para que
+// el orden sea total.
+func NaturalCompare(a, b string) int {
+	return compararNatural(a, b, false)
+}
+
+// NaturalCompareFold es como NaturalCompare pero sin distinguir mayúsculas de
+// minúsculas. Si dos strings solo difieren en eso, se desempata por
+// NaturalCompare.
+func NaturalCompareFold(a, b string) int {
+	return compararNatural(a, b, true)
+}
+
+// CompareFold compara dos strings sin distinguir mayúsculas de minúsculas,
+// con desempate por el orden habitual para que el orden sea total.
+func CompareFold(a, b string) int {
+	desempate := 0
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if c := compararRunas(ra, rb, true); c != 0 {
+			return c
+		}
+		if desempate == 0 {
+			desempate = compararRunas(ra, rb, false)
+		}
+		a, b = a[na:], b[nb:]
+	}
+	if c := len(a) - len(b); c != 0 {
+		return signo(c)
+	}
+
+	return desempate
+}
+
+func compararNatural(a, b string, fold bool) int {
+	desempate := 0
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if esDigito(a[i]) && esDigito(b[j]) {
+			finA, finB := finDeDigitos(a, i), finDeDigitos(b, j)
+			numA := strings.TrimLeft(a[i:finA], "0")
+			numB := strings.TrimLeft(b[j:finB], "0")
+			// sin ceros a la izquierda, el número más largo es el mayor
+			if c := len(numA) - len(numB); c != 0 {
+				return signo(c)
+			}
+			if c := strings.Compare(numA, numB); c != 0 {
+				return c
+			}
+			if desempate == 0 {
+				desempate = signo((finA - i) - (finB - j))
+			}
+			i, j = finA, finB
+			continue
+		}
+		ra, na := utf8.DecodeRuneInString(a[i:])
+		rb, nb := utf8.DecodeRuneInString(b[j:])
+		if c := compararRunas(ra, rb, fold); c != 0 {
+			return c
+		}
+		if desempate == 0 {
+			desempate = compararRunas(ra, rb, false)
+		}
+		i, j = i+na, j+nb
+	}
+	if c := (len(a) - i) - (len(b) - j); c != 0 {
+		return signo(c)
+	}
+
+	return desempate
+}
+
+func compararRunas(a, b rune, fold bool) int {
+	if fold {
+		a, b = unicode.ToLower(a), unicode.ToLower(b)
+	}
+
+	return signo(int(a) - int(b))
+}
+
+func esDigito(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func finDeDigitos(s string, i int) int {
+	for i < len(s) && esDigito(s[i]) {
+		i++
+	}
+
+	return i
+}
+
+func signo(x int) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,64 @@
+package heap
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNaturalCompareOrdenaArchivos(t *testing.T) {
+	archivos := []string{"item10.txt", "item2.txt", "item1.txt", "item02.txt", "item.txt", "itemA.txt", "item100.txt"}
+
+	slices.SortFunc(archivos, NaturalCompare)
+
+	assert.Equal(t, []string{"item.txt", "item1.txt", "item2.txt", "item02.txt", "item10.txt", "item100.txt", "itemA.txt"}, archivos)
+}
+
+func TestNaturalCompareCasos(t *testing.T) {
+	casos := []struct {
+		a, b     string
+		esperado int
+	}{
+		{"", "", 0},
+		{"a", "a", 0},
+		{"a2", "a10", -1},
+		{"a10", "a2", 1},
+		{"a1b2", "a1b10", -1},
+		{"v1.9", "v1.10", -1},
+		{"x99999999999999999999999", "x100000000000000000000000", -1},
+		{"a01", "a1", 1},
+		{"a", "a1", -1},
+		{"B", "a", -1},
+		{"año2", "año10", -1},
+	}
+	for _, c := range casos {
+		assert.Equal(t, c.esperado, NaturalCompare(c.a, c.b), "%q vs %q", c.a, c.b)
+	}
+}
+
+func TestNaturalCompareFold(t *testing.T) {
+	assert.Negative(t, NaturalCompareFold("a", "B"))
+	assert.Negative(t, NaturalCompareFold("Foto2", "foto10"))
+	// solo difieren en mayúsculas: se desempata por NaturalCompare
+	assert.Negative(t, NaturalCompareFold("Foto1", "foto1"))
+	assert.Zero(t, NaturalCompareFold("foto1", "foto1"))
+}
+
+func TestCompareFold(t *testing.T) {
+	assert.Negative(t, CompareFold("apple", "Banana"))
+	assert.Negative(t, CompareFold("Ñandú", "ñandúes"))
+	assert.Negative(t, CompareFold("ABC", "abc"))
+	assert.Zero(t, CompareFold("abc", "abc"))
+	// no es orden natural
+	assert.Negative(t, CompareFold("item10", "item2"))
+}
+
+func TestNaturalCompareEnHeap(t *testing.T) {
+	h := NewGenericHeap(NaturalCompareFold)
+	for _, s := range []string{"Cap10", "cap9", "CAP1", "cap1"} {
+		h.Insert(s)
+	}
+
+	assert.Equal(t, []string{"CAP1", "cap1", "cap9", "Cap10"}, extraerTodos(h))
+}
@@ -0,0 +1,126 @@
+package heap
+
+// Observador recibe los eventos internos de un heap: cada comparación e
+// intercambio de los reacomodos, y cada inserción y extracción. Permite
+// armar visualizadores paso a paso o correctores sin modificar el heap. Los
+// métodos se llaman durante la operación, así que no deben modificar el
+// heap. ObservadorFuncs implementa la interfaz a partir de funciones
+// sueltas.
+//
+// Con Insert, Remove y Replace los eventos alcanzan para reconstruir el
+// arreglo interno paso a paso. Las demás operaciones que modifican el heap
+// (Delete, Update, RemoveWhere, Clear, etc.) notifican las comparaciones e
+// intercambios de sus reacomodos, pero no los demás movimientos.
+type Observador[T any] interface {
+	// OnCompare se llama después de cada comparación de un reacomodo o una
+	// búsqueda, con su resultado.
+	OnCompare(a T, b T, resultado int)
+	// OnSwap se llama después de intercambiar las posiciones i y j.
+	OnSwap(i, j int)
+	// OnInsert se llama con el elemento ya agregado al final del arreglo y
+	// antes de reacomodarlo. Replace lo llama con el elemento nuevo, que
+	// ocupa la raíz.
+	OnInsert(element T)
+	// OnRemove se llama con la cima extraída, cuando el último elemento ya
+	// pasó a la raíz y antes de reacomodarlo. Replace lo llama con la cima
+	// que reemplaza.
+	OnRemove(element T)
+}
+
+// ObservadorFuncs es un Observador hecho de funciones. Las funciones nil se
+// ignoran, así que alcanza con completar los eventos que interesan.
+//
+// Uso:
+//
+//	h.Observe(heap.ObservadorFuncs[int]{
+//		Swap: func(i, j int) { fmt.Println("intercambio", i, j) },
+//	})
+type ObservadorFuncs[T any] struct {
+	Compare func(a T, b T, resultado int)
+	Swap    func(i, j int)
+	Insert  func(element T)
+	Remove  func(element T)
+}
+
+func (o ObservadorFuncs[T]) OnCompare(a T, b T, resultado int) {
+	if o.Compare != nil {
+		o.Compare(a, b, resultado)
+	}
+}
+
+func (o ObservadorFuncs[T]) OnSwap(i, j int) {
+	if o.Swap != nil {
+		o.Swap(i, j)
+	}
+}
+
+func (o ObservadorFuncs[T]) OnInsert(element T) {
+	if o.Insert != nil {
+		o.Insert(element)
+	}
+}
+
+func (o ObservadorFuncs[T]) OnRemove(element T) {
+	if o.Remove != nil {
+		o.Remove(element)
+	}
+}
+
+// observadorRegistrado es un observador junto con el identificador que usa
+// la función para darlo de baja.
+type observadorRegistrado[T any] struct {
+	id         int
+	observador Observador[T]
+}
+
+// Observe registra un observador que recibe los eventos internos del heap a
+// partir de ahora. Se pueden registrar varios; se notifican en el orden en
+// que se registraron. Las copias del heap no heredan los observadores.
+//
+// Uso:
+//
+//	dejarDeObservar := h.Observe(visualizador)
+//	defer dejarDeObservar()
+//
+// Parámetros:
+//   - `o` observador a registrar.
+//
+// Retorna:
+//   - una función que da de baja al observador. Llamarla más de una vez no
+//     tiene efecto.
+//
+// Si `o` es nil o el heap es nil se produce un panic.
+func (m *Heap[T]) Observe(o Observador[T]) func() {
+	if m == nil {
+		panic(Localizar("heap: no se puede observar un heap nil", "heap: cannot observe a nil heap"))
+	}
+	if o == nil {
+		panic(Localizar("heap: el observador no puede ser nil", "heap: observer must not be nil"))
+	}
+	m.guardia.entrar("Observe")
+	defer m.guardia.salir()
+	id := 0
+	if n := len(m.observadores); n > 0 {
+		id = m.observadores[n-1].id + 1
+	}
+	m.observadores = append(m.observadores, observadorRegistrado[T]{id: id, observador: o})
+
+	// los identificadores son únicos entre los observadores registrados;
+	// dado de baja uno, su identificador se puede reutilizar, así que la
+	// función solo actúa la primera vez
+	dadoDeBaja := false
+	return func() {
+		if dadoDeBaja {
+			return
+		}
+		dadoDeBaja = true
+		m.guardia.entrar("Observe")
+		defer m.guardia.salir()
+		for i, r := range m.observadores {
+			if r.id == id {
+				m.observadores = append(m.observadores[:i:i], m.observadores[i+1:]...)
+				return
+			}
+		}
+	}
+}
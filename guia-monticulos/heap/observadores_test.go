@@ -0,0 +1,103 @@
+package heap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// reconstructor arma una copia del arreglo interno a partir de los eventos,
+// como lo haría un visualizador.
+type reconstructor struct {
+	arreglo []int
+	eventos []string
+}
+
+func (r *reconstructor) OnCompare(a, b int, resultado int) {
+	r.eventos = append(r.eventos, fmt.Sprintf("compare %d %d", a, b))
+}
+
+func (r *reconstructor) OnSwap(i, j int) {
+	r.arreglo[i], r.arreglo[j] = r.arreglo[j], r.arreglo[i]
+	r.eventos = append(r.eventos, fmt.Sprintf("swap %d %d", i, j))
+}
+
+func (r *reconstructor) OnInsert(v int) {
+	r.arreglo = append(r.arreglo, v)
+	r.eventos = append(r.eventos, fmt.Sprintf("insert %d", v))
+}
+
+func (r *reconstructor) OnRemove(v int) {
+	r.arreglo[0] = r.arreglo[len(r.arreglo)-1]
+	r.arreglo = r.arreglo[:len(r.arreglo)-1]
+	r.eventos = append(r.eventos, fmt.Sprintf("remove %d", v))
+}
+
+func TestObserveNotificaLosEventosEnOrden(t *testing.T) {
+	h := NewMinHeap[int]()
+	r := &reconstructor{}
+	h.Observe(r)
+
+	h.Insert(5)
+	h.Insert(3)
+	_, _ = h.Remove()
+
+	assert.Equal(t, []string{
+		"insert 5",
+		"insert 3",
+		"compare 3 5",
+		"swap 1 0",
+		"remove 3",
+	}, r.eventos)
+}
+
+func TestObservePermiteReconstruirElArreglo(t *testing.T) {
+	h := NewMaxHeap[int]()
+	r := &reconstructor{}
+	h.Observe(r)
+
+	for _, v := range []int{44, 29, 58, 2, 98, 11, 65, 3, 68, 99} {
+		h.Insert(v)
+		assert.Equal(t, h.Values(), r.arreglo)
+	}
+	for h.Size() > 0 {
+		_, _ = h.Remove()
+		assert.Equal(t, h.Values(), r.arreglo)
+	}
+}
+
+func TestObservadorFuncsYBaja(t *testing.T) {
+	h := NewMinHeap[int]()
+	var intercambios, extraidos int
+	baja := h.Observe(ObservadorFuncs[int]{
+		Swap:   func(i, j int) { intercambios++ },
+		Remove: func(int) { extraidos++ },
+	})
+	otro := &reconstructor{}
+	h.Observe(otro)
+
+	h.Insert(2)
+	h.Insert(1)
+	_, _ = h.Remove()
+	assert.Equal(t, 1, intercambios)
+	assert.Equal(t, 1, extraidos)
+
+	baja()
+	baja()
+	h.Insert(0)
+	h.Insert(-1)
+	assert.Equal(t, 1, intercambios)
+	assert.Len(t, otro.arreglo, 3)
+
+	// las copias no heredan los observadores
+	copia := h.Clone()
+	copia.Insert(-2)
+	assert.Len(t, otro.arreglo, 3)
+}
+
+func TestObserveNilProducePanic(t *testing.T) {
+	var nulo *Heap[int]
+	assert.Panics(t, func() { nulo.Observe(&reconstructor{}) })
+	assert.Panics(t, func() { NewMinHeap[int]().Observe(nil) })
+}
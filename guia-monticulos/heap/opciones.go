@@ -0,0 +1,98 @@
+package heap
+
+import "cmp"
+
+// Option es una opción de configuración para NewHeap.
+type Option[T any] func(*configuracion[T])
+
+// configuracion reúne lo que se pide con las opciones de NewHeap.
+type configuracion[T any] struct {
+	compare   func(a T, b T) int
+	tipo      TipoHeap
+	capacidad int
+	elementos []T
+}
+
+// WithComparator indica la función de comparación del heap, con la misma
+// convención que NewGenericHeap.
+func WithComparator[T any](comp func(a T, b T) int) Option[T] {
+	return func(c *configuracion[T]) {
+		if comp == nil {
+			panic(Localizar("heap: la función de comparación no puede ser nil", "heap: comparison function must not be nil"))
+		}
+		c.compare, c.tipo = comp, HeapPersonalizado
+	}
+}
+
+// WithMinOrdering indica que el heap es de mínimos según el orden natural,
+// como NewMinHeap.
+func WithMinOrdering[T Ordered]() Option[T] {
+	return func(c *configuracion[T]) {
+		c.compare, c.tipo = cmp.Compare[T], HeapDeMinimos
+	}
+}
+
+// WithMaxOrdering indica que el heap es de máximos según el orden natural,
+// como NewMaxHeap.
+func WithMaxOrdering[T Ordered]() Option[T] {
+	return func(c *configuracion[T]) {
+		c.compare, c.tipo = func(a T, b T) int { return cmp.Compare(b, a) }, HeapDeMaximos
+	}
+}
+
+// WithCapacity reserva lugar para `n` elementos, como
+// NewMinHeapWithCapacity. Un valor negativo se toma como cero.
+func WithCapacity[T any](n int) Option[T] {
+	return func(c *configuracion[T]) {
+		c.capacidad = max(n, 0)
+	}
+}
+
+// WithElements carga el heap con los elementos del arreglo usando heapify en
+// O(n), como NuevoMonticuloDesdeArregloConComparador. El arreglo se copia.
+// Si se usa más de una vez, los elementos se acumulan.
+func WithElements[T any](arr []T) Option[T] {
+	return func(c *configuracion[T]) {
+		c.elementos = append(c.elementos, arr...)
+	}
+}
+
+// NewHeap crea un heap a partir de opciones. Reúne en una sola función lo
+// que hacen NewMinHeap, NewMaxHeap, NewGenericHeap, los constructores con
+// capacidad y los que parten de un arreglo, y admite agregar opciones nuevas
+// sin multiplicar los constructores. Las opciones se aplican en orden, así
+// que si dos indican el orden gana la última.
+//
+// Uso:
+//
+//	h := heap.NewHeap(heap.WithMaxOrdering[int](), heap.WithElements(datos), heap.WithCapacity[int](1000))
+//	g := heap.NewHeap(heap.WithComparator(func(a, b Tarea) int { return a.Vence.Compare(b.Vence) }))
+//
+// Parámetros:
+//   - `opts` opciones. Una de WithComparator, WithMinOrdering o
+//     WithMaxOrdering es obligatoria.
+//
+// Retorna:
+//   - un puntero al heap.
+//
+// Si ninguna opción indica el orden se produce un panic.
+func NewHeap[T any](opts ...Option[T]) *Heap[T] {
+	var c configuracion[T]
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.compare == nil {
+		panic(Localizar("heap: falta indicar el orden con WithComparator, WithMinOrdering o WithMaxOrdering",
+			"heap: the ordering must be set with WithComparator, WithMinOrdering or WithMaxOrdering"))
+	}
+
+	heap := &Heap[T]{
+		compare:  c.compare,
+		elements: make([]T, 0, max(c.capacidad, len(c.elementos))),
+		tipo:     c.tipo,
+	}
+	heap.elements = append(heap.elements, c.elementos...)
+	heap.heapify()
+
+	return heap
+}
@@ -0,0 +1,52 @@
+package heap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHeapConOrdenNatural(t *testing.T) {
+	minimos := NewHeap(WithMinOrdering[int](), WithElements([]int{5, 1, 3}))
+	assert.Equal(t, "heap de mínimos [1 5 3]", minimos.String())
+
+	maximos := NewHeap(WithMaxOrdering[int](), WithElements([]int{5, 1, 3}))
+	assert.Equal(t, []int{5, 3, 1}, extraerTodos(maximos))
+}
+
+func TestNewHeapConComparador(t *testing.T) {
+	porLargo := NewHeap(WithComparator(func(a, b string) int { return len(a) - len(b) }))
+	porLargo.Insert("ccc")
+	porLargo.Insert("a")
+	porLargo.Insert("bb")
+
+	assert.Equal(t, []string{"a", "bb", "ccc"}, extraerTodos(porLargo))
+	assert.Equal(t, "heap []", porLargo.String())
+}
+
+func TestNewHeapConCapacidadYElementos(t *testing.T) {
+	h := NewHeap(WithMinOrdering[int](), WithCapacity[int](10), WithElements([]int{3, 2}), WithElements([]int{1}))
+	assert.Equal(t, 10, cap(h.elements))
+	assert.Equal(t, []int{1, 2, 3}, extraerTodos(h))
+
+	// la capacidad nunca es menor que la cantidad de elementos
+	h = NewHeap(WithMinOrdering[int](), WithCapacity[int](1), WithElements([]int{3, 2}))
+	assert.Equal(t, 2, h.Size())
+
+	// el arreglo se copia
+	datos := []int{2, 1}
+	h = NewHeap(WithMinOrdering[int](), WithElements(datos))
+	datos[0] = 99
+	assert.Equal(t, []int{1, 2}, h.Values())
+}
+
+func TestNewHeapGanaLaUltimaOpcionDeOrden(t *testing.T) {
+	h := NewHeap(WithMinOrdering[int](), WithMaxOrdering[int](), WithElements([]int{1, 2}))
+	assert.Equal(t, "heap de máximos [2 1]", h.String())
+}
+
+func TestNewHeapSinOrdenProducePanic(t *testing.T) {
+	assert.Panics(t, func() { NewHeap[int]() })
+	assert.Panics(t, func() { NewHeap(WithCapacity[int](5)) })
+	assert.Panics(t, func() { NewHeap(WithComparator[int](nil)) })
+}
@@ -0,0 +1,227 @@
+// Package orderbook implementa un libro de órdenes límite como el de una
+// bolsa: las compras se guardan en un heap de máximos por precio y las ventas
+// en uno de mínimos, de modo que la mejor oferta de cada lado está siempre en
+// la cima. Ambos heaps son indexados para poder cancelar órdenes por ID.
+package orderbook
+
+import (
+	"errors"
+	"fmt"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// Lado indica si una orden es de compra o de venta.
+type Lado int
+
+const (
+	// Compra es una orden de compra (bid).
+	Compra Lado = iota
+	// Venta es una orden de venta (ask).
+	Venta
+)
+
+// String retorna el nombre del lado.
+func (l Lado) String() string {
+	if l == Compra {
+		return "compra"
+	}
+
+	return "venta"
+}
+
+var (
+	// ErrOrdenInvalida indica una orden con cantidad o precio no positivos.
+	ErrOrdenInvalida = errors.New("orden inválida")
+	// ErrLadoInvalido indica una orden cuyo lado no es Compra ni Venta.
+	ErrLadoInvalido = errors.New("lado inválido")
+	// ErrOrdenDuplicada indica que ya hay una orden con el mismo ID en el libro.
+	ErrOrdenDuplicada = errors.New("orden duplicada")
+)
+
+// Orden es una orden límite. El precio se expresa en unidades enteras (por
+// ejemplo centavos) para evitar errores de redondeo.
+type Orden struct {
+	ID       string
+	Lado     Lado
+	Precio   int64
+	Cantidad int
+}
+
+// Operacion es un cruce entre una orden de compra y una de venta.
+type Operacion struct {
+	Compra   string
+	Venta    string
+	Precio   int64
+	Cantidad int
+}
+
+// pendiente es una orden en el libro con su orden de llegada, que define la
+// prioridad entre órdenes del mismo precio.
+type pendiente struct {
+	Orden
+	llegada int
+}
+
+// OrderBook es un libro de órdenes. No es seguro usarlo desde varias goroutines.
+type OrderBook struct {
+	compras *heap.HeapIndexado[string, pendiente]
+	ventas  *heap.HeapIndexado[string, pendiente]
+	llegada int
+}
+
+// New crea un libro de órdenes vacío.
+//
+// Uso:
+//
+//	libro := orderbook.New()
+//
+// Retorna:
+//   - un puntero al libro.
+func New() *OrderBook {
+	return &OrderBook{
+		// la mejor compra es la de mayor precio; a igual precio, la más antigua
+		compras: heap.NewHeapIndexado[string](func(a, b pendiente) int {
+			if a.Precio != b.Precio {
+				return compararPrecios(b.Precio, a.Precio)
+			}
+			return a.llegada - b.llegada
+		}),
+		// la mejor venta es la de menor precio; a igual precio, la más antigua
+		ventas: heap.NewHeapIndexado[string](func(a, b pendiente) int {
+			if a.Precio != b.Precio {
+				return compararPrecios(a.Precio, b.Precio)
+			}
+			return a.llegada - b.llegada
+		}),
+	}
+}
+
+func compararPrecios(a, b int64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+
+	return 0
+}
+
+// Submit agrega una orden al libro. Primero se cruza con las órdenes del lado
+// contrario cuyo precio sea compatible, empezando por la mejor, al precio de
+// la orden que ya estaba en el libro; lo que no se cruza queda pendiente.
+//
+// Uso:
+//
+//	ops, err := libro.Submit(orderbook.Orden{ID: "c1", Lado: orderbook.Compra, Precio: 10050, Cantidad: 3})
+//
+// Parámetros:
+//   - `o` orden a agregar.
+//
+// Retorna:
+//   - las operaciones realizadas, en orden.
+//   - un error que envuelve a ErrLadoInvalido, a ErrOrdenInvalida o a
+//     ErrOrdenDuplicada; en ese caso el libro no se modifica.
+func (b *OrderBook) Submit(o Orden) ([]Operacion, error) {
+	if o.Lado != Compra && o.Lado != Venta {
+		return nil, fmt.Errorf("%w: %s: %d", ErrLadoInvalido, o.ID, o.Lado)
+	}
+	if o.Cantidad <= 0 || o.Precio <= 0 {
+		return nil, fmt.Errorf("%w: %s: precio %d, cantidad %d", ErrOrdenInvalida, o.ID, o.Precio, o.Cantidad)
+	}
+	if b.compras.Contains(o.ID) || b.ventas.Contains(o.ID) {
+		return nil, fmt.Errorf("%w: %s", ErrOrdenDuplicada, o.ID)
+	}
+
+	propio, contrario := b.compras, b.ventas
+	if o.Lado == Venta {
+		propio, contrario = b.ventas, b.compras
+	}
+
+	var operaciones []Operacion
+	for o.Cantidad > 0 && contrario.Size() > 0 {
+		id, mejor, _ := contrario.Peek()
+		if !cruza(o, mejor.Orden) {
+			break
+		}
+		cantidad := min(o.Cantidad, mejor.Cantidad)
+		op := Operacion{Compra: o.ID, Venta: id, Precio: mejor.Precio, Cantidad: cantidad}
+		if o.Lado == Venta {
+			op.Compra, op.Venta = id, o.ID
+		}
+		operaciones = append(operaciones, op)
+
+		o.Cantidad -= cantidad
+		mejor.Cantidad -= cantidad
+		if mejor.Cantidad == 0 {
+			_, _, _ = contrario.Remove()
+		} else {
+			_ = contrario.Update(id, mejor)
+		}
+	}
+
+	if o.Cantidad > 0 {
+		b.llegada++
+		_ = propio.Insert(o.ID, pendiente{Orden: o, llegada: b.llegada})
+	}
+
+	return operaciones, nil
+}
+
+// cruza indica si una orden entrante se puede cruzar con una del lado contrario.
+func cruza(entrante, enLibro Orden) bool {
+	if entrante.Lado == Compra {
+		return entrante.Precio >= enLibro.Precio
+	}
+
+	return entrante.Precio <= enLibro.Precio
+}
+
+// Cancel retira una orden pendiente del libro.
+//
+// Retorna:
+//   - la orden con la cantidad que quedaba pendiente.
+//   - false si no hay una orden pendiente con ese ID.
+func (b *OrderBook) Cancel(id string) (Orden, bool) {
+	if p, err := b.compras.Delete(id); err == nil {
+		return p.Orden, true
+	}
+	if p, err := b.ventas.Delete(id); err == nil {
+		return p.Orden, true
+	}
+
+	return Orden{}, false
+}
+
+// BestBid retorna la orden de compra de mayor precio.
+//
+// Retorna:
+//   - la orden.
+//   - false si no hay compras pendientes.
+func (b *OrderBook) BestBid() (Orden, bool) {
+	return mejor(b.compras)
+}
+
+// BestAsk retorna la orden de venta de menor precio.
+//
+// Retorna:
+//   - la orden.
+//   - false si no hay ventas pendientes.
+func (b *OrderBook) BestAsk() (Orden, bool) {
+	return mejor(b.ventas)
+}
+
+func mejor(lado *heap.HeapIndexado[string, pendiente]) (Orden, bool) {
+	_, p, err := lado.Peek()
+	if err != nil {
+		return Orden{}, false
+	}
+
+	return p.Orden, true
+}
+
+// Len retorna la cantidad de órdenes pendientes de cada lado.
+func (b *OrderBook) Len() (compras, ventas int) {
+	return b.compras.Size(), b.ventas.Size()
+}
@@ -0,0 +1,110 @@
+package orderbook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func enviar(t *testing.T, b *OrderBook, o Orden) []Operacion {
+	t.Helper()
+	ops, err := b.Submit(o)
+	assert.NoError(t, err)
+
+	return ops
+}
+
+func TestOrdenesSinCruceQuedanPendientes(t *testing.T) {
+	b := New()
+	enviar(t, b, Orden{"c1", Compra, 99, 5})
+	enviar(t, b, Orden{"c2", Compra, 100, 1})
+	enviar(t, b, Orden{"v1", Venta, 102, 2})
+	enviar(t, b, Orden{"v2", Venta, 101, 4})
+
+	bid, ok := b.BestBid()
+	assert.True(t, ok)
+	assert.Equal(t, "c2", bid.ID)
+	ask, ok := b.BestAsk()
+	assert.True(t, ok)
+	assert.Equal(t, "v2", ask.ID)
+	compras, ventas := b.Len()
+	assert.Equal(t, 2, compras)
+	assert.Equal(t, 2, ventas)
+}
+
+func TestCruceAlPrecioDelLibroYPrioridadPorLlegada(t *testing.T) {
+	b := New()
+	enviar(t, b, Orden{"v1", Venta, 101, 2})
+	enviar(t, b, Orden{"v2", Venta, 100, 3})
+	enviar(t, b, Orden{"v3", Venta, 100, 3})
+
+	ops := enviar(t, b, Orden{"c1", Compra, 101, 7})
+
+	assert.Equal(t, []Operacion{
+		{Compra: "c1", Venta: "v2", Precio: 100, Cantidad: 3},
+		{Compra: "c1", Venta: "v3", Precio: 100, Cantidad: 3},
+		{Compra: "c1", Venta: "v1", Precio: 101, Cantidad: 1},
+	}, ops)
+	ask, _ := b.BestAsk()
+	assert.Equal(t, Orden{"v1", Venta, 101, 1}, ask)
+	_, ok := b.BestBid()
+	assert.False(t, ok)
+}
+
+func TestVentaCruzaConComprasYElRestoQuedaPendiente(t *testing.T) {
+	b := New()
+	enviar(t, b, Orden{"c1", Compra, 100, 2})
+	enviar(t, b, Orden{"c2", Compra, 98, 2})
+
+	ops := enviar(t, b, Orden{"v1", Venta, 99, 5})
+
+	assert.Equal(t, []Operacion{{Compra: "c1", Venta: "v1", Precio: 100, Cantidad: 2}}, ops)
+	ask, _ := b.BestAsk()
+	assert.Equal(t, Orden{"v1", Venta, 99, 3}, ask)
+	bid, _ := b.BestBid()
+	assert.Equal(t, "c2", bid.ID)
+}
+
+func TestCancel(t *testing.T) {
+	b := New()
+	enviar(t, b, Orden{"c1", Compra, 100, 2})
+	enviar(t, b, Orden{"c2", Compra, 99, 2})
+	enviar(t, b, Orden{"v1", Venta, 105, 1})
+
+	o, ok := b.Cancel("c1")
+	assert.True(t, ok)
+	assert.Equal(t, Orden{"c1", Compra, 100, 2}, o)
+	bid, _ := b.BestBid()
+	assert.Equal(t, "c2", bid.ID)
+
+	_, ok = b.Cancel("v1")
+	assert.True(t, ok)
+	_, ok = b.Cancel("v1")
+	assert.False(t, ok)
+
+	assert.Empty(t, enviar(t, b, Orden{"v2", Venta, 100, 1}))
+}
+
+func TestSubmitInvalido(t *testing.T) {
+	b := New()
+	enviar(t, b, Orden{"c1", Compra, 100, 2})
+
+	_, err := b.Submit(Orden{"c1", Venta, 100, 1})
+	assert.ErrorIs(t, err, ErrOrdenDuplicada)
+	_, err = b.Submit(Orden{"c3", Compra, 100, 0})
+	assert.ErrorIs(t, err, ErrOrdenInvalida)
+	assert.EqualError(t, err, "orden inválida: c3: precio 100, cantidad 0")
+}
+
+func TestSubmitConLadoInvalido(t *testing.T) {
+	b := New()
+	enviar(t, b, Orden{"c1", Compra, 100, 2})
+
+	_, err := b.Submit(Orden{"x1", Lado(2), 90, 1})
+	assert.ErrorIs(t, err, ErrLadoInvalido)
+	assert.EqualError(t, err, "lado inválido: x1: 2")
+	// la orden no se cruzó ni quedó en el libro
+	assert.Empty(t, enviar(t, b, Orden{"v1", Venta, 110, 1}))
+	_, ok := b.Cancel("x1")
+	assert.False(t, ok)
+}
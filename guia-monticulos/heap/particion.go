@@ -0,0 +1,51 @@
+package heap
+
+import "fmt"
+
+// Partition reparte los elementos de un heap en k heaps con la misma función
+// de comparación, según la categoría que les asigne `clasificar`. Recorre el
+// heap una sola vez y arma cada destino con heapify, así que es O(n) en total.
+// El heap original no se modifica.
+//
+// Uso:
+//
+//	porCola := heap.Partition(trabajos, func(t Trabajo) int { return t.Cola }, 3)
+//
+// Parámetros:
+//   - `h` heap a repartir.
+//   - `clasificar` función que retorna la categoría de cada elemento, entre
+//     0 y k-1.
+//   - `k` cantidad de categorías.
+//
+// Retorna:
+//   - k heaps, donde el de índice i tiene los elementos de la categoría i.
+//     Si `h` es nil retorna nil.
+//
+// Si k es menor que 1 o `clasificar` retorna una categoría fuera de rango se
+// produce un panic, igual que al indexar un slice fuera de rango.
+func Partition[T any](h *Heap[T], clasificar func(T) int, k int) []*Heap[T] {
+	if k < 1 {
+		panic(fmt.Sprintf(Localizar("heap: partition: k debe ser positivo y es %d", "heap: partition: k must be positive, got %d"), k))
+	}
+	if h == nil {
+		return nil
+	}
+
+	destinos := make([]*Heap[T], k)
+	for i := range destinos {
+		destinos[i] = &Heap[T]{compare: h.compare, elements: make([]T, 0), tipo: h.tipo}
+	}
+	for _, e := range h.Values() {
+		c := clasificar(e)
+		if c < 0 || c >= k {
+			panic(fmt.Sprintf(Localizar("heap: partition: categoría %d fuera de rango [0, %d) para %v",
+				"heap: partition: category %d out of range [0, %d) for %v"), c, k, e))
+		}
+		destinos[c].elements = append(destinos[c].elements, e)
+	}
+	for _, d := range destinos {
+		d.heapify()
+	}
+
+	return destinos
+}
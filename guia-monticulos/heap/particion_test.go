@@ -0,0 +1,54 @@
+package heap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionPorResto(t *testing.T) {
+	h := heapDeMinimos(9, 4, 7, 1, 6, 3, 8, 2, 5)
+
+	partes := Partition(h, func(v int) int { return v % 3 }, 3)
+
+	assert.Len(t, partes, 3)
+	assert.Equal(t, []int{3, 6, 9}, extraerTodos(partes[0]))
+	assert.Equal(t, []int{1, 4, 7}, extraerTodos(partes[1]))
+	assert.Equal(t, []int{2, 5, 8}, extraerTodos(partes[2]))
+	// el original queda intacto
+	assert.Equal(t, 9, h.Size())
+}
+
+func TestPartitionConservaElComparador(t *testing.T) {
+	h := NewMaxHeap[int]()
+	for _, v := range []int{1, 10, 2, 20, 3} {
+		h.Insert(v)
+	}
+
+	partes := Partition(h, func(v int) int {
+		if v >= 10 {
+			return 1
+		}
+		return 0
+	}, 3)
+
+	assert.Equal(t, []int{3, 2, 1}, extraerTodos(partes[0]))
+	assert.Equal(t, []int{20, 10}, extraerTodos(partes[1]))
+	assert.Equal(t, 0, partes[2].Size())
+	partes[2].Insert(4)
+	partes[2].Insert(8)
+	v, _ := partes[2].Remove()
+	assert.Equal(t, 8, v)
+}
+
+func TestPartitionCasosBorde(t *testing.T) {
+	var nulo *Heap[int]
+	assert.Nil(t, Partition(nulo, func(int) int { return 0 }, 2))
+
+	assert.PanicsWithValue(t, "heap: partition: k debe ser positivo y es 0", func() {
+		Partition(heapDeMinimos(1), func(int) int { return 0 }, 0)
+	})
+	assert.PanicsWithValue(t, "heap: partition: categoría 2 fuera de rango [0, 2) para 5", func() {
+		Partition(heapDeMinimos(5), func(int) int { return 2 }, 2)
+	})
+}
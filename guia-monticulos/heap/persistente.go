@@ -0,0 +1,140 @@
+package heap
+
+import "fmt"
+
+// nodoPersistente es un nodo de un leftist heap inmutable.
+type nodoPersistente[T any] struct {
+	valor T
+	// largo del camino más corto hasta un hijo vacío (s-value)
+	rango int
+	size  int
+	izq   *nodoPersistente[T]
+	der   *nodoPersistente[T]
+}
+
+func (n *nodoPersistente[T]) getRango() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.rango
+}
+
+func (n *nodoPersistente[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+// fusionar combina dos leftist heaps sin modificarlos: solo se crean nodos
+// nuevos a lo largo del camino derecho, que tiene O(log n) nodos.
+func fusionar[T any](a, b *nodoPersistente[T], compare func(a T, b T) int) *nodoPersistente[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if compare(b.valor, a.valor) < 0 {
+		a, b = b, a
+	}
+	izq, der := a.izq, fusionar(a.der, b, compare)
+	if izq.getRango() < der.getRango() {
+		izq, der = der, izq
+	}
+
+	return &nodoPersistente[T]{
+		valor: a.valor,
+		rango: der.getRango() + 1,
+		size:  a.size + b.size,
+		izq:   izq,
+		der:   der,
+	}
+}
+
+// HeapPersistente es un heap inmutable: Insert y Remove no lo modifican sino
+// que retornan un heap nuevo, y las versiones anteriores siguen siendo
+// válidas. Internamente es un leftist heap en el que cada versión comparte
+// con la anterior todos los nodos salvo O(log n), así que guardar muchas
+// versiones es barato. El valor cero no sirve: se crea con NewHeapPersistente.
+type HeapPersistente[T any] struct {
+	raiz    *nodoPersistente[T]
+	compare func(a T, b T) int
+}
+
+// NewHeapPersistente crea un heap persistente vacío.
+//
+// Uso:
+//
+//	v0 := heap.NewHeapPersistente(cmp.Compare[int])
+//	v1 := v0.Insert(5) // v0 sigue vacío
+//
+// Parámetros:
+//   - `comp` función de comparación, con la misma convención que NewGenericHeap.
+//
+// Retorna:
+//   - el heap vacío.
+func NewHeapPersistente[T any](comp func(a T, b T) int) HeapPersistente[T] {
+	if comp == nil {
+		panic(Localizar("heap: la función de comparación no puede ser nil", "heap: comparison function must not be nil"))
+	}
+
+	return HeapPersistente[T]{compare: comp}
+}
+
+// Size retorna la cantidad de elementos del heap.
+func (h HeapPersistente[T]) Size() int {
+	return h.raiz.getSize()
+}
+
+// Insert retorna un heap con los elementos de este más `element`. O(log n)
+func (h HeapPersistente[T]) Insert(element T) HeapPersistente[T] {
+	nuevo := &nodoPersistente[T]{valor: element, rango: 1, size: 1}
+
+	return HeapPersistente[T]{raiz: fusionar(h.raiz, nuevo, h.compare), compare: h.compare}
+}
+
+// Peek retorna el elemento en la cima del heap.
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (h HeapPersistente[T]) Peek() (T, error) {
+	if h.raiz == nil {
+		var cero T
+		return cero, fmt.Errorf(Localizar("consultar: %w", "peek: %w"), ErrHeapVacio)
+	}
+
+	return h.raiz.valor, nil
+}
+
+// Remove retorna el elemento en la cima y un heap sin él. O(log n)
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - el heap sin ese elemento.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (h HeapPersistente[T]) Remove() (T, HeapPersistente[T], error) {
+	if h.raiz == nil {
+		var cero T
+		return cero, h, fmt.Errorf(Localizar("extraer: %w", "remove: %w"), ErrHeapVacio)
+	}
+	resto := HeapPersistente[T]{raiz: fusionar(h.raiz.izq, h.raiz.der, h.compare), compare: h.compare}
+
+	return h.raiz.valor, resto, nil
+}
+
+// ToSlice retorna los elementos en el orden en que saldrían del heap, sin
+// modificarlo. O(n log n)
+func (h HeapPersistente[T]) ToSlice() []T {
+	elementos := make([]T, 0, h.Size())
+	for h.raiz != nil {
+		var v T
+		v, h, _ = h.Remove()
+		elementos = append(elementos, v)
+	}
+
+	return elementos
+}
@@ -0,0 +1,102 @@
+package heap
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeapPersistenteConservaLasVersiones(t *testing.T) {
+	v0 := NewHeapPersistente(cmp.Compare[int])
+	v1 := v0.Insert(5)
+	v2 := v1.Insert(2)
+	v3 := v2.Insert(8)
+	min, v4, err := v3.Remove()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, min)
+	assert.Equal(t, 0, v0.Size())
+	assert.Equal(t, []int{5}, v1.ToSlice())
+	assert.Equal(t, []int{2, 5}, v2.ToSlice())
+	assert.Equal(t, []int{2, 5, 8}, v3.ToSlice())
+	assert.Equal(t, []int{5, 8}, v4.ToSlice())
+
+	// dos ramas a partir de la misma versión
+	a, b := v2.Insert(1), v2.Insert(9)
+	pa, _ := a.Peek()
+	pb, _ := b.Peek()
+	assert.Equal(t, 1, pa)
+	assert.Equal(t, 2, pb)
+}
+
+func TestHeapPersistenteVacio(t *testing.T) {
+	h := NewHeapPersistente(cmp.Compare[string])
+
+	_, err := h.Peek()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+	_, resto, err := h.Remove()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+	assert.Equal(t, 0, resto.Size())
+	assert.Empty(t, h.ToSlice())
+	assert.Panics(t, func() { NewHeapPersistente[int](nil) })
+}
+
+func TestHeapPersistenteCoincideConOrdenar(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	h := NewHeapPersistente(Reverse(cmp.Compare[int]))
+	var valores []int
+	for i := 0; i < 1000; i++ {
+		v := r.Intn(100)
+		valores = append(valores, v)
+		h = h.Insert(v)
+	}
+
+	slices.Sort(valores)
+	slices.Reverse(valores)
+	assert.Equal(t, valores, h.ToSlice())
+	assert.LessOrEqual(t, h.raiz.rango, 10)
+}
+
+func TestVersionedHeap(t *testing.T) {
+	h := NewVersionedHeap(cmp.Compare[int])
+	assert.Equal(t, 0, h.Version())
+
+	assert.Equal(t, 1, h.Insert(4))
+	assert.Equal(t, 2, h.Insert(1))
+	assert.Equal(t, 3, h.Insert(7))
+	v, version, err := h.Remove()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 4, version)
+	assert.Equal(t, 2, h.Size())
+
+	cima, err := h.PeekAt(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cima)
+	tam, err := h.SizeAt(3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, tam)
+	elementos, err := h.ToSliceAt(4)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 7}, elementos)
+
+	_, err = h.PeekAt(0)
+	assert.ErrorIs(t, err, ErrHeapVacio)
+	_, err = h.SizeAt(5)
+	assert.ErrorIs(t, err, ErrVersionInexistente)
+	_, err = h.ToSliceAt(-1)
+	assert.EqualError(t, err, "versión -1: versión inexistente")
+}
+
+func TestVersionedHeapRemoveVacioNoCreaVersion(t *testing.T) {
+	h := NewVersionedHeap(cmp.Compare[int])
+
+	_, version, err := h.Remove()
+
+	assert.ErrorIs(t, err, ErrHeapVacio)
+	assert.Equal(t, 0, version)
+	assert.Equal(t, 0, h.Version())
+}
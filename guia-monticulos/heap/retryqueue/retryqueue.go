@@ -0,0 +1,229 @@
+// Package retryqueue implementa una cola de trabajos con reintentos: cuando
+// un trabajo falla vuelve a la cola con una demora que crece
+// exponencialmente con cada intento (con jitter, para que los trabajos que
+// fallaron juntos no se reintenten todos al mismo tiempo), y cuando agota los
+// intentos pasa a una dead-letter queue para revisarlo a mano. Las demoras
+// se implementan con heap.DelayQueue.
+package retryqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+var (
+	// ErrConfigInvalida indica que algún parámetro de la configuración es inválido.
+	ErrConfigInvalida = errors.New("configuración inválida")
+	// ErrColaCerrada indica que se operó sobre una cola cerrada.
+	ErrColaCerrada = errors.New("cola de reintentos cerrada")
+)
+
+// Config son los parámetros de la cola.
+type Config struct {
+	// MaxIntentos es la cantidad de ejecuciones tras la cual un trabajo que
+	// sigue fallando pasa a la dead-letter queue.
+	MaxIntentos int
+	// BackoffBase es la demora antes del primer reintento; cada reintento
+	// siguiente espera el doble que el anterior.
+	BackoffBase time.Duration
+	// BackoffMax es la demora máxima entre reintentos. Con 0 no hay máximo.
+	BackoffMax time.Duration
+	// Jitter es la fracción de la demora que se elige al azar, entre 0 (sin
+	// jitter) y 1 (la demora es un valor uniforme entre 0 y el backoff).
+	Jitter float64
+	// Semilla del generador usado para el jitter.
+	Semilla int64
+}
+
+func (c Config) validar() error {
+	switch {
+	case c.MaxIntentos < 1:
+		return fmt.Errorf("%w: debe haber al menos un intento", ErrConfigInvalida)
+	case c.BackoffBase <= 0:
+		return fmt.Errorf("%w: el backoff base debe ser positivo", ErrConfigInvalida)
+	case c.BackoffMax < 0:
+		return fmt.Errorf("%w: el backoff máximo no puede ser negativo", ErrConfigInvalida)
+	case !(c.Jitter >= 0 && c.Jitter <= 1):
+		return fmt.Errorf("%w: el jitter debe estar entre 0 y 1", ErrConfigInvalida)
+	}
+
+	return nil
+}
+
+// Trabajo es un elemento de la cola junto con su historial de intentos.
+type Trabajo[T any] struct {
+	Valor T
+	// Intentos es la cantidad de veces que el trabajo falló.
+	Intentos int
+	// UltimoError es el error del último intento fallido.
+	UltimoError error
+}
+
+// Cola es una cola de reintentos. Es segura para usar desde varias goroutines.
+type Cola[T any] struct {
+	config     Config
+	pendientes *heap.DelayQueue[*Trabajo[T]]
+
+	mu         sync.Mutex
+	azar       *rand.Rand
+	deadLetter []Trabajo[T]
+}
+
+// New crea una cola de reintentos vacía.
+//
+// Uso:
+//
+//	cola, err := retryqueue.New[Email](retryqueue.Config{
+//		MaxIntentos: 5,
+//		BackoffBase: time.Second,
+//		BackoffMax:  time.Minute,
+//		Jitter:      0.5,
+//	})
+//
+// Retorna:
+//   - un puntero a la cola.
+//   - un error que envuelve a ErrConfigInvalida si algún parámetro es inválido.
+func New[T any](config Config) (*Cola[T], error) {
+	if err := config.validar(); err != nil {
+		return nil, err
+	}
+
+	return &Cola[T]{
+		config:     config,
+		pendientes: heap.NewDelayQueue[*Trabajo[T]](),
+		azar:       rand.New(rand.NewSource(config.Semilla)),
+	}, nil
+}
+
+// Backoff retorna la demora antes del reintento que sigue a `intentos`
+// fallos, sin jitter: BackoffBase * 2^(intentos-1), acotada por BackoffMax.
+func (c Config) Backoff(intentos int) time.Duration {
+	d := c.BackoffBase
+	for i := 1; i < intentos; i++ {
+		// desde 2^62 el doble ya no entra en un time.Duration
+		if c.BackoffMax > 0 && d >= c.BackoffMax || d >= time.Duration(1<<62) {
+			break
+		}
+		d *= 2
+	}
+	if c.BackoffMax > 0 && d > c.BackoffMax {
+		d = c.BackoffMax
+	}
+
+	return d
+}
+
+// demora retorna el backoff con jitter para un trabajo que falló `intentos`
+// veces.
+func (c *Cola[T]) demora(intentos int) time.Duration {
+	d := c.config.Backoff(intentos)
+	c.mu.Lock()
+	azar := c.azar.Float64()
+	c.mu.Unlock()
+
+	return d - time.Duration(c.config.Jitter*azar*float64(d))
+}
+
+// Submit agrega un trabajo nuevo, disponible enseguida.
+//
+// Retorna:
+//   - un error que envuelve a ErrColaCerrada si la cola está cerrada.
+func (c *Cola[T]) Submit(valor T) error {
+	if err := c.pendientes.PutAfter(&Trabajo[T]{Valor: valor}, 0); err != nil {
+		return fmt.Errorf("submit: %w", ErrColaCerrada)
+	}
+
+	return nil
+}
+
+// Take retira el próximo trabajo disponible, esperando si hace falta. El
+// trabajo deja de estar en la cola: si falla hay que informarlo con Fail.
+//
+// Retorna:
+//   - el trabajo.
+//   - el error del contexto si se canceló antes, o un error que envuelve a
+//     ErrColaCerrada si la cola está cerrada y no quedan trabajos.
+func (c *Cola[T]) Take(ctx context.Context) (*Trabajo[T], error) {
+	t, err := c.pendientes.Take(ctx)
+	if errors.Is(err, heap.ErrColaCerrada) {
+		return nil, fmt.Errorf("take: %w", ErrColaCerrada)
+	}
+
+	return t, err
+}
+
+// Fail informa que un trabajo retirado con Take falló. Si le quedan
+// intentos vuelve a la cola con la demora que corresponde; si no, pasa a la
+// dead-letter queue.
+//
+// Retorna:
+//   - la demora hasta el próximo intento, o -1 si el trabajo pasó a la
+//     dead-letter queue.
+func (c *Cola[T]) Fail(t *Trabajo[T], causa error) time.Duration {
+	t.Intentos++
+	t.UltimoError = causa
+	if t.Intentos < c.config.MaxIntentos {
+		d := c.demora(t.Intentos)
+		if c.pendientes.PutAfter(t, d) == nil {
+			return d
+		}
+		// con la cola cerrada el trabajo no se puede reintentar
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deadLetter = append(c.deadLetter, *t)
+
+	return -1
+}
+
+// Procesar retira trabajos y los ejecuta con `fn` hasta que se cancele el
+// contexto o se cierre la cola y se vacíe. Los trabajos para los que `fn`
+// retorna un error se informan con Fail.
+//
+// Uso:
+//
+//	err := cola.Procesar(ctx, func(e Email) error { return enviar(e) })
+//
+// Retorna:
+//   - el error del contexto, o nil si terminó porque la cola se cerró.
+func (c *Cola[T]) Procesar(ctx context.Context, fn func(T) error) error {
+	for {
+		t, err := c.Take(ctx)
+		if errors.Is(err, ErrColaCerrada) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(t.Valor); err != nil {
+			c.Fail(t, err)
+		}
+	}
+}
+
+// Len retorna la cantidad de trabajos en la cola, incluidos los que esperan
+// un reintento.
+func (c *Cola[T]) Len() int {
+	return c.pendientes.Size()
+}
+
+// DeadLetters retorna una copia de los trabajos que agotaron sus intentos.
+func (c *Cola[T]) DeadLetters() []Trabajo[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Trabajo[T](nil), c.deadLetter...)
+}
+
+// Close cierra la cola: Submit falla, los trabajos que fallen a partir de
+// ahora van directo a la dead-letter queue, y Take entrega los pendientes
+// (a medida que llega su reintento) y después falla.
+func (c *Cola[T]) Close() {
+	c.pendientes.Close()
+}
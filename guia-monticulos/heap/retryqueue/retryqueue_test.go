@@ -0,0 +1,132 @@
+package retryqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigInvalida(t *testing.T) {
+	for _, c := range []Config{
+		{MaxIntentos: 0, BackoffBase: time.Second},
+		{MaxIntentos: 1},
+		{MaxIntentos: 1, BackoffBase: time.Second, BackoffMax: -1},
+		{MaxIntentos: 1, BackoffBase: time.Second, Jitter: 1.5},
+	} {
+		_, err := New[int](c)
+		assert.ErrorIs(t, err, ErrConfigInvalida)
+	}
+}
+
+func TestBackoffExponencialAcotado(t *testing.T) {
+	c := Config{BackoffBase: 100 * time.Millisecond, BackoffMax: time.Second}
+
+	var demoras []time.Duration
+	for i := 1; i <= 6; i++ {
+		demoras = append(demoras, c.Backoff(i))
+	}
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, time.Second, time.Second,
+	}, demoras)
+
+	sinMaximo := Config{BackoffBase: time.Second}
+	assert.Equal(t, 1024*time.Second, sinMaximo.Backoff(11))
+	assert.Positive(t, sinMaximo.Backoff(1000))
+}
+
+func TestBackoffNoDesbordaEnElLimite(t *testing.T) {
+	limite := time.Duration(1 << 62)
+
+	assert.Equal(t, limite, Config{BackoffBase: limite}.Backoff(2))
+	assert.Equal(t, limite, Config{BackoffBase: limite / 2}.Backoff(3))
+	assert.Equal(t, limite-2, Config{BackoffBase: limite/2 - 1}.Backoff(2))
+}
+
+func TestJitterReduceLaDemora(t *testing.T) {
+	cola, err := New[int](Config{MaxIntentos: 100, BackoffBase: time.Hour, Jitter: 0.5, Semilla: 1})
+	assert.NoError(t, err)
+
+	distintas := map[time.Duration]bool{}
+	for i := 0; i < 20; i++ {
+		d := cola.demora(1)
+		assert.GreaterOrEqual(t, d, 30*time.Minute)
+		assert.LessOrEqual(t, d, time.Hour)
+		distintas[d] = true
+	}
+	assert.Greater(t, len(distintas), 1)
+}
+
+func TestReintentaHastaAgotarYPasaADeadLetter(t *testing.T) {
+	cola, err := New[string](Config{MaxIntentos: 3, BackoffBase: time.Millisecond})
+	assert.NoError(t, err)
+	assert.NoError(t, cola.Submit("falla siempre"))
+	assert.NoError(t, cola.Submit("falla una vez"))
+
+	var mu sync.Mutex
+	ejecuciones := map[string]int{}
+	ctx, cancelar := context.WithTimeout(context.Background(), time.Second)
+	defer cancelar()
+	go func() {
+		for {
+			if len(cola.DeadLetters()) == 1 && cola.Len() == 0 {
+				cola.Close()
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	err = cola.Procesar(ctx, func(s string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		ejecuciones[s]++
+		if s == "falla siempre" || ejecuciones[s] == 1 {
+			return errors.New("timeout")
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"falla siempre": 3, "falla una vez": 2}, ejecuciones)
+	muertos := cola.DeadLetters()
+	assert.Len(t, muertos, 1)
+	assert.Equal(t, "falla siempre", muertos[0].Valor)
+	assert.Equal(t, 3, muertos[0].Intentos)
+	assert.EqualError(t, muertos[0].UltimoError, "timeout")
+}
+
+func TestFailRetornaLaDemora(t *testing.T) {
+	cola, _ := New[int](Config{MaxIntentos: 2, BackoffBase: time.Hour})
+	assert.NoError(t, cola.Submit(7))
+	trabajo, err := cola.Take(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, time.Hour, cola.Fail(trabajo, errors.New("x")))
+	assert.Equal(t, 1, cola.Len())
+	// el reintento no está disponible todavía
+	ctx, cancelar := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelar()
+	_, err = cola.Take(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Equal(t, time.Duration(-1), cola.Fail(trabajo, errors.New("y")))
+	assert.Len(t, cola.DeadLetters(), 1)
+}
+
+func TestColaCerrada(t *testing.T) {
+	cola, _ := New[int](Config{MaxIntentos: 5, BackoffBase: time.Hour})
+	assert.NoError(t, cola.Submit(1))
+	trabajo, _ := cola.Take(context.Background())
+	cola.Close()
+
+	assert.ErrorIs(t, cola.Submit(2), ErrColaCerrada)
+	// sin cola no hay reintento posible
+	assert.Equal(t, time.Duration(-1), cola.Fail(trabajo, errors.New("x")))
+	_, err := cola.Take(context.Background())
+	assert.ErrorIs(t, err, ErrColaCerrada)
+}
@@ -0,0 +1,204 @@
+// Package simbanco simula la atención de clientes en un banco con varias
+// cajas y una única fila, sobre el motor de eventos del paquete eventos. Los
+// clientes llegan según un proceso de Poisson y cada caja atiende a uno por
+// vez con tiempos tomados de una distribución configurable.
+package simbanco
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"untref/ayp2/monticulo/heap/eventos"
+)
+
+// Distribucion genera tiempos aleatorios de atención.
+type Distribucion interface {
+	Muestra(r *rand.Rand) float64
+}
+
+// Exponencial es la distribución exponencial con la media indicada.
+type Exponencial struct {
+	Media float64
+}
+
+// Muestra retorna un tiempo con distribución exponencial.
+func (d Exponencial) Muestra(r *rand.Rand) float64 {
+	return r.ExpFloat64() * d.Media
+}
+
+// Uniforme es la distribución uniforme en [Min, Max).
+type Uniforme struct {
+	Min, Max float64
+}
+
+// Muestra retorna un tiempo con distribución uniforme.
+func (d Uniforme) Muestra(r *rand.Rand) float64 {
+	return d.Min + r.Float64()*(d.Max-d.Min)
+}
+
+// Constante siempre retorna el mismo tiempo.
+type Constante struct {
+	Valor float64
+}
+
+// Muestra retorna el valor constante.
+func (d Constante) Muestra(*rand.Rand) float64 {
+	return d.Valor
+}
+
+// Config son los parámetros de la simulación. Los tiempos se miden en una
+// unidad cualquiera (por ejemplo minutos), la misma para todos los campos.
+type Config struct {
+	// TasaArribos es la cantidad media de clientes que llegan por unidad de tiempo.
+	TasaArribos float64
+	// Cajas es la cantidad de cajas abiertas.
+	Cajas int
+	// Atencion es la distribución del tiempo que tarda una caja con un cliente.
+	Atencion Distribucion
+	// Duracion es el tiempo que el banco recibe clientes; los que están en la
+	// fila al cerrar se atienden igual.
+	Duracion float64
+	// Semilla hace reproducible la simulación.
+	Semilla int64
+}
+
+// Resultado resume una simulación.
+type Resultado struct {
+	// Clientes es la cantidad de clientes atendidos.
+	Clientes int
+	// EsperaPromedio es el tiempo medio en la fila, sin contar la atención.
+	EsperaPromedio float64
+	// EsperaMaxima es el mayor tiempo que un cliente pasó en la fila.
+	EsperaMaxima float64
+	// LargoMaximoFila es la mayor cantidad de clientes esperando a la vez.
+	LargoMaximoFila int
+	// Ocupacion es la fracción del tiempo total que las cajas estuvieron
+	// atendiendo, en promedio.
+	Ocupacion float64
+	// Fin es el instante en que se atendió al último cliente.
+	Fin float64
+}
+
+// ErrConfigInvalida indica que algún parámetro de la simulación es inválido.
+var ErrConfigInvalida = errors.New("configuración inválida")
+
+func (c Config) validar() error {
+	switch {
+	case !(c.TasaArribos > 0):
+		return fmt.Errorf("%w: la tasa de arribos debe ser positiva", ErrConfigInvalida)
+	case c.Cajas < 1:
+		return fmt.Errorf("%w: debe haber al menos una caja", ErrConfigInvalida)
+	case c.Atencion == nil:
+		return fmt.Errorf("%w: falta la distribución de atención", ErrConfigInvalida)
+	case !(c.Duracion > 0) || math.IsInf(c.Duracion, 1):
+		return fmt.Errorf("%w: la duración debe ser positiva y finita", ErrConfigInvalida)
+	}
+
+	return nil
+}
+
+// banco es el estado de una simulación en curso.
+type banco struct {
+	config Config
+	sim    *eventos.Simulacion
+	rnd    *rand.Rand
+	// instantes de llegada de los clientes que esperan, en orden de llegada
+	fila       []float64
+	cajasLibre int
+	ocupado    float64
+	esperas    []float64
+	resultado  Resultado
+}
+
+// Simular ejecuta una simulación completa.
+//
+// Uso:
+//
+//	res, err := simbanco.Simular(simbanco.Config{
+//		TasaArribos: 0.5,
+//		Cajas:       2,
+//		Atencion:    simbanco.Exponencial{Media: 3},
+//		Duracion:    480,
+//		Semilla:     1,
+//	})
+//
+// Parámetros:
+//   - `c` parámetros de la simulación.
+//
+// Retorna:
+//   - el resumen de la simulación.
+//   - un error que envuelve a ErrConfigInvalida si algún parámetro es inválido.
+func Simular(c Config) (Resultado, error) {
+	if err := c.validar(); err != nil {
+		return Resultado{}, err
+	}
+	b := &banco{
+		config:     c,
+		sim:        eventos.New(),
+		rnd:        rand.New(rand.NewSource(c.Semilla)),
+		cajasLibre: c.Cajas,
+	}
+	b.programarArribo()
+	b.sim.Ejecutar(math.Inf(1))
+
+	return b.resumir(), nil
+}
+
+func (b *banco) programarArribo() {
+	demora := b.rnd.ExpFloat64() / b.config.TasaArribos
+	if b.sim.Ahora()+demora > b.config.Duracion {
+		return
+	}
+	_ = b.sim.Programar(demora, b.arribo)
+}
+
+// arribo pone al cliente en la fila, o directamente en una caja si hay una libre.
+func (b *banco) arribo() {
+	b.programarArribo()
+	if b.cajasLibre > 0 {
+		b.atender(b.sim.Ahora())
+		return
+	}
+	b.fila = append(b.fila, b.sim.Ahora())
+	b.resultado.LargoMaximoFila = max(b.resultado.LargoMaximoFila, len(b.fila))
+}
+
+// atender ocupa una caja con un cliente que llegó en el instante `llegada`.
+func (b *banco) atender(llegada float64) {
+	b.cajasLibre--
+	b.esperas = append(b.esperas, b.sim.Ahora()-llegada)
+	duracion := math.Max(0, b.config.Atencion.Muestra(b.rnd))
+	b.ocupado += duracion
+	_ = b.sim.Programar(duracion, b.salida)
+}
+
+// salida libera la caja y llama al primero de la fila, si hay alguien.
+func (b *banco) salida() {
+	b.cajasLibre++
+	b.resultado.Fin = b.sim.Ahora()
+	if len(b.fila) > 0 {
+		llegada := b.fila[0]
+		b.fila = b.fila[1:]
+		b.atender(llegada)
+	}
+}
+
+func (b *banco) resumir() Resultado {
+	r := b.resultado
+	r.Clientes = len(b.esperas)
+	total := 0.0
+	for _, e := range b.esperas {
+		total += e
+		r.EsperaMaxima = math.Max(r.EsperaMaxima, e)
+	}
+	if r.Clientes > 0 {
+		r.EsperaPromedio = total / float64(r.Clientes)
+	}
+	if r.Fin > 0 {
+		r.Ocupacion = b.ocupado / (r.Fin * float64(b.config.Cajas))
+	}
+
+	return r
+}
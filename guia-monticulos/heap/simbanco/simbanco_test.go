@@ -0,0 +1,66 @@
+package simbanco
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimularEsReproducible(t *testing.T) {
+	c := Config{TasaArribos: 1, Cajas: 2, Atencion: Uniforme{Min: 1, Max: 3}, Duracion: 200, Semilla: 42}
+
+	a, err := Simular(c)
+	assert.NoError(t, err)
+	b, err := Simular(c)
+	assert.NoError(t, err)
+	assert.Equal(t, a, b)
+	assert.Greater(t, a.Clientes, 150)
+}
+
+func TestSimularSinEsperaSiLaAtencionEsInstantanea(t *testing.T) {
+	res, err := Simular(Config{TasaArribos: 2, Cajas: 1, Atencion: Constante{Valor: 0}, Duracion: 100, Semilla: 1})
+
+	assert.NoError(t, err)
+	assert.Zero(t, res.EsperaPromedio)
+	assert.Zero(t, res.LargoMaximoFila)
+}
+
+// Para una fila M/M/1 la espera media teórica es λ/(μ(μ-λ)).
+func TestSimularAproximaLaTeoriaMM1(t *testing.T) {
+	res, err := Simular(Config{TasaArribos: 0.5, Cajas: 1, Atencion: Exponencial{Media: 1}, Duracion: 200000, Semilla: 7})
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0, res.EsperaPromedio, 0.1)
+	assert.InDelta(t, 0.5, res.Ocupacion, 0.02)
+}
+
+func TestMasCajasReducenLaEspera(t *testing.T) {
+	c := Config{TasaArribos: 0.9, Cajas: 1, Atencion: Exponencial{Media: 1}, Duracion: 5000, Semilla: 3}
+	una, err := Simular(c)
+	assert.NoError(t, err)
+
+	c.Cajas = 2
+	dos, err := Simular(c)
+	assert.NoError(t, err)
+
+	assert.Less(t, dos.EsperaPromedio, una.EsperaPromedio)
+	assert.LessOrEqual(t, dos.LargoMaximoFila, una.LargoMaximoFila)
+}
+
+func TestSimularConfigInvalida(t *testing.T) {
+	base := Config{TasaArribos: 1, Cajas: 1, Atencion: Constante{Valor: 1}, Duracion: 10}
+
+	casos := map[string]func(c *Config){
+		"configuración inválida: la tasa de arribos debe ser positiva":   func(c *Config) { c.TasaArribos = 0 },
+		"configuración inválida: debe haber al menos una caja":           func(c *Config) { c.Cajas = 0 },
+		"configuración inválida: falta la distribución de atención":      func(c *Config) { c.Atencion = nil },
+		"configuración inválida: la duración debe ser positiva y finita": func(c *Config) { c.Duracion = -1 },
+	}
+	for msg, modificar := range casos {
+		c := base
+		modificar(&c)
+		_, err := Simular(c)
+		assert.ErrorIs(t, err, ErrConfigInvalida)
+		assert.EqualError(t, err, msg)
+	}
+}
@@ -0,0 +1,102 @@
+package heap
+
+import "fmt"
+
+// ErrIteradorAgotado indica que se llamó a Next sin elementos pendientes.
+var ErrIteradorAgotado error = &errorLocalizado{es: "no hay más elementos", en: "no more elements"}
+
+// HeapReader son las operaciones de consulta de un heap, las que ofrece
+// ReadOnlyHeap. Sirve para declarar parámetros que solo necesitan leer.
+type HeapReader[T any] interface {
+	Peek() (T, error)
+	Size() int
+	ToSlice() []T
+	Iterator() Iterator[T]
+}
+
+// ReadOnlyHeap es una vista de solo lectura de un heap: permite consultarlo
+// pero no tiene Insert ni Remove, así que se puede pasar a código que no
+// debe modificar el heap con la garantía del compilador. La vista refleja los
+// cambios que se hagan sobre el heap original.
+type ReadOnlyHeap[T any] struct {
+	heap *Heap[T]
+}
+
+// ReadOnly retorna una vista de solo lectura del heap.
+//
+// Uso:
+//
+//	mostrarPendientes(cola.ReadOnly())
+//
+// Retorna:
+//   - un puntero a la vista.
+func (m *Heap[T]) ReadOnly() *ReadOnlyHeap[T] {
+	return &ReadOnlyHeap[T]{heap: m}
+}
+
+// Peek retorna el elemento en la cima del heap sin eliminarlo.
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos, o a
+//     ErrHeapNil si el heap es nil.
+func (r *ReadOnlyHeap[T]) Peek() (T, error) {
+	return r.heap.peek()
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (r *ReadOnlyHeap[T]) Size() int {
+	return r.heap.Size()
+}
+
+// ToSlice retorna una copia de los elementos en el orden del arreglo interno.
+func (r *ReadOnlyHeap[T]) ToSlice() []T {
+	return r.heap.Values()
+}
+
+// Iterator retorna un iterador sobre los elementos en el orden del arreglo
+// interno. Recorre una copia tomada al crearlo, así que no lo afectan los
+// cambios posteriores del heap.
+func (r *ReadOnlyHeap[T]) Iterator() Iterator[T] {
+	return r.heap.Iterator()
+}
+
+// Compare compara dos elementos con la función de comparación del heap.
+func (r *ReadOnlyHeap[T]) Compare(a T, b T) int {
+	return r.heap.Compare(a, b)
+}
+
+// peek retorna la cima. La comparten Heap y ReadOnlyHeap.
+func (m *Heap[T]) peek() (T, error) {
+	var element T
+	if m == nil {
+		return element, fmt.Errorf(Localizar("consultar: %w", "peek: %w"), ErrHeapNil)
+	}
+	m.guardia.entrar("Peek")
+	defer m.guardia.salir()
+	if len(m.elements) == 0 {
+		return element, fmt.Errorf(Localizar("consultar: %w", "peek: %w"), ErrHeapVacio)
+	}
+
+	return m.elements[0], nil
+}
+
+// iteradorArreglo recorre un slice de izquierda a derecha.
+type iteradorArreglo[T any] struct {
+	elementos []T
+	siguiente int
+}
+
+func (it *iteradorArreglo[T]) HasNext() bool {
+	return it.siguiente < len(it.elementos)
+}
+
+func (it *iteradorArreglo[T]) Next() (T, error) {
+	if !it.HasNext() {
+		var cero T
+		return cero, fmt.Errorf(Localizar("siguiente: %w", "next: %w"), ErrIteradorAgotado)
+	}
+	it.siguiente++
+
+	return it.elementos[it.siguiente-1], nil
+}
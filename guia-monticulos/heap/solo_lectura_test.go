@@ -0,0 +1,66 @@
+package heap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sumar solo puede leer el heap.
+func sumar(h HeapReader[int]) int {
+	suma := 0
+	for it := h.Iterator(); it.HasNext(); {
+		v, _ := it.Next()
+		suma += v
+	}
+
+	return suma
+}
+
+func TestReadOnlyHeap(t *testing.T) {
+	h := heapDeMinimos(5, 3, 8)
+	r := h.ReadOnly()
+
+	v, err := r.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, 3, r.Size())
+	assert.Equal(t, []int{3, 5, 8}, r.ToSlice())
+	assert.Equal(t, 16, sumar(r))
+	assert.Negative(t, r.Compare(1, 2))
+	// consultar no modifica el heap
+	assert.Equal(t, 3, h.Size())
+
+	// la vista refleja los cambios del original
+	h.Insert(1)
+	v, _ = r.Peek()
+	assert.Equal(t, 1, v)
+}
+
+func TestReadOnlyHeapIteradorSobreCopia(t *testing.T) {
+	h := heapDeMinimos(2, 4)
+	it := h.ReadOnly().Iterator()
+	h.Insert(0)
+
+	var vistos []int
+	for it.HasNext() {
+		v, err := it.Next()
+		assert.NoError(t, err)
+		vistos = append(vistos, v)
+	}
+	assert.Equal(t, []int{2, 4}, vistos)
+	_, err := it.Next()
+	assert.ErrorIs(t, err, ErrIteradorAgotado)
+}
+
+func TestReadOnlyHeapVacioYNil(t *testing.T) {
+	_, err := NewMinHeap[int]().ReadOnly().Peek()
+	assert.ErrorIs(t, err, ErrHeapVacio)
+
+	var nulo *Heap[int]
+	r := nulo.ReadOnly()
+	_, err = r.Peek()
+	assert.ErrorIs(t, err, ErrHeapNil)
+	assert.Equal(t, 0, r.Size())
+	assert.False(t, r.Iterator().HasNext())
+}
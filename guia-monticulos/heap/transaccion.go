@@ -0,0 +1,97 @@
+package heap
+
+import "fmt"
+
+// HeapTx es la vista del heap que recibe la función pasada a Transaction.
+// Solo es válida mientras dura la transacción.
+type HeapTx[T any] struct {
+	heap      *Heap[T]
+	terminada bool
+}
+
+func (tx *HeapTx[T]) verificar() {
+	if tx.terminada {
+		panic(Localizar("heap: se usó una transacción ya terminada", "heap: transaction used after it finished"))
+	}
+}
+
+// Size retorna la cantidad de elementos en el heap.
+func (tx *HeapTx[T]) Size() int {
+	tx.verificar()
+	return tx.heap.Size()
+}
+
+// Insert agrega un elemento al heap.
+func (tx *HeapTx[T]) Insert(element T) {
+	tx.verificar()
+	tx.heap.Insert(element)
+}
+
+// Remove elimina y retorna el elemento en la cima del heap.
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (tx *HeapTx[T]) Remove() (T, error) {
+	tx.verificar()
+	return tx.heap.Remove()
+}
+
+// Update reemplaza un elemento equivalente a `old` por `new` y lo reubica,
+// como Heap.Update.
+//
+// Retorna:
+//   - un error que envuelve a ErrElementoInexistente si no hay un elemento
+//     equivalente a `old`.
+func (tx *HeapTx[T]) Update(old, new T) error {
+	tx.verificar()
+	return tx.heap.Update(old, new)
+}
+
+// Transaction aplica un conjunto de operaciones de forma atómica: si `fn`
+// retorna un error (o produce un panic) el heap queda exactamente como
+// estaba antes de la transacción, con el mismo arreglo interno.
+//
+// Uso:
+//
+//	err := h.Transaction(func(tx *heap.HeapTx[Pedido]) error {
+//		p, err := tx.Remove()
+//		if err != nil {
+//			return err
+//		}
+//		if !p.Pagado {
+//			return errPedidoImpago // el pedido vuelve al heap
+//		}
+//		tx.Insert(p.Siguiente())
+//		return nil
+//	})
+//
+// Parámetros:
+//   - `fn` función que opera sobre el heap a través de `tx`. No debe usar el
+//     heap directamente ni guardar `tx` para después.
+//
+// Retorna:
+//   - el error retornado por `fn`, o uno que envuelve a ErrHeapNil si el heap
+//     es nil.
+func (m *Heap[T]) Transaction(fn func(tx *HeapTx[T]) error) (err error) {
+	if m == nil {
+		return fmt.Errorf(Localizar("transacción: %w", "transaction: %w"), ErrHeapNil)
+	}
+	// copia del estado para restaurarlo si la transacción falla
+	antes := copiarElementos("transaction", m.elements, nil)
+	tx := &HeapTx[T]{heap: m}
+	exito := false
+	defer func() {
+		tx.terminada = true
+		if !exito {
+			m.elements = antes
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+	exito = true
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package heap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionConfirma(t *testing.T) {
+	h := heapDeMinimos(4, 2, 6)
+
+	err := h.Transaction(func(tx *HeapTx[int]) error {
+		v, err := tx.Remove()
+		assert.Equal(t, 2, v)
+		tx.Insert(1)
+		assert.NoError(t, tx.Update(6, 0))
+		assert.Equal(t, 3, tx.Size())
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 4}, extraerTodos(h))
+}
+
+func TestTransactionRevierteAnteUnError(t *testing.T) {
+	h := heapDeMinimos(5, 3, 9, 1, 7)
+	antes := h.Values()
+	errPropio := errors.New("cancelado")
+
+	err := h.Transaction(func(tx *HeapTx[int]) error {
+		_, _ = tx.Remove()
+		_, _ = tx.Remove()
+		tx.Insert(0)
+		if err := tx.Update(42, 1); err != nil {
+			return err
+		}
+		return errPropio
+	})
+
+	assert.ErrorIs(t, err, ErrElementoInexistente)
+	assert.EqualError(t, err, "actualizar 42: elemento inexistente")
+	assert.Equal(t, antes, h.Values())
+
+	err = h.Transaction(func(tx *HeapTx[int]) error {
+		tx.Insert(-1)
+		return errPropio
+	})
+	assert.ErrorIs(t, err, errPropio)
+	assert.Equal(t, antes, h.Values())
+}
+
+func TestTransactionRevierteAnteUnPanic(t *testing.T) {
+	h := heapDeMinimos(2, 1)
+	antes := h.Values()
+
+	assert.Panics(t, func() {
+		_ = h.Transaction(func(tx *HeapTx[int]) error {
+			tx.Insert(0)
+			panic("falla")
+		})
+	})
+	assert.Equal(t, antes, h.Values())
+}
+
+func TestTransactionNoPermiteUsarTxDespues(t *testing.T) {
+	h := NewMinHeap[int]()
+	var guardada *HeapTx[int]
+	_ = h.Transaction(func(tx *HeapTx[int]) error {
+		guardada = tx
+		return nil
+	})
+
+	assert.PanicsWithValue(t, "heap: se usó una transacción ya terminada", func() {
+		guardada.Insert(1)
+	})
+
+	var nulo *Heap[int]
+	assert.ErrorIs(t, nulo.Transaction(func(*HeapTx[int]) error { return nil }), ErrHeapNil)
+}
+
+func TestHeapTxUpdateReubica(t *testing.T) {
+	h := NewMaxHeap[int]()
+	for _, v := range []int{10, 8, 9, 1, 2, 3} {
+		h.Insert(v)
+	}
+
+	_ = h.Transaction(func(tx *HeapTx[int]) error {
+		assert.NoError(t, tx.Update(1, 20))
+		assert.NoError(t, tx.Update(10, 0))
+		return nil
+	})
+
+	assert.Equal(t, []int{20, 9, 8, 3, 2, 0}, extraerTodos(h))
+}
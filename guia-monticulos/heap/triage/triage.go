@@ -0,0 +1,154 @@
+// Package triage ordena la atención de una guardia según la gravedad de los
+// pacientes, con envejecimiento opcional: cuanto más espera un paciente, más
+// sube su prioridad, para que los casos leves no esperen indefinidamente.
+package triage
+
+import (
+	"errors"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+// ErrSinPacientes indica que se intentó atender con la sala de espera vacía.
+var ErrSinPacientes = errors.New("no hay pacientes esperando")
+
+// Paciente es una persona que llega a la guardia.
+type Paciente struct {
+	Nombre string
+	// Gravedad va de 1 (leve) a 5 (crítico).
+	Gravedad int
+	// Llegada es el minuto en que llegó el paciente.
+	Llegada int
+}
+
+// Cola es la sala de espera. La prioridad efectiva de un paciente en el
+// minuto t es
+//
+//	Gravedad + envejecimiento * (t - Llegada)
+//
+// Como todos los pacientes que esperan envejecen al mismo ritmo, el orden
+// entre ellos no cambia con el tiempo: alcanza con ordenar el heap por
+// Gravedad - envejecimiento * Llegada, que no depende de t.
+type Cola struct {
+	espera         *heap.Heap[Paciente]
+	envejecimiento float64
+}
+
+// NewCola crea una sala de espera vacía.
+//
+// Uso:
+//
+//	cola := triage.NewCola(0.1) // un punto de gravedad cada 10 minutos
+//
+// Parámetros:
+//   - `envejecimiento` puntos de gravedad que gana un paciente por minuto de
+//     espera. Con 0 se atiende estrictamente por gravedad.
+//
+// Retorna:
+//   - un puntero a la sala de espera.
+func NewCola(envejecimiento float64) *Cola {
+	c := &Cola{envejecimiento: envejecimiento}
+	c.espera = heap.NewGenericHeap(func(a, b Paciente) int {
+		ka, kb := c.clave(a), c.clave(b)
+		switch {
+		case ka > kb:
+			return -1
+		case ka < kb:
+			return 1
+		}
+		// a igual prioridad, primero el que llegó antes
+		return a.Llegada - b.Llegada
+	})
+
+	return c
+}
+
+func (c *Cola) clave(p Paciente) float64 {
+	return float64(p.Gravedad) - c.envejecimiento*float64(p.Llegada)
+}
+
+// PrioridadEfectiva retorna la prioridad de un paciente en el minuto `ahora`.
+func (c *Cola) PrioridadEfectiva(p Paciente, ahora int) float64 {
+	return float64(p.Gravedad) + c.envejecimiento*float64(ahora-p.Llegada)
+}
+
+// Ingresar agrega un paciente a la sala de espera.
+func (c *Cola) Ingresar(p Paciente) {
+	c.espera.Insert(p)
+}
+
+// Atender retira al paciente con mayor prioridad efectiva.
+//
+// Retorna:
+//   - el paciente a atender.
+//   - ErrSinPacientes si la sala de espera está vacía.
+func (c *Cola) Atender() (Paciente, error) {
+	if c.espera.Size() == 0 {
+		return Paciente{}, ErrSinPacientes
+	}
+
+	return c.espera.Remove()
+}
+
+// Size retorna la cantidad de pacientes esperando.
+func (c *Cola) Size() int {
+	return c.espera.Size()
+}
+
+// Atencion indica cuándo fue atendido un paciente.
+type Atencion struct {
+	Paciente Paciente
+	Inicio   int
+}
+
+// Espera retorna los minutos que esperó el paciente.
+func (a Atencion) Espera() int {
+	return a.Inicio - a.Paciente.Llegada
+}
+
+// Simular reproduce una guardia con un único médico que tarda `duracion`
+// minutos con cada paciente y, al quedar libre, llama al de mayor prioridad
+// efectiva entre los que ya llegaron.
+//
+// Uso:
+//
+//	atenciones := triage.Simular(pacientes, 15, 0.1)
+//
+// Parámetros:
+//   - `pacientes` pacientes en cualquier orden.
+//   - `duracion` minutos de cada atención.
+//   - `envejecimiento` igual que en NewCola.
+//
+// Retorna:
+//   - las atenciones en el orden en que ocurrieron.
+func Simular(pacientes []Paciente, duracion int, envejecimiento float64) []Atencion {
+	porLlegada := heap.NewGenericHeap(func(a, b Paciente) int { return a.Llegada - b.Llegada })
+	for _, p := range pacientes {
+		porLlegada.Insert(p)
+	}
+
+	cola := NewCola(envejecimiento)
+	atenciones := make([]Atencion, 0, len(pacientes))
+	ahora := 0
+	for porLlegada.Size() > 0 || cola.Size() > 0 {
+		if cola.Size() == 0 {
+			// el médico espera al próximo paciente
+			p, _ := porLlegada.Remove()
+			ahora = max(ahora, p.Llegada)
+			porLlegada.Insert(p)
+		}
+		for porLlegada.Size() > 0 {
+			p, _ := porLlegada.Remove()
+			if p.Llegada > ahora {
+				porLlegada.Insert(p)
+				break
+			}
+			cola.Ingresar(p)
+		}
+		p, _ := cola.Atender()
+		atenciones = append(atenciones, Atencion{Paciente: p, Inicio: ahora})
+		ahora += duracion
+	}
+
+	return atenciones
+}
@@ -0,0 +1,70 @@
+package triage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func nombres(atenciones []Atencion) []string {
+	r := make([]string, len(atenciones))
+	for i, a := range atenciones {
+		r[i] = a.Paciente.Nombre
+	}
+
+	return r
+}
+
+func TestColaSinEnvejecimientoAtiendePorGravedad(t *testing.T) {
+	c := NewCola(0)
+	c.Ingresar(Paciente{"leve", 1, 0})
+	c.Ingresar(Paciente{"grave", 4, 30})
+	c.Ingresar(Paciente{"otro grave", 4, 40})
+
+	for _, esperado := range []string{"grave", "otro grave", "leve"} {
+		p, err := c.Atender()
+		assert.NoError(t, err)
+		assert.Equal(t, esperado, p.Nombre)
+	}
+	_, err := c.Atender()
+	assert.ErrorIs(t, err, ErrSinPacientes)
+}
+
+func TestColaConEnvejecimiento(t *testing.T) {
+	c := NewCola(0.1)
+	leve := Paciente{"leve", 1, 0}
+	grave := Paciente{"grave", 3, 30}
+	c.Ingresar(leve)
+	c.Ingresar(grave)
+
+	// en el minuto 30 el leve ya acumuló 3 puntos: 1 + 3 = 4 > 3
+	assert.InDelta(t, 4.0, c.PrioridadEfectiva(leve, 30), 1e-9)
+	p, _ := c.Atender()
+	assert.Equal(t, "leve", p.Nombre)
+}
+
+func TestSimularConYSinEnvejecimiento(t *testing.T) {
+	pacientes := []Paciente{
+		{"Ana", 1, 0},
+		{"Beto", 3, 5},
+		{"Carla", 3, 20},
+		{"Dario", 4, 35},
+		{"Eva", 2, 50},
+	}
+
+	sin := Simular(pacientes, 20, 0)
+	con := Simular(pacientes, 20, 0.1)
+
+	assert.Equal(t, []string{"Ana", "Beto", "Dario", "Carla", "Eva"}, nombres(sin))
+	assert.Equal(t, []string{"Ana", "Beto", "Carla", "Dario", "Eva"}, nombres(con))
+	assert.Equal(t, 0, sin[0].Espera())
+	assert.Equal(t, 80, sin[4].Inicio)
+}
+
+func TestSimularEsperaAlProximoPaciente(t *testing.T) {
+	atenciones := Simular([]Paciente{{"tarde", 2, 100}, {"temprano", 1, 10}}, 15, 0)
+
+	assert.Equal(t, []string{"temprano", "tarde"}, nombres(atenciones))
+	assert.Equal(t, 10, atenciones[0].Inicio)
+	assert.Equal(t, 100, atenciones[1].Inicio)
+}
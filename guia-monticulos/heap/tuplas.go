@@ -0,0 +1,117 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Pair es un par de valores, pensado como elemento de un heap cuya prioridad
+// depende de dos campos, por ejemplo (distancia, nodo) en Dijkstra.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple es una terna de valores, como Pair.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// MakePair crea un par.
+func MakePair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// MakeTriple crea una terna.
+func MakeTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// String retorna el par como "(a, b)".
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// String retorna la terna como "(a, b, c)".
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.First, t.Second, t.Third)
+}
+
+// ComparePair compara dos pares en orden lexicográfico: primero por First y,
+// si empatan, por Second. Se puede pasar directamente a NewGenericHeap.
+//
+// Uso:
+//
+//	frontera := heap.NewGenericHeap(heap.ComparePair[int, string])
+//	frontera.Insert(heap.MakePair(3, "b"))
+func ComparePair[A, B cmp.Ordered](a, b Pair[A, B]) int {
+	if c := cmp.Compare(a.First, b.First); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.Second, b.Second)
+}
+
+// CompareTriple compara dos ternas en orden lexicográfico.
+func CompareTriple[A, B, C cmp.Ordered](a, b Triple[A, B, C]) int {
+	if c := cmp.Compare(a.First, b.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Second, b.Second); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.Third, b.Third)
+}
+
+// PairComparator arma una comparación lexicográfica de pares a partir de una
+// comparación para cada campo, para los tipos sin orden natural o cuando un
+// campo se ordena al revés.
+//
+// Uso:
+//
+//	// mayor puntaje primero y, a igual puntaje, por nombre
+//	comp := heap.PairComparator(heap.Reverse(cmp.Compare[int]), cmp.Compare[string])
+//	ranking := heap.NewGenericHeap(comp)
+//
+// Parámetros:
+//   - `first` comparación de First.
+//   - `second` comparación de Second, que solo se usa si First empata.
+//
+// Retorna:
+//   - la función de comparación de pares.
+func PairComparator[A, B any](first func(A, A) int, second func(B, B) int) func(a, b Pair[A, B]) int {
+	return func(a, b Pair[A, B]) int {
+		if c := first(a.First, b.First); c != 0 {
+			return c
+		}
+
+		return second(a.Second, b.Second)
+	}
+}
+
+// TripleComparator arma una comparación lexicográfica de ternas a partir de
+// una comparación para cada campo, como PairComparator.
+func TripleComparator[A, B, C any](first func(A, A) int, second func(B, B) int, third func(C, C) int) func(a, b Triple[A, B, C]) int {
+	return func(a, b Triple[A, B, C]) int {
+		if c := first(a.First, b.First); c != 0 {
+			return c
+		}
+		if c := second(a.Second, b.Second); c != 0 {
+			return c
+		}
+
+		return third(a.Third, b.Third)
+	}
+}
+
+// Reverse invierte una función de comparación, por ejemplo para convertir
+// un heap de mínimos en uno de máximos o para ordenar un campo de un par en
+// forma descendente.
+func Reverse[T any](comp func(a T, b T) int) func(a T, b T) int {
+	return func(a, b T) int {
+		return comp(b, a)
+	}
+}
@@ -0,0 +1,61 @@
+package heap
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparePairEnHeap(t *testing.T) {
+	h := NewGenericHeap(ComparePair[int, string])
+	for _, p := range []Pair[int, string]{{3, "c"}, {1, "z"}, {3, "a"}, {2, "b"}} {
+		h.Insert(p)
+	}
+
+	assert.Equal(t, []Pair[int, string]{{1, "z"}, {2, "b"}, {3, "a"}, {3, "c"}}, extraerTodos(h))
+}
+
+func TestCompareTriple(t *testing.T) {
+	a := MakeTriple(1, "x", 2.5)
+
+	assert.Equal(t, 0, CompareTriple(a, MakeTriple(1, "x", 2.5)))
+	assert.Negative(t, CompareTriple(a, MakeTriple(1, "x", 3.0)))
+	assert.Positive(t, CompareTriple(a, MakeTriple(1, "w", 9.0)))
+	assert.Negative(t, CompareTriple(a, MakeTriple(2, "a", 0.0)))
+}
+
+func TestPairComparatorConCampoInvertido(t *testing.T) {
+	type jugador struct{ nombre string }
+	comp := PairComparator(Reverse(cmp.Compare[int]), func(a, b jugador) int {
+		return cmp.Compare(a.nombre, b.nombre)
+	})
+	h := NewGenericHeap(comp)
+	h.Insert(MakePair(10, jugador{"beto"}))
+	h.Insert(MakePair(30, jugador{"carla"}))
+	h.Insert(MakePair(10, jugador{"ana"}))
+
+	var nombres []string
+	for _, p := range extraerTodos(h) {
+		nombres = append(nombres, p.Second.nombre)
+	}
+	assert.Equal(t, []string{"carla", "ana", "beto"}, nombres)
+}
+
+func TestTripleComparator(t *testing.T) {
+	comp := TripleComparator(cmp.Compare[int], Reverse(cmp.Compare[int]), cmp.Compare[string])
+	h := NewGenericHeap(comp)
+	h.Insert(MakeTriple(1, 1, "b"))
+	h.Insert(MakeTriple(1, 2, "z"))
+	h.Insert(MakeTriple(1, 1, "a"))
+	h.Insert(MakeTriple(0, 0, "q"))
+
+	assert.Equal(t, []Triple[int, int, string]{
+		{0, 0, "q"}, {1, 2, "z"}, {1, 1, "a"}, {1, 1, "b"},
+	}, extraerTodos(h))
+}
+
+func TestTuplasString(t *testing.T) {
+	assert.Equal(t, "(1, a)", MakePair(1, "a").String())
+	assert.Equal(t, "(1, a, true)", MakeTriple(1, "a", true).String())
+}
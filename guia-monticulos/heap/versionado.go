@@ -0,0 +1,129 @@
+package heap
+
+import "fmt"
+
+// ErrVersionInexistente indica que se consultó una versión que no existe.
+var ErrVersionInexistente error = &errorLocalizado{es: "versión inexistente", en: "missing version"}
+
+// VersionedHeap es un heap que guarda todas sus versiones: cada Insert o
+// Remove crea una versión nueva, numerada a partir de 1 (la versión 0 es el
+// heap vacío), y cualquier versión anterior se puede consultar. Está armado
+// sobre HeapPersistente, así que cada versión ocupa O(log n) de memoria
+// adicional.
+type VersionedHeap[T any] struct {
+	versiones []HeapPersistente[T]
+}
+
+// NewVersionedHeap crea un heap versionado vacío.
+//
+// Uso:
+//
+//	h := heap.NewVersionedHeap(cmp.Compare[int])
+//	v := h.Insert(5)
+//	tam, _ := h.SizeAt(v - 1)
+//
+// Parámetros:
+//   - `comp` función de comparación, con la misma convención que NewGenericHeap.
+//
+// Retorna:
+//   - un puntero al heap, en la versión 0.
+func NewVersionedHeap[T any](comp func(a T, b T) int) *VersionedHeap[T] {
+	return &VersionedHeap[T]{versiones: []HeapPersistente[T]{NewHeapPersistente(comp)}}
+}
+
+// Version retorna el número de la versión actual.
+func (h *VersionedHeap[T]) Version() int {
+	return len(h.versiones) - 1
+}
+
+func (h *VersionedHeap[T]) actual() HeapPersistente[T] {
+	return h.versiones[len(h.versiones)-1]
+}
+
+// Size retorna la cantidad de elementos de la versión actual.
+func (h *VersionedHeap[T]) Size() int {
+	return h.actual().Size()
+}
+
+// Insert agrega un elemento.
+//
+// Retorna:
+//   - el número de la versión creada.
+func (h *VersionedHeap[T]) Insert(element T) int {
+	h.versiones = append(h.versiones, h.actual().Insert(element))
+
+	return h.Version()
+}
+
+// Remove elimina y retorna el elemento en la cima. Si el heap está vacío no
+// se crea una versión nueva.
+//
+// Retorna:
+//   - el elemento en la cima del heap.
+//   - el número de la versión creada.
+//   - un error que envuelve a ErrHeapVacio si el heap no tiene elementos.
+func (h *VersionedHeap[T]) Remove() (T, int, error) {
+	v, resto, err := h.actual().Remove()
+	if err != nil {
+		return v, h.Version(), err
+	}
+	h.versiones = append(h.versiones, resto)
+
+	return v, h.Version(), nil
+}
+
+// At retorna la versión pedida como un heap persistente, que se puede seguir
+// modificando sin afectar al heap versionado.
+//
+// Retorna:
+//   - el heap en esa versión.
+//   - un error que envuelve a ErrVersionInexistente si la versión no existe.
+func (h *VersionedHeap[T]) At(version int) (HeapPersistente[T], error) {
+	if version < 0 || version >= len(h.versiones) {
+		return HeapPersistente[T]{}, fmt.Errorf(Localizar("versión %d: %w", "version %d: %w"), version, ErrVersionInexistente)
+	}
+
+	return h.versiones[version], nil
+}
+
+// PeekAt retorna la cima de una versión.
+//
+// Retorna:
+//   - el elemento en la cima.
+//   - un error que envuelve a ErrVersionInexistente si la versión no existe,
+//     o a ErrHeapVacio si esa versión no tenía elementos.
+func (h *VersionedHeap[T]) PeekAt(version int) (T, error) {
+	p, err := h.At(version)
+	if err != nil {
+		var cero T
+		return cero, err
+	}
+
+	return p.Peek()
+}
+
+// SizeAt retorna la cantidad de elementos de una versión.
+//
+// Retorna:
+//   - la cantidad de elementos.
+//   - un error que envuelve a ErrVersionInexistente si la versión no existe.
+func (h *VersionedHeap[T]) SizeAt(version int) (int, error) {
+	p, err := h.At(version)
+
+	return p.Size(), err
+}
+
+// ToSliceAt retorna los elementos de una versión en el orden en que
+// saldrían del heap.
+//
+// Retorna:
+//   - los elementos.
+//   - un error que envuelve a ErrVersionInexistente si la versión no existe.
+func (h *VersionedHeap[T]) ToSliceAt(version int) ([]T, error) {
+	p, err := h.At(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.ToSlice(), nil
+}
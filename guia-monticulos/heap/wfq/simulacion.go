@@ -0,0 +1,81 @@
+package wfq
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Envio es la transmisión de un paquete por el enlace.
+type Envio struct {
+	Paquete Paquete
+	Inicio  float64
+	Fin     float64
+}
+
+// Simular transmite los paquetes por un enlace de la capacidad indicada,
+// despachándolos con WFQ. El enlace transmite un paquete por vez y, al
+// quedar libre, elige entre los paquetes que ya llegaron.
+//
+// Uso:
+//
+//	envios, err := wfq.Simular(1000, map[string]float64{"a": 1, "b": 2}, paquetes)
+//
+// Parámetros:
+//   - `capacidad` unidades de tamaño que el enlace transmite por unidad de tiempo.
+//   - `pesos` peso de cada flujo.
+//   - `paquetes` paquetes en cualquier orden.
+//
+// Retorna:
+//   - los envíos en el orden en que ocurrieron.
+//   - un error si algún parámetro es inválido.
+func Simular(capacidad float64, pesos map[string]float64, paquetes []Paquete) ([]Envio, error) {
+	if !(capacidad > 0) {
+		return nil, fmt.Errorf("%w: capacidad %v", ErrParametroInvalido, capacidad)
+	}
+	q := New()
+	for flujo, peso := range pesos {
+		if err := q.AddFlujo(flujo, peso); err != nil {
+			return nil, err
+		}
+	}
+	pendientes := append([]Paquete(nil), paquetes...)
+	sort.SliceStable(pendientes, func(i, j int) bool { return pendientes[i].Llegada < pendientes[j].Llegada })
+
+	envios := make([]Envio, 0, len(pendientes))
+	ahora := 0.0
+	for len(pendientes) > 0 || q.Len() > 0 {
+		if q.Len() == 0 {
+			ahora = max(ahora, pendientes[0].Llegada)
+		}
+		for len(pendientes) > 0 && pendientes[0].Llegada <= ahora {
+			if err := q.Enqueue(pendientes[0]); err != nil {
+				return nil, err
+			}
+			pendientes = pendientes[1:]
+		}
+		p, _ := q.Dequeue()
+		fin := ahora + float64(p.Tamano)/capacidad
+		envios = append(envios, Envio{Paquete: p, Inicio: ahora, Fin: fin})
+		ahora = fin
+	}
+
+	return envios, nil
+}
+
+// Throughput retorna cuánto transmitió cada flujo hasta el instante `hasta`,
+// contando proporcionalmente los envíos en curso.
+func Throughput(envios []Envio, hasta float64) map[string]float64 {
+	r := make(map[string]float64)
+	for _, e := range envios {
+		if e.Inicio >= hasta {
+			continue
+		}
+		transmitido := float64(e.Paquete.Tamano)
+		if e.Fin > hasta {
+			transmitido *= (hasta - e.Inicio) / (e.Fin - e.Inicio)
+		}
+		r[e.Paquete.Flujo] += transmitido
+	}
+
+	return r
+}
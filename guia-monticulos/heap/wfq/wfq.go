@@ -0,0 +1,134 @@
+// Package wfq implementa weighted fair queuing: varios flujos comparten un
+// enlace y cada uno recibe una parte del ancho de banda proporcional a su
+// peso. A cada paquete se le asigna un tiempo virtual de finalización y se
+// despacha siempre el de menor tiempo, usando un heap de mínimos.
+//
+// El tiempo virtual se calcula como en self-clocked fair queuing (SCFQ): es
+// el tiempo de finalización del último paquete despachado, lo que evita
+// simular el sistema fluido de referencia y da la misma equidad a largo plazo.
+package wfq
+
+import (
+	"errors"
+	"fmt"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+var (
+	// ErrFlujoDesconocido indica un paquete de un flujo que no se registró.
+	ErrFlujoDesconocido = errors.New("flujo desconocido")
+	// ErrParametroInvalido indica un peso, tamaño o capacidad no positivos.
+	ErrParametroInvalido = errors.New("parámetro inválido")
+	// ErrSinPaquetes indica que se pidió un paquete con la cola vacía.
+	ErrSinPaquetes = errors.New("no hay paquetes")
+)
+
+// Paquete es una unidad de datos de un flujo.
+type Paquete struct {
+	Flujo   string
+	Tamano  int
+	Llegada float64
+}
+
+type etiquetado struct {
+	Paquete
+	fin       float64
+	secuencia int
+}
+
+// WFQ es la cola de un enlace compartido por varios flujos.
+type WFQ struct {
+	pesos map[string]float64
+	// tiempo virtual de finalización del último paquete encolado de cada flujo
+	ultimoFin map[string]float64
+	virtual   float64
+	cola      *heap.Heap[etiquetado]
+	secuencia int
+}
+
+// New crea una cola sin flujos.
+//
+// Uso:
+//
+//	q := wfq.New()
+//	_ = q.AddFlujo("video", 3)
+//
+// Retorna:
+//   - un puntero a la cola.
+func New() *WFQ {
+	return &WFQ{
+		pesos:     make(map[string]float64),
+		ultimoFin: make(map[string]float64),
+		cola: heap.NewGenericHeap(func(a, b etiquetado) int {
+			if a.fin < b.fin {
+				return -1
+			}
+			if a.fin > b.fin {
+				return 1
+			}
+			return a.secuencia - b.secuencia
+		}),
+	}
+}
+
+// AddFlujo registra un flujo o cambia su peso.
+//
+// Retorna:
+//   - un error que envuelve a ErrParametroInvalido si el peso no es positivo.
+func (q *WFQ) AddFlujo(nombre string, peso float64) error {
+	if !(peso > 0) {
+		return fmt.Errorf("%w: peso %v del flujo %q", ErrParametroInvalido, peso, nombre)
+	}
+	q.pesos[nombre] = peso
+
+	return nil
+}
+
+// Enqueue encola un paquete asignándole su tiempo virtual de finalización:
+//
+//	fin = max(tiempo virtual, fin del paquete anterior del flujo) + tamaño / peso
+//
+// Retorna:
+//   - un error que envuelve a ErrFlujoDesconocido o a ErrParametroInvalido.
+func (q *WFQ) Enqueue(p Paquete) error {
+	peso, ok := q.pesos[p.Flujo]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrFlujoDesconocido, p.Flujo)
+	}
+	if p.Tamano <= 0 {
+		return fmt.Errorf("%w: tamaño %d", ErrParametroInvalido, p.Tamano)
+	}
+	fin := max(q.virtual, q.ultimoFin[p.Flujo]) + float64(p.Tamano)/peso
+	q.ultimoFin[p.Flujo] = fin
+	q.secuencia++
+	q.cola.Insert(etiquetado{Paquete: p, fin: fin, secuencia: q.secuencia})
+
+	return nil
+}
+
+// Dequeue retira el paquete con menor tiempo virtual de finalización y
+// avanza el tiempo virtual.
+//
+// Retorna:
+//   - el paquete.
+//   - ErrSinPaquetes si la cola está vacía.
+func (q *WFQ) Dequeue() (Paquete, error) {
+	e, err := q.cola.Remove()
+	if err != nil {
+		return Paquete{}, ErrSinPaquetes
+	}
+	q.virtual = e.fin
+	if q.cola.Size() == 0 {
+		// con el enlace ocioso se reinicia el reloj virtual, como en SCFQ
+		q.virtual = 0
+		clear(q.ultimoFin)
+	}
+
+	return e.Paquete, nil
+}
+
+// Len retorna la cantidad de paquetes encolados.
+func (q *WFQ) Len() int {
+	return q.cola.Size()
+}
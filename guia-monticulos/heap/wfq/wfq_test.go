@@ -0,0 +1,82 @@
+package wfq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rafaga(flujo string, n, tamano int, llegada float64) []Paquete {
+	r := make([]Paquete, n)
+	for i := range r {
+		r[i] = Paquete{Flujo: flujo, Tamano: tamano, Llegada: llegada}
+	}
+
+	return r
+}
+
+func TestDequeueIntercalaSegunPesos(t *testing.T) {
+	q := New()
+	assert.NoError(t, q.AddFlujo("a", 1))
+	assert.NoError(t, q.AddFlujo("b", 2))
+	for _, p := range append(rafaga("a", 4, 100, 0), rafaga("b", 4, 100, 0)...) {
+		assert.NoError(t, q.Enqueue(p))
+	}
+
+	var orden string
+	for q.Len() > 0 {
+		p, err := q.Dequeue()
+		assert.NoError(t, err)
+		orden += p.Flujo
+	}
+	assert.Equal(t, "babbabaa", orden)
+	_, err := q.Dequeue()
+	assert.ErrorIs(t, err, ErrSinPaquetes)
+}
+
+func TestSimularRepartePorPesos(t *testing.T) {
+	var paquetes []Paquete
+	paquetes = append(paquetes, rafaga("voz", 300, 100, 0)...)
+	paquetes = append(paquetes, rafaga("video", 300, 100, 0)...)
+	paquetes = append(paquetes, rafaga("datos", 300, 100, 0)...)
+
+	envios, err := Simular(1000, map[string]float64{"voz": 1, "video": 3, "datos": 1}, paquetes)
+	assert.NoError(t, err)
+	assert.Len(t, envios, 900)
+
+	// mientras los tres flujos tienen paquetes, video recibe 3/5 del enlace
+	tp := Throughput(envios, 40)
+	assert.InDelta(t, 8000, tp["voz"], 200)
+	assert.InDelta(t, 24000, tp["video"], 200)
+	assert.InDelta(t, 8000, tp["datos"], 200)
+}
+
+func TestSimularFlujoQueLlegaTardeNoEsPerjudicado(t *testing.T) {
+	paquetes := append(rafaga("viejo", 100, 100, 0), rafaga("nuevo", 10, 100, 5)...)
+
+	envios, err := Simular(100, map[string]float64{"viejo": 1, "nuevo": 1}, paquetes)
+	assert.NoError(t, err)
+
+	// al llegar, el flujo nuevo se alterna con el viejo en lugar de esperar
+	// a que termine la ráfaga acumulada
+	ultimoNuevo := 0.0
+	for _, e := range envios {
+		if e.Paquete.Flujo == "nuevo" {
+			ultimoNuevo = e.Fin
+		}
+	}
+	assert.Less(t, ultimoNuevo, 30.0)
+}
+
+func TestErrores(t *testing.T) {
+	q := New()
+	assert.ErrorIs(t, q.AddFlujo("a", 0), ErrParametroInvalido)
+	assert.EqualError(t, q.Enqueue(Paquete{Flujo: "x", Tamano: 1}), `flujo desconocido: "x"`)
+	assert.NoError(t, q.AddFlujo("a", 1))
+	assert.ErrorIs(t, q.Enqueue(Paquete{Flujo: "a"}), ErrParametroInvalido)
+
+	_, err := Simular(0, nil, nil)
+	assert.ErrorIs(t, err, ErrParametroInvalido)
+	_, err = Simular(1, map[string]float64{"a": 1}, []Paquete{{Flujo: "b", Tamano: 1}})
+	assert.ErrorIs(t, err, ErrFlujoDesconocido)
+}
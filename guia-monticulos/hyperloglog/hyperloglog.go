@@ -0,0 +1,141 @@
+// Package hyperloglog provee un HyperLogLog para estimar la cantidad de
+// elementos distintos de un stream usando memoria constante.
+package hyperloglog
+
+import (
+	"errors"
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog estima la cardinalidad de un conjunto con un error relativo
+// típico de 1.04/√m, siendo m = 2^p la cantidad de registros.
+type HyperLogLog struct {
+	registers []uint8
+	p         uint8
+}
+
+// NewHyperLogLog crea un sketch vacío con 2^p registros.
+//
+// Uso:
+//
+//	hll, _ := hyperloglog.NewHyperLogLog(14)
+//
+// Parámetros:
+//   - `p` precisión, entre 4 y 16.
+//
+// Retorna:
+//   - un puntero a un sketch vacío.
+//   - un error si la precisión está fuera de rango.
+func NewHyperLogLog(p uint8) (*HyperLogLog, error) {
+	if p < 4 || p > 16 {
+		return nil, errors.New("precisión fuera de rango")
+	}
+
+	return &HyperLogLog{registers: make([]uint8, 1<<p), p: p}, nil
+}
+
+// Precision retorna la precisión p del sketch.
+func (h *HyperLogLog) Precision() uint8 {
+	return h.p
+}
+
+// Add registra un elemento. O(1)
+//
+// Uso:
+//
+//	hll.Add("usuario-42")
+//
+// Parámetros:
+//   - `item` elemento observado.
+func (h *HyperLogLog) Add(item string) {
+	x := hash(item)
+	// los primeros p bits eligen el registro y el resto estima la rareza
+	index := x >> (64 - h.p)
+	rank := uint8(bits.LeadingZeros64(x<<h.p|1<<(h.p-1))) + 1
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+// Count retorna la cantidad estimada de elementos distintos. O(m)
+//
+// Uso:
+//
+//	distintos := hll.Count()
+//
+// Retorna:
+//   - la cardinalidad estimada.
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	estimate := alpha(len(h.registers)) * m * m / sum
+	// para cardinalidades chicas el conteo lineal es más preciso
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(estimate + 0.5)
+}
+
+// Merge incorpora otro sketch, de modo que el resultado estima la cardinalidad
+// de la unión de ambos conjuntos.
+//
+// Uso:
+//
+//	err := hll.Merge(otro)
+//
+// Parámetros:
+//   - `other` sketch a incorporar. No se modifica.
+//
+// Retorna:
+//   - un error si los sketches tienen distinta precisión.
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if other.p != h.p {
+		return errors.New("precisiones distintas")
+	}
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+
+	return nil
+}
+
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+
+	return 0.7213 / (1 + 1.079/float64(m))
+}
+
+// hash aplica FNV-1a y luego el mezclador de splitmix64, ya que HyperLogLog
+// necesita que todos los bits del hash estén bien distribuidos.
+func hash(item string) uint64 {
+	f := fnv.New64a()
+	_, _ = f.Write([]byte(item))
+	x := f.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
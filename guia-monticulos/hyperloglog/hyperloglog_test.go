@@ -0,0 +1,66 @@
+package hyperloglog
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func errorRelativo(estimado uint64, real int) float64 {
+	return math.Abs(float64(estimado)-float64(real)) / float64(real)
+}
+
+func TestNewHyperLogLogPrecisionInvalida(t *testing.T) {
+	_, err := NewHyperLogLog(3)
+	assert.EqualError(t, err, "precisión fuera de rango")
+
+	_, err = NewHyperLogLog(17)
+	assert.Error(t, err)
+}
+
+func TestHyperLogLogVacio(t *testing.T) {
+	hll, _ := NewHyperLogLog(10)
+	assert.Equal(t, uint64(0), hll.Count())
+}
+
+func TestHyperLogLogIgnoraRepetidos(t *testing.T) {
+	hll, _ := NewHyperLogLog(12)
+	for i := 0; i < 1000; i++ {
+		hll.Add(fmt.Sprintf("item-%d", i%10))
+	}
+
+	assert.Equal(t, uint64(10), hll.Count())
+}
+
+func TestHyperLogLogCount(t *testing.T) {
+	hll, _ := NewHyperLogLog(14)
+	for i := 0; i < 100000; i++ {
+		hll.Add(fmt.Sprintf("item-%d", i))
+	}
+
+	// el error típico con p = 14 es de 0.8%
+	assert.Less(t, errorRelativo(hll.Count(), 100000), 0.03)
+}
+
+func TestHyperLogLogMerge(t *testing.T) {
+	a, _ := NewHyperLogLog(12)
+	b, _ := NewHyperLogLog(12)
+	for i := 0; i < 30000; i++ {
+		a.Add(fmt.Sprintf("item-%d", i))
+	}
+	for i := 20000; i < 50000; i++ {
+		b.Add(fmt.Sprintf("item-%d", i))
+	}
+
+	assert.NoError(t, a.Merge(b))
+	assert.Less(t, errorRelativo(a.Count(), 50000), 0.05)
+}
+
+func TestHyperLogLogMergePrecisionesDistintas(t *testing.T) {
+	a, _ := NewHyperLogLog(10)
+	b, _ := NewHyperLogLog(11)
+
+	assert.EqualError(t, a.Merge(b), "precisiones distintas")
+}
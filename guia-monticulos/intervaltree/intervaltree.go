@@ -0,0 +1,300 @@
+// Package intervaltree provee un árbol de intervalos para consultas de
+// solapamiento.
+//
+// El árbol es un AVL ordenado por el extremo inferior de cada intervalo y
+// aumentado con el máximo extremo superior de cada subárbol, lo que permite
+// descartar ramas enteras al buscar solapamientos.
+package intervaltree
+
+import (
+	"cmp"
+	"errors"
+)
+
+var (
+	// ErrIntervaloInvalido indica que el extremo inferior es mayor que el superior.
+	ErrIntervaloInvalido = errors.New("intervalo inválido")
+	// ErrIntervaloRepetido indica que el intervalo ya estaba en el árbol.
+	ErrIntervaloRepetido = errors.New("intervalo repetido")
+)
+
+// Interval es un intervalo cerrado [Low, High].
+type Interval[T cmp.Ordered] struct {
+	Low  T
+	High T
+}
+
+// Overlaps indica si dos intervalos cerrados tienen al menos un punto en común.
+func (i Interval[T]) Overlaps(other Interval[T]) bool {
+	return i.Low <= other.High && other.Low <= i.High
+}
+
+// Contains indica si el punto pertenece al intervalo.
+func (i Interval[T]) Contains(point T) bool {
+	return i.Low <= point && point <= i.High
+}
+
+// compare ordena los intervalos por extremo inferior y luego por superior.
+func (i Interval[T]) compare(other Interval[T]) int {
+	if c := cmp.Compare(i.Low, other.Low); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(i.High, other.High)
+}
+
+type intervalNode[T cmp.Ordered] struct {
+	interval Interval[T]      // intervalo
+	maxHigh  T                // máximo extremo superior del subárbol
+	height   int              // altura
+	left     *intervalNode[T] // hijo izquierdo
+	right    *intervalNode[T] // hijo derecho
+}
+
+// IntervalTree es un árbol de intervalos. No admite intervalos repetidos.
+type IntervalTree[T cmp.Ordered] struct {
+	root *intervalNode[T]
+	size int
+}
+
+// NewIntervalTree crea un árbol de intervalos vacío.
+//
+// Uso:
+//
+//	tree := intervaltree.NewIntervalTree[int]()
+//
+// Retorna:
+//   - un puntero a un árbol de intervalos vacío.
+func NewIntervalTree[T cmp.Ordered]() *IntervalTree[T] {
+	return &IntervalTree[T]{}
+}
+
+// Size retorna la cantidad de intervalos almacenados.
+func (t *IntervalTree[T]) Size() int {
+	return t.size
+}
+
+// IsEmpty indica si el árbol no tiene intervalos.
+func (t *IntervalTree[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Insert agrega un intervalo al árbol. Como el árbol no admite intervalos
+// repetidos, si ya estaba no lo agrega y retorna un error. O(log n)
+//
+// Uso:
+//
+//	err := tree.Insert(intervaltree.Interval[int]{Low: 10, High: 20})
+//
+// Parámetros:
+//   - `interval` intervalo a agregar.
+//
+// Retorna:
+//   - ErrIntervaloInvalido si el extremo inferior es mayor que el superior.
+//   - ErrIntervaloRepetido si el intervalo ya estaba en el árbol.
+func (t *IntervalTree[T]) Insert(interval Interval[T]) error {
+	if interval.Low > interval.High {
+		return ErrIntervaloInvalido
+	}
+	var inserted bool
+	t.root, inserted = t.root.insert(interval)
+	if !inserted {
+		return ErrIntervaloRepetido
+	}
+	t.size++
+
+	return nil
+}
+
+// Delete elimina un intervalo del árbol. O(log n)
+//
+// Uso:
+//
+//	removed := tree.Delete(intervaltree.Interval[int]{Low: 10, High: 20})
+//
+// Parámetros:
+//   - `interval` intervalo a eliminar.
+//
+// Retorna:
+//   - true si el intervalo estaba en el árbol.
+func (t *IntervalTree[T]) Delete(interval Interval[T]) bool {
+	var removed bool
+	t.root, removed = t.root.remove(interval)
+	if removed {
+		t.size--
+	}
+
+	return removed
+}
+
+// QueryOverlaps retorna todos los intervalos que se solapan con el dado,
+// ordenados por extremo inferior. O(log n + k), con k la cantidad de resultados.
+//
+// Uso:
+//
+//	reservas := tree.QueryOverlaps(intervaltree.Interval[int]{Low: 15, High: 18})
+//
+// Parámetros:
+//   - `interval` intervalo de consulta.
+//
+// Retorna:
+//   - los intervalos almacenados que se solapan con el de consulta.
+func (t *IntervalTree[T]) QueryOverlaps(interval Interval[T]) []Interval[T] {
+	result := make([]Interval[T], 0)
+	t.root.collectOverlaps(interval, &result)
+
+	return result
+}
+
+// QueryPoint retorna todos los intervalos que contienen al punto dado.
+//
+// Uso:
+//
+//	ocupados := tree.QueryPoint(17)
+//
+// Parámetros:
+//   - `point` punto de consulta.
+//
+// Retorna:
+//   - los intervalos almacenados que contienen al punto.
+func (t *IntervalTree[T]) QueryPoint(point T) []Interval[T] {
+	return t.QueryOverlaps(Interval[T]{Low: point, High: point})
+}
+
+func (n *intervalNode[T]) getHeight() int {
+	if n == nil {
+		return -1
+	}
+
+	return n.height
+}
+
+func (n *intervalNode[T]) getBalance() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.left.getHeight() - n.right.getHeight()
+}
+
+// update recalcula la altura y el máximo extremo superior del nodo.
+func (n *intervalNode[T]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.maxHigh = n.interval.High
+	if n.left != nil && n.left.maxHigh > n.maxHigh {
+		n.maxHigh = n.left.maxHigh
+	}
+	if n.right != nil && n.right.maxHigh > n.maxHigh {
+		n.maxHigh = n.right.maxHigh
+	}
+}
+
+func (n *intervalNode[T]) rotateRight() *intervalNode[T] {
+	y := n.left
+	n.left = y.right
+	y.right = n
+
+	n.update()
+	y.update()
+
+	return y
+}
+
+func (n *intervalNode[T]) rotateLeft() *intervalNode[T] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+
+	n.update()
+	x.update()
+
+	return x
+}
+
+func (n *intervalNode[T]) applyRotation() *intervalNode[T] {
+	balance := n.getBalance()
+
+	if balance > 1 {
+		if n.left.getBalance() < 0 {
+			n.left = n.left.rotateLeft()
+		}
+
+		return n.rotateRight()
+	}
+
+	if balance < -1 {
+		if n.right.getBalance() > 0 {
+			n.right = n.right.rotateRight()
+		}
+
+		return n.rotateLeft()
+	}
+
+	return n
+}
+
+func (n *intervalNode[T]) insert(interval Interval[T]) (*intervalNode[T], bool) {
+	if n == nil {
+		return &intervalNode[T]{interval: interval, maxHigh: interval.High}, true
+	}
+
+	var inserted bool
+	switch c := interval.compare(n.interval); {
+	case c < 0:
+		n.left, inserted = n.left.insert(interval)
+	case c > 0:
+		n.right, inserted = n.right.insert(interval)
+	default:
+		return n, false
+	}
+	n.update()
+
+	return n.applyRotation(), inserted
+}
+
+func (n *intervalNode[T]) remove(interval Interval[T]) (*intervalNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+	switch c := interval.compare(n.interval); {
+	case c < 0:
+		n.left, removed = n.left.remove(interval)
+	case c > 0:
+		n.right, removed = n.right.remove(interval)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.interval = successor.interval
+		n.right, _ = n.right.remove(successor.interval)
+		removed = true
+	}
+	n.update()
+
+	return n.applyRotation(), removed
+}
+
+func (n *intervalNode[T]) collectOverlaps(interval Interval[T], result *[]Interval[T]) {
+	// ningún intervalo del subárbol termina después del inicio de la consulta
+	if n == nil || n.maxHigh < interval.Low {
+		return
+	}
+	n.left.collectOverlaps(interval, result)
+	if n.interval.Overlaps(interval) {
+		*result = append(*result, n.interval)
+	}
+	// a la derecha todos empiezan después de n, y n ya empieza tarde
+	if n.interval.Low > interval.High {
+		return
+	}
+	n.right.collectOverlaps(interval, result)
+}
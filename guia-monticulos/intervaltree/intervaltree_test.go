@@ -0,0 +1,100 @@
+package intervaltree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func nuevoArbolDeReservas() *IntervalTree[int] {
+	tree := NewIntervalTree[int]()
+	reservas := []Interval[int]{
+		{15, 20}, {10, 30}, {17, 19}, {5, 20}, {12, 15}, {30, 40},
+	}
+	for _, r := range reservas {
+		_ = tree.Insert(r)
+	}
+
+	return tree
+}
+
+func TestNewIntervalTree(t *testing.T) {
+	tree := NewIntervalTree[int]()
+
+	assert.True(t, tree.IsEmpty())
+	assert.Empty(t, tree.QueryPoint(3))
+}
+
+func TestIntervalTreeInsertInvalido(t *testing.T) {
+	tree := NewIntervalTree[int]()
+
+	err := tree.Insert(Interval[int]{Low: 5, High: 1})
+	assert.ErrorIs(t, err, ErrIntervaloInvalido)
+	assert.EqualError(t, err, "intervalo inválido")
+	assert.True(t, tree.IsEmpty())
+}
+
+func TestIntervalTreeInsertRepetido(t *testing.T) {
+	tree := NewIntervalTree[int]()
+
+	assert.NoError(t, tree.Insert(Interval[int]{1, 2}))
+	err := tree.Insert(Interval[int]{1, 2})
+	assert.ErrorIs(t, err, ErrIntervaloRepetido)
+	assert.Equal(t, 1, tree.Size())
+	assert.Equal(t, []Interval[int]{{1, 2}}, tree.QueryPoint(1))
+}
+
+func TestIntervalTreeQueryOverlaps(t *testing.T) {
+	tree := nuevoArbolDeReservas()
+
+	assert.Equal(t, 6, tree.Size())
+	assert.Equal(t,
+		[]Interval[int]{{5, 20}, {10, 30}, {15, 20}, {17, 19}},
+		tree.QueryOverlaps(Interval[int]{Low: 16, High: 18}))
+	assert.Equal(t,
+		[]Interval[int]{{10, 30}, {30, 40}},
+		tree.QueryOverlaps(Interval[int]{Low: 25, High: 35}))
+	assert.Empty(t, tree.QueryOverlaps(Interval[int]{Low: 41, High: 50}))
+}
+
+func TestIntervalTreeQueryPoint(t *testing.T) {
+	tree := nuevoArbolDeReservas()
+
+	assert.Equal(t, []Interval[int]{{5, 20}, {10, 30}, {12, 15}, {15, 20}}, tree.QueryPoint(15))
+	assert.Equal(t, []Interval[int]{{5, 20}}, tree.QueryPoint(7))
+}
+
+func TestIntervalTreeDelete(t *testing.T) {
+	tree := nuevoArbolDeReservas()
+
+	assert.True(t, tree.Delete(Interval[int]{10, 30}))
+	assert.False(t, tree.Delete(Interval[int]{10, 30}))
+	assert.Equal(t, 5, tree.Size())
+	assert.Equal(t, []Interval[int]{{30, 40}}, tree.QueryPoint(30))
+}
+
+func TestIntervalTreeContraFuerzaBruta(t *testing.T) {
+	tree := NewIntervalTree[int]()
+	intervalos := make([]Interval[int], 0)
+	for i := 0; i < 60; i++ {
+		low := (i * 37) % 100
+		iv := Interval[int]{Low: low, High: low + (i*13)%17}
+		if tree.Insert(iv) == nil {
+			intervalos = append(intervalos, iv)
+		}
+	}
+	for i := 0; i < len(intervalos); i += 3 {
+		tree.Delete(intervalos[i])
+	}
+
+	for q := 0; q < 120; q += 7 {
+		consulta := Interval[int]{Low: q, High: q + 5}
+		esperado := make([]Interval[int], 0)
+		for i, iv := range intervalos {
+			if i%3 != 0 && iv.Overlaps(consulta) {
+				esperado = append(esperado, iv)
+			}
+		}
+		assert.ElementsMatch(t, esperado, tree.QueryOverlaps(consulta))
+	}
+}
@@ -0,0 +1,207 @@
+// Package kdtree provee un k-d tree para búsquedas espaciales en 2 o 3
+// dimensiones.
+package kdtree
+
+import (
+	"errors"
+	"math"
+)
+
+// Point es un punto de k coordenadas.
+type Point []float64
+
+// distanciaCuadrada retorna el cuadrado de la distancia euclídea entre dos puntos.
+func (p Point) distanciaCuadrada(q Point) float64 {
+	var d float64
+	for i := range p {
+		diff := p[i] - q[i]
+		d += diff * diff
+	}
+
+	return d
+}
+
+// copiar retorna un punto nuevo con las mismas coordenadas.
+func (p Point) copiar() Point {
+	q := make(Point, len(p))
+	copy(q, p)
+
+	return q
+}
+
+type kdNode struct {
+	point Point   // punto almacenado
+	left  *kdNode // puntos con coordenada de corte menor
+	right *kdNode // puntos con coordenada de corte mayor o igual
+}
+
+// KDTree es un k-d tree. En cada nivel se parte el espacio según una
+// coordenada distinta, alternándolas de forma cíclica.
+type KDTree struct {
+	root *kdNode
+	k    int
+	size int
+}
+
+// NewKDTree crea un k-d tree vacío de la dimensión indicada.
+//
+// Uso:
+//
+//	tree, _ := kdtree.NewKDTree(2)
+//
+// Parámetros:
+//   - `k` cantidad de coordenadas de los puntos (2 o 3).
+//
+// Retorna:
+//   - un puntero a un k-d tree vacío.
+//   - un error si la dimensión no es 2 ni 3.
+func NewKDTree(k int) (*KDTree, error) {
+	if k != 2 && k != 3 {
+		return nil, errors.New("dimensión no soportada")
+	}
+
+	return &KDTree{k: k}, nil
+}
+
+// Size retorna la cantidad de puntos almacenados.
+func (t *KDTree) Size() int {
+	return t.size
+}
+
+// IsEmpty indica si el árbol no tiene puntos.
+func (t *KDTree) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Insert agrega un punto al árbol. O(log n) en promedio.
+//
+// Uso:
+//
+//	err := tree.Insert(kdtree.Point{3, 6})
+//
+// Parámetros:
+//   - `p` punto a agregar. Se copia, por lo que modificarlo luego no afecta al árbol.
+//
+// Retorna:
+//   - un error si el punto no tiene la dimensión del árbol.
+func (t *KDTree) Insert(p Point) error {
+	if len(p) != t.k {
+		return errors.New("dimensión inválida")
+	}
+	point := p.copiar()
+
+	link := &t.root
+	for depth := 0; *link != nil; depth++ {
+		axis := depth % t.k
+		if point[axis] < (*link).point[axis] {
+			link = &(*link).left
+		} else {
+			link = &(*link).right
+		}
+	}
+	*link = &kdNode{point: point}
+	t.size++
+
+	return nil
+}
+
+// Nearest retorna el punto del árbol más cercano al dado.
+//
+// Uso:
+//
+//	p, err := tree.Nearest(kdtree.Point{9, 2})
+//
+// Parámetros:
+//   - `target` punto de consulta.
+//
+// Retorna:
+//   - una copia del punto más cercano según la distancia euclídea, que se
+//     puede modificar sin afectar al árbol.
+//   - un error si el árbol está vacío o el punto no tiene la dimensión del árbol.
+func (t *KDTree) Nearest(target Point) (Point, error) {
+	if len(target) != t.k {
+		return nil, errors.New("dimensión inválida")
+	}
+	if t.root == nil {
+		return nil, errors.New("árbol vacío")
+	}
+
+	var best *kdNode
+	bestDist := math.Inf(1)
+	t.nearest(t.root, target, 0, &best, &bestDist)
+
+	return best.point.copiar(), nil
+}
+
+func (t *KDTree) nearest(n *kdNode, target Point, depth int, best **kdNode, bestDist *float64) {
+	if n == nil {
+		return
+	}
+	if d := n.point.distanciaCuadrada(target); d < *bestDist {
+		*best, *bestDist = n, d
+	}
+
+	axis := depth % t.k
+	diff := target[axis] - n.point[axis]
+	near, far := n.left, n.right
+	if diff >= 0 {
+		near, far = n.right, n.left
+	}
+
+	t.nearest(near, target, depth+1, best, bestDist)
+	// sólo hace falta mirar del otro lado si la hiperesfera cruza el plano de corte
+	if diff*diff < *bestDist {
+		t.nearest(far, target, depth+1, best, bestDist)
+	}
+}
+
+// RangeSearch retorna todos los puntos contenidos en el rectángulo (o prisma)
+// alineado a los ejes con esquinas `min` y `max`, bordes incluidos.
+//
+// Uso:
+//
+//	puntos, err := tree.RangeSearch(kdtree.Point{0, 0}, kdtree.Point{5, 5})
+//
+// Parámetros:
+//   - `min` esquina con las coordenadas menores.
+//   - `max` esquina con las coordenadas mayores.
+//
+// Retorna:
+//   - copias de los puntos dentro de la región, que se pueden modificar sin
+//     afectar al árbol.
+//   - un error si las esquinas no tienen la dimensión del árbol.
+func (t *KDTree) RangeSearch(min, max Point) ([]Point, error) {
+	if len(min) != t.k || len(max) != t.k {
+		return nil, errors.New("dimensión inválida")
+	}
+	result := make([]Point, 0)
+	t.rangeSearch(t.root, min, max, 0, &result)
+
+	return result, nil
+}
+
+func (t *KDTree) rangeSearch(n *kdNode, min, max Point, depth int, result *[]Point) {
+	if n == nil {
+		return
+	}
+
+	inside := true
+	for i := 0; i < t.k; i++ {
+		if n.point[i] < min[i] || n.point[i] > max[i] {
+			inside = false
+
+			break
+		}
+	}
+	if inside {
+		*result = append(*result, n.point.copiar())
+	}
+
+	axis := depth % t.k
+	if min[axis] < n.point[axis] {
+		t.rangeSearch(n.left, min, max, depth+1, result)
+	}
+	if max[axis] >= n.point[axis] {
+		t.rangeSearch(n.right, min, max, depth+1, result)
+	}
+}
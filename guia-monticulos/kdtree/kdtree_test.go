@@ -0,0 +1,109 @@
+package kdtree
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func nuevoArbol2D(t *testing.T) *KDTree {
+	tree, err := NewKDTree(2)
+	assert.NoError(t, err)
+	for _, p := range []Point{{2, 3}, {5, 4}, {9, 6}, {4, 7}, {8, 1}, {7, 2}} {
+		assert.NoError(t, tree.Insert(p))
+	}
+
+	return tree
+}
+
+func TestNewKDTree(t *testing.T) {
+	tree, err := NewKDTree(3)
+	assert.NoError(t, err)
+	assert.True(t, tree.IsEmpty())
+
+	_, err = NewKDTree(4)
+	assert.EqualError(t, err, "dimensión no soportada")
+}
+
+func TestKDTreeInsertDimensionInvalida(t *testing.T) {
+	tree, _ := NewKDTree(2)
+
+	assert.EqualError(t, tree.Insert(Point{1, 2, 3}), "dimensión inválida")
+	assert.Equal(t, 0, tree.Size())
+}
+
+func TestKDTreeNearestVacio(t *testing.T) {
+	tree, _ := NewKDTree(2)
+
+	_, err := tree.Nearest(Point{1, 1})
+	assert.EqualError(t, err, "árbol vacío")
+}
+
+func TestKDTreeNearest(t *testing.T) {
+	tree := nuevoArbol2D(t)
+
+	p, err := tree.Nearest(Point{9, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, Point{8, 1}, p)
+
+	p, _ = tree.Nearest(Point{3, 6})
+	assert.Equal(t, Point{4, 7}, p)
+}
+
+func TestKDTreeRangeSearch(t *testing.T) {
+	tree := nuevoArbol2D(t)
+
+	puntos, err := tree.RangeSearch(Point{4, 1}, Point{8, 4})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []Point{{5, 4}, {8, 1}, {7, 2}}, puntos)
+}
+
+func TestKDTreeResultadosNoCompartenMemoria(t *testing.T) {
+	tree := nuevoArbol2D(t)
+
+	p, _ := tree.Nearest(Point{9, 2})
+	p[0] = 100
+	puntos, _ := tree.RangeSearch(Point{4, 1}, Point{8, 4})
+	for _, q := range puntos {
+		q[1] = -100
+	}
+
+	p, _ = tree.Nearest(Point{9, 2})
+	assert.Equal(t, Point{8, 1}, p)
+	puntos, _ = tree.RangeSearch(Point{4, 1}, Point{8, 4})
+	assert.ElementsMatch(t, []Point{{5, 4}, {8, 1}, {7, 2}}, puntos)
+}
+
+func TestKDTree3DContraFuerzaBruta(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree, _ := NewKDTree(3)
+	puntos := make([]Point, 0)
+	for i := 0; i < 300; i++ {
+		p := Point{r.Float64() * 100, r.Float64() * 100, r.Float64() * 100}
+		puntos = append(puntos, p)
+		_ = tree.Insert(p)
+	}
+
+	for i := 0; i < 50; i++ {
+		q := Point{r.Float64() * 100, r.Float64() * 100, r.Float64() * 100}
+		esperado := puntos[0]
+		for _, p := range puntos {
+			if p.distanciaCuadrada(q) < esperado.distanciaCuadrada(q) {
+				esperado = p
+			}
+		}
+		obtenido, _ := tree.Nearest(q)
+		assert.Equal(t, esperado, obtenido)
+	}
+
+	min, max := Point{20, 30, 10}, Point{60, 50, 90}
+	esperado := make([]Point, 0)
+	for _, p := range puntos {
+		if p[0] >= min[0] && p[0] <= max[0] && p[1] >= min[1] && p[1] <= max[1] && p[2] >= min[2] && p[2] <= max[2] {
+			esperado = append(esperado, p)
+		}
+	}
+	obtenidos, _ := tree.RangeSearch(min, max)
+	assert.ElementsMatch(t, esperado, obtenidos)
+}
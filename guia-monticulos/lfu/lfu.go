@@ -0,0 +1,175 @@
+// Package lfu provee un cache genérico de capacidad fija con política de
+// expulsión LFU (el menos usado frecuentemente).
+package lfu
+
+import (
+	"errors"
+
+	"untref/ayp2/monticulo/heap"
+)
+
+type entrada[V any] struct {
+	valor      V
+	frecuencia int
+	acceso     uint64 // instante lógico del último acceso
+}
+
+// candidato es una entrada del heap de expulsión. Refleja el estado de una
+// clave en un momento dado y puede quedar desactualizado.
+type candidato[K comparable] struct {
+	clave      K
+	frecuencia int
+	acceso     uint64
+}
+
+// LFU es un cache de capacidad fija que, al llenarse, expulsa la clave con
+// menos accesos. Entre claves con la misma frecuencia expulsa la que fue
+// accedida hace más tiempo.
+//
+// Las claves se ordenan en un heap de mínimo por (frecuencia, último acceso).
+// Como el heap no permite modificar prioridades, cada acceso agrega una
+// entrada nueva y las desactualizadas se descartan al llegar a la cima.
+//
+// El último acceso se mide con un reloj lógico propio en lugar de delegar el
+// desempate al heap: el paquete heap no ofrece una inserción estable, y aunque
+// la ofreciera no alcanzaría, porque compactar reinserta las claves en el
+// orden arbitrario de un map y perdería la antigüedad relativa. El reloj,
+// además, es lo que permite reconocer los candidatos desactualizados.
+type LFU[K comparable, V any] struct {
+	capacidad int
+	entradas  map[K]*entrada[V]
+	reloj     uint64 // cuenta los accesos; desempata y detecta candidatos viejos
+	heap      *heap.Heap[candidato[K]]
+}
+
+// NewLFU crea un cache vacío.
+//
+// Uso:
+//
+//	cache, _ := lfu.NewLFU[string, int](100)
+//
+// Parámetros:
+//   - `capacidad` cantidad máxima de entradas.
+//
+// Retorna:
+//   - un puntero a un cache vacío.
+//   - un error si la capacidad no es positiva.
+func NewLFU[K comparable, V any](capacidad int) (*LFU[K, V], error) {
+	if capacidad < 1 {
+		return nil, errors.New("capacidad inválida")
+	}
+
+	return &LFU[K, V]{
+		capacidad: capacidad,
+		entradas:  make(map[K]*entrada[V], capacidad),
+		heap:      heap.NewGenericHeap[candidato[K]](menosFrecuenteYAntiguo[K]),
+	}, nil
+}
+
+// Size retorna la cantidad de entradas del cache.
+func (c *LFU[K, V]) Size() int {
+	return len(c.entradas)
+}
+
+// Get retorna el valor asociado a la clave e incrementa su frecuencia.
+// O(log n) amortizado.
+//
+// Uso:
+//
+//	if v, ok := cache.Get("a"); ok {
+//		fmt.Println(v)
+//	}
+//
+// Parámetros:
+//   - `clave` clave a buscar.
+//
+// Retorna:
+//   - el valor asociado a la clave.
+//   - true si la clave estaba en el cache.
+func (c *LFU[K, V]) Get(clave K) (V, bool) {
+	e, ok := c.entradas[clave]
+	if !ok {
+		var valor V
+
+		return valor, false
+	}
+	c.registrarAcceso(clave, e)
+
+	return e.valor, true
+}
+
+// Put asocia el valor a la clave e incrementa su frecuencia. Si el cache está
+// lleno, expulsa la clave menos usada. O(log n) amortizado.
+//
+// Uso:
+//
+//	cache.Put("a", 1)
+//
+// Parámetros:
+//   - `clave` clave a agregar o actualizar.
+//   - `valor` valor a asociar.
+func (c *LFU[K, V]) Put(clave K, valor V) {
+	e, ok := c.entradas[clave]
+	if !ok {
+		if len(c.entradas) == c.capacidad {
+			c.expulsar()
+		}
+		e = &entrada[V]{}
+		c.entradas[clave] = e
+	}
+	e.valor = valor
+	c.registrarAcceso(clave, e)
+}
+
+// Frecuencia retorna la cantidad de accesos registrados para la clave.
+func (c *LFU[K, V]) Frecuencia(clave K) int {
+	if e, ok := c.entradas[clave]; ok {
+		return e.frecuencia
+	}
+
+	return 0
+}
+
+func (c *LFU[K, V]) registrarAcceso(clave K, e *entrada[V]) {
+	c.reloj++
+	e.frecuencia++
+	e.acceso = c.reloj
+	c.heap.Insert(candidato[K]{clave: clave, frecuencia: e.frecuencia, acceso: e.acceso})
+
+	// cada clave tiene a lo sumo una entrada vigente en el heap
+	if c.heap.Size() > 2*c.capacidad {
+		c.compactar()
+	}
+}
+
+func (c *LFU[K, V]) expulsar() {
+	for c.heap.Size() > 0 {
+		cand, _ := c.heap.Remove()
+		if e, ok := c.entradas[cand.clave]; ok && e.acceso == cand.acceso {
+			delete(c.entradas, cand.clave)
+
+			return
+		}
+	}
+}
+
+// compactar reconstruye el heap sólo con las entradas vigentes.
+func (c *LFU[K, V]) compactar() {
+	c.heap = heap.NewGenericHeap[candidato[K]](menosFrecuenteYAntiguo[K])
+	for clave, e := range c.entradas {
+		c.heap.Insert(candidato[K]{clave: clave, frecuencia: e.frecuencia, acceso: e.acceso})
+	}
+}
+
+func menosFrecuenteYAntiguo[K comparable](a, b candidato[K]) int {
+	switch {
+	case a.frecuencia != b.frecuencia:
+		return a.frecuencia - b.frecuencia
+	case a.acceso < b.acceso:
+		return -1
+	case a.acceso > b.acceso:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,74 @@
+package lfu
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLFUCapacidadInvalida(t *testing.T) {
+	_, err := NewLFU[string, int](-1)
+	assert.EqualError(t, err, "capacidad inválida")
+}
+
+func TestLFUPutYGet(t *testing.T) {
+	cache, _ := NewLFU[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("a", 2)
+
+	v, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 3, cache.Frecuencia("a"))
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Frecuencia("b"))
+}
+
+func TestLFUExpulsaElMenosFrecuente(t *testing.T) {
+	cache, _ := NewLFU[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	cache.Put("c", 3)
+
+	_, ok := cache.Get("b")
+	assert.False(t, ok)
+	_, ok = cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 2, cache.Size())
+}
+
+func TestLFUDesempataPorAntiguedad(t *testing.T) {
+	cache, _ := NewLFU[int, string](3)
+	cache.Put(1, "uno")
+	cache.Put(2, "dos")
+	cache.Put(3, "tres")
+	cache.Get(1)
+	cache.Get(2)
+	cache.Get(3)
+	// las tres claves tienen frecuencia 2; la 1 es la accedida hace más tiempo
+	cache.Put(4, "cuatro")
+
+	_, ok := cache.Get(1)
+	assert.False(t, ok)
+	assert.Equal(t, 3, cache.Size())
+}
+
+func TestLFUMuchosAccesosCompactaElHeap(t *testing.T) {
+	cache, _ := NewLFU[int, int](4)
+	for i := 0; i < 1000; i++ {
+		cache.Put(i%3, i)
+	}
+	cache.Put(10, 10)
+	cache.Put(11, 11)
+
+	assert.LessOrEqual(t, cache.heap.Size(), 8)
+	_, ok := cache.Get(10)
+	assert.False(t, ok)
+	for k := 0; k < 3; k++ {
+		_, ok := cache.Get(k)
+		assert.True(t, ok)
+	}
+}
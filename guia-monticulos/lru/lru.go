@@ -0,0 +1,195 @@
+// Package lru provee un cache genérico de capacidad fija con política de
+// expulsión LRU (el menos usado recientemente).
+package lru
+
+import "errors"
+
+// nodo es un nodo de la lista doblemente enlazada de claves.
+type nodo[K comparable] struct {
+	clave K
+	prev  *nodo[K]
+	next  *nodo[K]
+}
+
+type entrada[K comparable, V any] struct {
+	valor V
+	nodo  *nodo[K]
+}
+
+// LRU es un cache de capacidad fija. Las claves se mantienen en una lista
+// doblemente enlazada ordenada de la más reciente a la más antigua, y un map
+// permite llegar al nodo de cada clave en O(1).
+type LRU[K comparable, V any] struct {
+	capacidad int
+	entradas  map[K]*entrada[K, V]
+	head      *nodo[K] // clave usada más recientemente
+	tail      *nodo[K] // clave usada hace más tiempo
+	onEvict   func(clave K, valor V)
+}
+
+// NewLRU crea un cache vacío.
+//
+// Uso:
+//
+//	cache, _ := lru.NewLRU[string, int](100, func(k string, v int) {
+//		fmt.Println("expulsado", k)
+//	})
+//
+// Parámetros:
+//   - `capacidad` cantidad máxima de entradas.
+//   - `onEvict` función que se invoca con cada entrada expulsada por falta de
+//     espacio. Puede ser nil.
+//
+// Retorna:
+//   - un puntero a un cache vacío.
+//   - un error si la capacidad no es positiva.
+func NewLRU[K comparable, V any](capacidad int, onEvict func(clave K, valor V)) (*LRU[K, V], error) {
+	if capacidad < 1 {
+		return nil, errors.New("capacidad inválida")
+	}
+
+	return &LRU[K, V]{
+		capacidad: capacidad,
+		entradas:  make(map[K]*entrada[K, V], capacidad),
+		onEvict:   onEvict,
+	}, nil
+}
+
+// Size retorna la cantidad de entradas del cache.
+func (c *LRU[K, V]) Size() int {
+	return len(c.entradas)
+}
+
+// Capacity retorna la cantidad máxima de entradas del cache.
+func (c *LRU[K, V]) Capacity() int {
+	return c.capacidad
+}
+
+// Get retorna el valor asociado a la clave y la marca como la más reciente. O(1)
+//
+// Uso:
+//
+//	if v, ok := cache.Get("a"); ok {
+//		fmt.Println(v)
+//	}
+//
+// Parámetros:
+//   - `clave` clave a buscar.
+//
+// Retorna:
+//   - el valor asociado a la clave.
+//   - true si la clave estaba en el cache.
+func (c *LRU[K, V]) Get(clave K) (V, bool) {
+	e, ok := c.entradas[clave]
+	if !ok {
+		var valor V
+
+		return valor, false
+	}
+	c.moverAlFrente(e.nodo)
+
+	return e.valor, true
+}
+
+// Put asocia el valor a la clave y la marca como la más reciente. Si el cache
+// está lleno, expulsa la entrada usada hace más tiempo. O(1)
+//
+// Uso:
+//
+//	cache.Put("a", 1)
+//
+// Parámetros:
+//   - `clave` clave a agregar o actualizar.
+//   - `valor` valor a asociar.
+func (c *LRU[K, V]) Put(clave K, valor V) {
+	if e, ok := c.entradas[clave]; ok {
+		e.valor = valor
+		c.moverAlFrente(e.nodo)
+
+		return
+	}
+
+	if len(c.entradas) == c.capacidad {
+		c.expulsar()
+	}
+
+	n := &nodo[K]{clave: clave}
+	c.enlazarAlFrente(n)
+	c.entradas[clave] = &entrada[K, V]{valor: valor, nodo: n}
+}
+
+// Remove elimina la entrada de la clave sin invocar la función de expulsión. O(1)
+//
+// Uso:
+//
+//	removed := cache.Remove("a")
+//
+// Parámetros:
+//   - `clave` clave a eliminar.
+//
+// Retorna:
+//   - true si la clave estaba en el cache.
+func (c *LRU[K, V]) Remove(clave K) bool {
+	e, ok := c.entradas[clave]
+	if !ok {
+		return false
+	}
+	c.desenlazar(e.nodo)
+	delete(c.entradas, clave)
+
+	return true
+}
+
+// Keys retorna las claves de la más reciente a la más antigua.
+func (c *LRU[K, V]) Keys() []K {
+	claves := make([]K, 0, len(c.entradas))
+	for n := c.head; n != nil; n = n.next {
+		claves = append(claves, n.clave)
+	}
+
+	return claves
+}
+
+func (c *LRU[K, V]) expulsar() {
+	n := c.tail
+	e := c.entradas[n.clave]
+	c.desenlazar(n)
+	delete(c.entradas, n.clave)
+	if c.onEvict != nil {
+		c.onEvict(n.clave, e.valor)
+	}
+}
+
+func (c *LRU[K, V]) moverAlFrente(n *nodo[K]) {
+	if n == c.head {
+		return
+	}
+	c.desenlazar(n)
+	c.enlazarAlFrente(n)
+}
+
+func (c *LRU[K, V]) enlazarAlFrente(n *nodo[K]) {
+	n.prev = nil
+	n.next = c.head
+	if c.head != nil {
+		c.head.prev = n
+	} else {
+		c.tail = n
+	}
+	c.head = n
+}
+
+func (c *LRU[K, V]) desenlazar(n *nodo[K]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		c.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		c.tail = n.prev
+	}
+	n.prev = nil
+	n.next = nil
+}
@@ -0,0 +1,69 @@
+package lru
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLRUCapacidadInvalida(t *testing.T) {
+	_, err := NewLRU[string, int](0, nil)
+	assert.EqualError(t, err, "capacidad inválida")
+}
+
+func TestLRUGetInexistente(t *testing.T) {
+	cache, _ := NewLRU[string, int](2, nil)
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Size())
+}
+
+func TestLRUPutYGet(t *testing.T) {
+	cache, _ := NewLRU[string, int](2, nil)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("a", 10)
+
+	v, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, []string{"a", "b"}, cache.Keys())
+}
+
+func TestLRUExpulsaElMenosReciente(t *testing.T) {
+	expulsados := make([]string, 0)
+	cache, _ := NewLRU[string, int](3, func(k string, v int) {
+		expulsados = append(expulsados, k)
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Put("d", 4)
+	cache.Put("e", 5)
+
+	assert.Equal(t, []string{"b", "c"}, expulsados)
+	assert.Equal(t, []string{"e", "d", "a"}, cache.Keys())
+	assert.Equal(t, 3, cache.Capacity())
+}
+
+func TestLRURemove(t *testing.T) {
+	expulsados := 0
+	cache, _ := NewLRU[int, string](3, func(int, string) { expulsados++ })
+	cache.Put(1, "uno")
+	cache.Put(2, "dos")
+	cache.Put(3, "tres")
+
+	assert.True(t, cache.Remove(2))
+	assert.False(t, cache.Remove(2))
+	assert.True(t, cache.Remove(3))
+	assert.True(t, cache.Remove(1))
+	assert.Empty(t, cache.Keys())
+	assert.Equal(t, 0, expulsados)
+
+	cache.Put(4, "cuatro")
+	assert.Equal(t, []int{4}, cache.Keys())
+}
@@ -0,0 +1,214 @@
+// Package narytree provee un árbol n-ario general, en el que cada nodo puede
+// tener cualquier cantidad de hijos.
+package narytree
+
+// NaryNode es un nodo de un árbol n-ario. Los hijos se guardan en un slice en
+// el orden en que fueron agregados.
+type NaryNode[T any] struct {
+	data     T
+	children []*NaryNode[T]
+}
+
+// GetData retorna el dato del nodo.
+func (n *NaryNode[T]) GetData() T {
+	return n.data
+}
+
+// GetChildren retorna los hijos del nodo, de izquierda a derecha.
+func (n *NaryNode[T]) GetChildren() []*NaryNode[T] {
+	return n.children
+}
+
+// IsLeaf indica si el nodo no tiene hijos.
+func (n *NaryNode[T]) IsLeaf() bool {
+	return len(n.children) == 0
+}
+
+// AddChild agrega un hijo a la derecha de los existentes.
+//
+// Uso:
+//
+//	hijo := tree.GetRoot().AddChild(2)
+//	hijo.AddChild(5)
+//
+// Parámetros:
+//   - `data` dato del nuevo hijo.
+//
+// Retorna:
+//   - un puntero al nodo agregado.
+func (n *NaryNode[T]) AddChild(data T) *NaryNode[T] {
+	child := &NaryNode[T]{data: data}
+	n.children = append(n.children, child)
+
+	return child
+}
+
+func (n *NaryNode[T]) size() int {
+	count := 1
+	for _, c := range n.children {
+		count += c.size()
+	}
+
+	return count
+}
+
+func (n *NaryNode[T]) height() int {
+	h := -1
+	for _, c := range n.children {
+		h = max(h, c.height())
+	}
+
+	return h + 1
+}
+
+func (n *NaryNode[T]) leafCount() int {
+	if n.IsLeaf() {
+		return 1
+	}
+	count := 0
+	for _, c := range n.children {
+		count += c.leafCount()
+	}
+
+	return count
+}
+
+func (n *NaryNode[T]) postOrder(result *[]T) {
+	for _, c := range n.children {
+		c.postOrder(result)
+	}
+	*result = append(*result, n.data)
+}
+
+// NaryTree es un árbol n-ario.
+type NaryTree[T any] struct {
+	root *NaryNode[T]
+}
+
+// NewNaryTree crea un árbol con un único nodo raíz.
+//
+// Uso:
+//
+//	tree := narytree.NewNaryTree(1)
+//
+// Parámetros:
+//   - `data` dato de la raíz.
+//
+// Retorna:
+//   - un puntero al árbol.
+func NewNaryTree[T any](data T) *NaryTree[T] {
+	return &NaryTree[T]{root: &NaryNode[T]{data: data}}
+}
+
+// GetRoot retorna la raíz del árbol.
+func (t *NaryTree[T]) GetRoot() *NaryNode[T] {
+	return t.root
+}
+
+// Size retorna la cantidad de nodos del árbol.
+func (t *NaryTree[T]) Size() int {
+	return t.root.size()
+}
+
+// Height retorna la altura del árbol. Un árbol con sólo la raíz tiene altura 0.
+func (t *NaryTree[T]) Height() int {
+	return t.root.height()
+}
+
+// LeafCount retorna la cantidad de hojas del árbol.
+func (t *NaryTree[T]) LeafCount() int {
+	return t.root.leafCount()
+}
+
+// PreOrder recorre el árbol en profundidad visitando cada nodo antes que sus
+// hijos. Usa una pila explícita.
+//
+// Uso:
+//
+//	datos := tree.PreOrder()
+//
+// Retorna:
+//   - los datos en preorden.
+func (t *NaryTree[T]) PreOrder() []T {
+	result := make([]T, 0)
+	pending := []*NaryNode[T]{t.root} // pila, con el tope al final
+
+	for len(pending) > 0 {
+		n := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		result = append(result, n.data)
+		// se apilan de derecha a izquierda para visitar primero el de la izquierda
+		for i := len(n.children) - 1; i >= 0; i-- {
+			pending = append(pending, n.children[i])
+		}
+	}
+
+	return result
+}
+
+// PostOrder recorre el árbol en profundidad visitando cada nodo después de
+// todos sus hijos.
+//
+// Uso:
+//
+//	datos := tree.PostOrder()
+//
+// Retorna:
+//   - los datos en posorden.
+func (t *NaryTree[T]) PostOrder() []T {
+	result := make([]T, 0)
+	t.root.postOrder(&result)
+
+	return result
+}
+
+// LevelOrder recorre el árbol por niveles, de izquierda a derecha. Usa una cola.
+//
+// Uso:
+//
+//	datos := tree.LevelOrder()
+//
+// Retorna:
+//   - los datos por niveles.
+func (t *NaryTree[T]) LevelOrder() []T {
+	result := make([]T, 0)
+	for _, level := range t.Levels() {
+		result = append(result, level...)
+	}
+
+	return result
+}
+
+// Levels retorna los datos agrupados por nivel, comenzando por la raíz.
+//
+// Uso:
+//
+//	for i, nivel := range tree.Levels() {
+//		fmt.Println(i, nivel)
+//	}
+//
+// Retorna:
+//   - un slice por nivel con sus datos de izquierda a derecha.
+func (t *NaryTree[T]) Levels() [][]T {
+	levels := make([][]T, 0)
+	pending := []*NaryNode[T]{t.root} // cola, con el primero al principio
+	remaining := 1
+
+	for remaining > 0 {
+		level := make([]T, 0, remaining)
+		next := 0
+		for ; remaining > 0; remaining-- {
+			n := pending[0]
+			pending = pending[1:]
+			level = append(level, n.data)
+			for _, c := range n.children {
+				pending = append(pending, c)
+				next++
+			}
+		}
+		levels = append(levels, level)
+		remaining = next
+	}
+
+	return levels
+}
@@ -0,0 +1,64 @@
+package narytree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// nuevoArbolDeEjemplo arma el árbol:
+//
+//	[1]
+//	├── [2]
+//	│   ├── [5]
+//	│   └── [6]
+//	├── [3]
+//	└── [4]
+//	    └── [7]
+//	        └── [8]
+func nuevoArbolDeEjemplo() *NaryTree[int] {
+	tree := NewNaryTree(1)
+	root := tree.GetRoot()
+	dos := root.AddChild(2)
+	root.AddChild(3)
+	cuatro := root.AddChild(4)
+	dos.AddChild(5)
+	dos.AddChild(6)
+	cuatro.AddChild(7).AddChild(8)
+
+	return tree
+}
+
+func TestNewNaryTree(t *testing.T) {
+	tree := NewNaryTree("raíz")
+
+	assert.Equal(t, "raíz", tree.GetRoot().GetData())
+	assert.Equal(t, 1, tree.Size())
+	assert.Equal(t, 0, tree.Height())
+	assert.Equal(t, 1, tree.LeafCount())
+	assert.Equal(t, []string{"raíz"}, tree.PreOrder())
+}
+
+func TestNaryTreeMedidas(t *testing.T) {
+	tree := nuevoArbolDeEjemplo()
+
+	assert.Equal(t, 8, tree.Size())
+	assert.Equal(t, 3, tree.Height())
+	assert.Equal(t, 4, tree.LeafCount())
+	assert.False(t, tree.GetRoot().IsLeaf())
+	assert.Len(t, tree.GetRoot().GetChildren(), 3)
+}
+
+func TestNaryTreeRecorridosEnProfundidad(t *testing.T) {
+	tree := nuevoArbolDeEjemplo()
+
+	assert.Equal(t, []int{1, 2, 5, 6, 3, 4, 7, 8}, tree.PreOrder())
+	assert.Equal(t, []int{5, 6, 2, 3, 8, 7, 4, 1}, tree.PostOrder())
+}
+
+func TestNaryTreeRecorridoPorNiveles(t *testing.T) {
+	tree := nuevoArbolDeEjemplo()
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, tree.LevelOrder())
+	assert.Equal(t, [][]int{{1}, {2, 3, 4}, {5, 6, 7}, {8}}, tree.Levels())
+}
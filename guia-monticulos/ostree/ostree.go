@@ -0,0 +1,324 @@
+// Package ostree provee un árbol de estadísticas de orden, que responde en
+// O(log n) cuántos elementos son menores que un valor (rank) y cuál es el
+// k-ésimo elemento (select).
+//
+// El árbol es un AVL aumentado con la cantidad de elementos de cada
+// subárbol. Admite elementos repetidos, que se guardan en un mismo nodo con un
+// contador, por lo que sirve para calcular percentiles exactos de una
+// secuencia que cambia, como alternativa al enfoque de dos heaps.
+package ostree
+
+import (
+	"cmp"
+	"errors"
+)
+
+type osNode[T cmp.Ordered] struct {
+	value  T          // valor
+	count  int        // cantidad de repeticiones del valor
+	size   int        // cantidad de elementos del subárbol, con repeticiones
+	height int        // altura
+	left   *osNode[T] // hijo izquierdo
+	right  *osNode[T] // hijo derecho
+}
+
+// OrderStatisticTree es un árbol de estadísticas de orden.
+type OrderStatisticTree[T cmp.Ordered] struct {
+	root *osNode[T]
+}
+
+// NewOrderStatisticTree crea un árbol vacío.
+//
+// Uso:
+//
+//	tree := ostree.NewOrderStatisticTree[int]()
+//
+// Retorna:
+//   - un puntero a un árbol vacío.
+func NewOrderStatisticTree[T cmp.Ordered]() *OrderStatisticTree[T] {
+	return &OrderStatisticTree[T]{}
+}
+
+// Size retorna la cantidad de elementos almacenados, contando las repeticiones.
+func (t *OrderStatisticTree[T]) Size() int {
+	return t.root.getSize()
+}
+
+// IsEmpty indica si el árbol no tiene elementos.
+func (t *OrderStatisticTree[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Insert agrega un elemento al árbol. O(log n)
+//
+// Uso:
+//
+//	tree.Insert(42)
+//
+// Parámetros:
+//   - `value` elemento a agregar. Si ya estaba, se agrega una repetición.
+func (t *OrderStatisticTree[T]) Insert(value T) {
+	t.root = t.root.insert(value)
+}
+
+// Delete elimina una repetición de un elemento. O(log n)
+//
+// Uso:
+//
+//	removed := tree.Delete(42)
+//
+// Parámetros:
+//   - `value` elemento a eliminar.
+//
+// Retorna:
+//   - true si el elemento estaba en el árbol.
+func (t *OrderStatisticTree[T]) Delete(value T) bool {
+	var removed bool
+	t.root, removed = t.root.remove(value)
+
+	return removed
+}
+
+// Count retorna cuántas veces está el elemento en el árbol. O(log n)
+func (t *OrderStatisticTree[T]) Count(value T) int {
+	n := t.root
+	for n != nil {
+		switch {
+		case value < n.value:
+			n = n.left
+		case value > n.value:
+			n = n.right
+		default:
+			return n.count
+		}
+	}
+
+	return 0
+}
+
+// Rank retorna la cantidad de elementos estrictamente menores que `value`,
+// que es la posición que ocuparía su primera aparición en el arreglo
+// ordenado. `value` no necesita estar en el árbol. O(log n)
+//
+// Uso:
+//
+//	menores := tree.Rank(42)
+func (t *OrderStatisticTree[T]) Rank(value T) int {
+	rank := 0
+	n := t.root
+	for n != nil {
+		switch {
+		case value < n.value:
+			n = n.left
+		case value > n.value:
+			rank += n.left.getSize() + n.count
+			n = n.right
+		default:
+			return rank + n.left.getSize()
+		}
+	}
+
+	return rank
+}
+
+// Select retorna el elemento que ocuparía la posición k del arreglo
+// ordenado, contando desde 0. O(log n)
+//
+// Uso:
+//
+//	mediana, err := tree.Select(tree.Size() / 2)
+//
+// Parámetros:
+//   - `k` posición buscada.
+//
+// Retorna:
+//   - el elemento.
+//   - un error si k no está entre 0 y Size()-1.
+func (t *OrderStatisticTree[T]) Select(k int) (T, error) {
+	var value T
+	if k < 0 || k >= t.Size() {
+		return value, errors.New("posición fuera de rango")
+	}
+	n := t.root
+	for {
+		izquierda := n.left.getSize()
+		switch {
+		case k < izquierda:
+			n = n.left
+		case k < izquierda+n.count:
+			return n.value, nil
+		default:
+			k -= izquierda + n.count
+			n = n.right
+		}
+	}
+}
+
+// Percentile retorna el percentil p de los elementos por el método del rango
+// más cercano: el menor elemento que es mayor o igual que el p% de ellos.
+// O(log n)
+//
+// Uso:
+//
+//	p99, err := tree.Percentile(99)
+//
+// Parámetros:
+//   - `p` percentil buscado, entre 0 y 100.
+//
+// Retorna:
+//   - el elemento.
+//   - un error si el árbol está vacío o p está fuera de rango.
+func (t *OrderStatisticTree[T]) Percentile(p float64) (T, error) {
+	var value T
+	if t.IsEmpty() {
+		return value, errors.New("árbol vacío")
+	}
+	if p < 0 || p > 100 {
+		return value, errors.New("percentil inválido")
+	}
+	// rango más cercano: ceil(p/100 * n), con un mínimo de 1
+	n := t.Size()
+	k := int(p * float64(n) / 100)
+	if float64(k)*100 < p*float64(n) {
+		k++
+	}
+
+	return t.Select(max(k, 1) - 1)
+}
+
+func (n *osNode[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+func (n *osNode[T]) getHeight() int {
+	if n == nil {
+		return -1
+	}
+
+	return n.height
+}
+
+func (n *osNode[T]) getBalance() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.left.getHeight() - n.right.getHeight()
+}
+
+// update recalcula la altura y el tamaño del subárbol.
+func (n *osNode[T]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.size = n.left.getSize() + n.count + n.right.getSize()
+}
+
+func (n *osNode[T]) rotateRight() *osNode[T] {
+	y := n.left
+	n.left = y.right
+	y.right = n
+
+	n.update()
+	y.update()
+
+	return y
+}
+
+func (n *osNode[T]) rotateLeft() *osNode[T] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+
+	n.update()
+	x.update()
+
+	return x
+}
+
+func (n *osNode[T]) applyRotation() *osNode[T] {
+	balance := n.getBalance()
+
+	if balance > 1 {
+		if n.left.getBalance() < 0 {
+			n.left = n.left.rotateLeft()
+		}
+
+		return n.rotateRight()
+	}
+
+	if balance < -1 {
+		if n.right.getBalance() > 0 {
+			n.right = n.right.rotateRight()
+		}
+
+		return n.rotateLeft()
+	}
+
+	return n
+}
+
+func (n *osNode[T]) insert(value T) *osNode[T] {
+	if n == nil {
+		return &osNode[T]{value: value, count: 1, size: 1}
+	}
+
+	switch {
+	case value < n.value:
+		n.left = n.left.insert(value)
+	case value > n.value:
+		n.right = n.right.insert(value)
+	default:
+		n.count++
+	}
+	n.update()
+
+	return n.applyRotation()
+}
+
+func (n *osNode[T]) remove(value T) (*osNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+	switch {
+	case value < n.value:
+		n.left, removed = n.left.remove(value)
+	case value > n.value:
+		n.right, removed = n.right.remove(value)
+	case n.count > 1:
+		n.count--
+		removed = true
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.value, n.count = successor.value, successor.count
+		n.right = n.right.removeMin()
+		removed = true
+	}
+	n.update()
+
+	return n.applyRotation(), removed
+}
+
+// removeMin quita el nodo mínimo del subárbol, con todas sus repeticiones.
+func (n *osNode[T]) removeMin() *osNode[T] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = n.left.removeMin()
+	n.update()
+
+	return n.applyRotation()
+}
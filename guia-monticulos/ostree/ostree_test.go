@@ -0,0 +1,111 @@
+package ostree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrderStatisticTree(t *testing.T) {
+	tree := NewOrderStatisticTree[int]()
+
+	assert.True(t, tree.IsEmpty())
+	assert.Equal(t, 0, tree.Size())
+	assert.Equal(t, 0, tree.Rank(5))
+	_, err := tree.Select(0)
+	assert.EqualError(t, err, "posición fuera de rango")
+	_, err = tree.Percentile(50)
+	assert.EqualError(t, err, "árbol vacío")
+}
+
+func TestRankYSelect(t *testing.T) {
+	tree := NewOrderStatisticTree[int]()
+	for _, v := range []int{50, 20, 70, 20, 10, 60, 20} {
+		tree.Insert(v)
+	}
+
+	assert.Equal(t, 7, tree.Size())
+	assert.Equal(t, 3, tree.Count(20))
+	assert.Equal(t, 0, tree.Rank(10))
+	assert.Equal(t, 1, tree.Rank(20))
+	assert.Equal(t, 4, tree.Rank(50))
+	assert.Equal(t, 5, tree.Rank(55))
+	assert.Equal(t, 7, tree.Rank(100))
+
+	ordenados := make([]int, 0, tree.Size())
+	for k := 0; k < tree.Size(); k++ {
+		v, err := tree.Select(k)
+		assert.NoError(t, err)
+		ordenados = append(ordenados, v)
+	}
+	assert.Equal(t, []int{10, 20, 20, 20, 50, 60, 70}, ordenados)
+
+	_, err := tree.Select(7)
+	assert.Error(t, err)
+	_, err = tree.Select(-1)
+	assert.Error(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	tree := NewOrderStatisticTree[string]()
+	for _, v := range []string{"b", "a", "c", "b"} {
+		tree.Insert(v)
+	}
+
+	assert.True(t, tree.Delete("b"))
+	assert.Equal(t, 1, tree.Count("b"))
+	assert.True(t, tree.Delete("b"))
+	assert.False(t, tree.Delete("b"))
+	assert.Equal(t, 2, tree.Size())
+	v, _ := tree.Select(1)
+	assert.Equal(t, "c", v)
+}
+
+func TestPercentile(t *testing.T) {
+	tree := NewOrderStatisticTree[int]()
+	for v := 1; v <= 100; v++ {
+		tree.Insert(v)
+	}
+
+	for p, esperado := range map[float64]int{0: 1, 50: 50, 90: 90, 99: 99, 99.5: 100, 100: 100} {
+		v, err := tree.Percentile(p)
+		assert.NoError(t, err)
+		assert.Equal(t, esperado, v, "percentil %v", p)
+	}
+	_, err := tree.Percentile(101)
+	assert.EqualError(t, err, "percentil inválido")
+}
+
+func TestCoincideConUnArregloOrdenado(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	tree := NewOrderStatisticTree[int]()
+	var referencia []int
+
+	for i := 0; i < 3000; i++ {
+		v := r.Intn(200)
+		if r.Intn(3) == 0 {
+			j := sort.SearchInts(referencia, v)
+			estaba := j < len(referencia) && referencia[j] == v
+			assert.Equal(t, estaba, tree.Delete(v))
+			if estaba {
+				referencia = append(referencia[:j], referencia[j+1:]...)
+			}
+		} else {
+			tree.Insert(v)
+			j := sort.SearchInts(referencia, v)
+			referencia = append(referencia[:j], append([]int{v}, referencia[j:]...)...)
+		}
+
+		assert.Equal(t, len(referencia), tree.Size())
+		assert.Equal(t, sort.SearchInts(referencia, v), tree.Rank(v))
+		if len(referencia) > 0 {
+			k := r.Intn(len(referencia))
+			elegido, err := tree.Select(k)
+			assert.NoError(t, err)
+			assert.Equal(t, referencia[k], elegido)
+		}
+	}
+	assert.LessOrEqual(t, tree.root.getHeight(), 12)
+}
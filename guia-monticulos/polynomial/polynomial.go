@@ -0,0 +1,218 @@
+// Package polynomial provee el TDA Polinomio de una variable con coeficientes
+// reales.
+package polynomial
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Term es un término c·x^e de un polinomio.
+type Term struct {
+	Coef float64
+	Exp  int
+}
+
+// Polynomial es un polinomio representado como una secuencia de sus
+// términos no nulos, ordenada por exponente de mayor a menor. Los polinomios
+// son inmutables: todas las operaciones retornan uno nuevo.
+type Polynomial struct {
+	terms []Term
+}
+
+// NewPolynomial crea un polinomio a partir de sus términos. Los términos de
+// igual exponente se suman y los nulos se descartan.
+//
+// Uso:
+//
+//	p, _ := polynomial.NewPolynomial(polynomial.Term{3, 2}, polynomial.Term{-1, 0}) // 3x^2 - 1
+//
+// Parámetros:
+//   - `terms` términos del polinomio, en cualquier orden.
+//
+// Retorna:
+//   - un puntero al polinomio.
+//   - un error si algún exponente es negativo.
+func NewPolynomial(terms ...Term) (*Polynomial, error) {
+	coefs := make(map[int]float64)
+	for _, t := range terms {
+		if t.Exp < 0 {
+			return nil, fmt.Errorf("exponente negativo: %d", t.Exp)
+		}
+		coefs[t.Exp] += t.Coef
+	}
+
+	return fromCoefs(coefs), nil
+}
+
+// fromCoefs arma la secuencia ordenada a partir de un mapa de exponente a coeficiente.
+func fromCoefs(coefs map[int]float64) *Polynomial {
+	exps := make([]int, 0, len(coefs))
+	for e, c := range coefs {
+		if c != 0 {
+			exps = append(exps, e)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(exps)))
+
+	terms := make([]Term, 0, len(exps))
+	for _, e := range exps {
+		terms = append(terms, Term{Coef: coefs[e], Exp: e})
+	}
+
+	return &Polynomial{terms: terms}
+}
+
+// Terms retorna los términos no nulos ordenados de mayor a menor exponente.
+func (p *Polynomial) Terms() []Term {
+	result := make([]Term, len(p.terms))
+	copy(result, p.terms)
+
+	return result
+}
+
+// Degree retorna el grado del polinomio. El polinomio nulo tiene grado -1.
+func (p *Polynomial) Degree() int {
+	if len(p.terms) == 0 {
+		return -1
+	}
+
+	return p.terms[0].Exp
+}
+
+// Add retorna la suma de dos polinomios. Recorre ambas secuencias a la par, como
+// en la intercalación de merge sort. O(n + m)
+//
+// Uso:
+//
+//	suma := p.Add(q)
+//
+// Parámetros:
+//   - `other` polinomio a sumar.
+//
+// Retorna:
+//   - un puntero al polinomio suma.
+func (p *Polynomial) Add(other *Polynomial) *Polynomial {
+	result := make([]Term, 0, len(p.terms)+len(other.terms))
+	i, j := 0, 0
+	for i < len(p.terms) || j < len(other.terms) {
+		switch {
+		case j == len(other.terms) || (i < len(p.terms) && p.terms[i].Exp > other.terms[j].Exp):
+			result = append(result, p.terms[i])
+			i++
+		case i == len(p.terms) || other.terms[j].Exp > p.terms[i].Exp:
+			result = append(result, other.terms[j])
+			j++
+		default:
+			if c := p.terms[i].Coef + other.terms[j].Coef; c != 0 {
+				result = append(result, Term{Coef: c, Exp: p.terms[i].Exp})
+			}
+			i, j = i+1, j+1
+		}
+	}
+
+	return &Polynomial{terms: result}
+}
+
+// Multiply retorna el producto de dos polinomios. O(n·m)
+//
+// Uso:
+//
+//	producto := p.Multiply(q)
+//
+// Parámetros:
+//   - `other` polinomio por el que se multiplica.
+//
+// Retorna:
+//   - un puntero al polinomio producto.
+func (p *Polynomial) Multiply(other *Polynomial) *Polynomial {
+	coefs := make(map[int]float64)
+	for _, a := range p.terms {
+		for _, b := range other.terms {
+			coefs[a.Exp+b.Exp] += a.Coef * b.Coef
+		}
+	}
+
+	return fromCoefs(coefs)
+}
+
+// Evaluate calcula el valor del polinomio en x usando la regla de Horner,
+// adaptada para saltear los términos nulos. O(n) multiplicaciones y sumas,
+// más las potencias de los saltos entre exponentes.
+//
+// Uso:
+//
+//	y := p.Evaluate(2)
+//
+// Parámetros:
+//   - `x` valor de la variable.
+//
+// Retorna:
+//   - el valor del polinomio en x.
+func (p *Polynomial) Evaluate(x float64) float64 {
+	result := 0.0
+	prevExp := p.Degree()
+	for _, t := range p.terms {
+		result = result*math.Pow(x, float64(prevExp-t.Exp)) + t.Coef
+		prevExp = t.Exp
+	}
+	if prevExp > 0 {
+		result *= math.Pow(x, float64(prevExp))
+	}
+
+	return result
+}
+
+// Derivative retorna la derivada del polinomio. O(n)
+//
+// Uso:
+//
+//	dp := p.Derivative()
+//
+// Retorna:
+//   - un puntero al polinomio derivado.
+func (p *Polynomial) Derivative() *Polynomial {
+	result := make([]Term, 0, len(p.terms))
+	for _, t := range p.terms {
+		if t.Exp > 0 {
+			result = append(result, Term{Coef: t.Coef * float64(t.Exp), Exp: t.Exp - 1})
+		}
+	}
+
+	return &Polynomial{terms: result}
+}
+
+// String retorna el polinomio en notación usual, por ejemplo "3x^2 - x + 1".
+func (p *Polynomial) String() string {
+	if len(p.terms) == 0 {
+		return "0"
+	}
+
+	var sb strings.Builder
+	for i, t := range p.terms {
+		coef := t.Coef
+		switch {
+		case i > 0 && coef < 0:
+			sb.WriteString(" - ")
+			coef = -coef
+		case i > 0:
+			sb.WriteString(" + ")
+		case coef < 0:
+			sb.WriteString("-")
+			coef = -coef
+		}
+		if coef != 1 || t.Exp == 0 {
+			sb.WriteString(fmt.Sprintf("%g", coef))
+		}
+		switch {
+		case t.Exp == 1:
+			sb.WriteString("x")
+		case t.Exp > 1:
+			sb.WriteString(fmt.Sprintf("x^%d", t.Exp))
+		}
+	}
+
+	return sb.String()
+}
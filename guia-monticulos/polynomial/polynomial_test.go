@@ -0,0 +1,69 @@
+package polynomial
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func nuevo(t *testing.T, terms ...Term) *Polynomial {
+	p, err := NewPolynomial(terms...)
+	assert.NoError(t, err)
+
+	return p
+}
+
+func TestNewPolynomialAgrupaYOrdena(t *testing.T) {
+	p := nuevo(t, Term{1, 0}, Term{2, 3}, Term{-1, 1}, Term{4, 3}, Term{5, 2}, Term{-5, 2})
+
+	assert.Equal(t, []Term{{6, 3}, {-1, 1}, {1, 0}}, p.Terms())
+	assert.Equal(t, 3, p.Degree())
+	assert.Equal(t, "6x^3 - x + 1", p.String())
+}
+
+func TestNewPolynomialExponenteNegativo(t *testing.T) {
+	_, err := NewPolynomial(Term{1, -2})
+	assert.EqualError(t, err, "exponente negativo: -2")
+}
+
+func TestPolinomioNulo(t *testing.T) {
+	p := nuevo(t)
+
+	assert.Equal(t, -1, p.Degree())
+	assert.Equal(t, "0", p.String())
+	assert.Equal(t, 0.0, p.Evaluate(3))
+	assert.Equal(t, "0", p.Derivative().String())
+}
+
+func TestPolynomialAdd(t *testing.T) {
+	p := nuevo(t, Term{3, 4}, Term{2, 2}, Term{1, 0})
+	q := nuevo(t, Term{-2, 2}, Term{5, 1}, Term{-1, 0}, Term{1, 5})
+
+	assert.Equal(t, "x^5 + 3x^4 + 5x", p.Add(q).String())
+	assert.Equal(t, p.Add(q).Terms(), q.Add(p).Terms())
+}
+
+func TestPolynomialMultiply(t *testing.T) {
+	p := nuevo(t, Term{1, 1}, Term{1, 0})  // x + 1
+	q := nuevo(t, Term{1, 1}, Term{-1, 0}) // x - 1
+
+	assert.Equal(t, "x^2 - 1", p.Multiply(q).String())
+	assert.Equal(t, "x^2 + 2x + 1", p.Multiply(p).String())
+	assert.Equal(t, "0", p.Multiply(nuevo(t)).String())
+}
+
+func TestPolynomialEvaluateHorner(t *testing.T) {
+	p := nuevo(t, Term{2, 5}, Term{-3, 2}, Term{7, 0}) // 2x^5 - 3x^2 + 7
+
+	assert.Equal(t, 7.0, p.Evaluate(0))
+	assert.Equal(t, 6.0, p.Evaluate(1))
+	assert.Equal(t, 59.0, p.Evaluate(2))
+	assert.Equal(t, 16.0, nuevo(t, Term{1, 4}).Evaluate(2))
+}
+
+func TestPolynomialDerivative(t *testing.T) {
+	p := nuevo(t, Term{2, 5}, Term{-3, 2}, Term{7, 0})
+
+	assert.Equal(t, "10x^4 - 6x", p.Derivative().String())
+	assert.Equal(t, "40x^3 - 6", p.Derivative().Derivative().String())
+}
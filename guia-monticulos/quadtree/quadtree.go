@@ -0,0 +1,182 @@
+// Package quadtree provee un quadtree de puntos en el plano.
+package quadtree
+
+import "errors"
+
+// maxDepth limita la subdivisión para que muchos puntos repetidos o muy
+// cercanos no generen una rama arbitrariamente profunda.
+const maxDepth = 24
+
+// Point es un punto del plano.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Rect es un rectángulo alineado a los ejes, con bordes incluidos.
+type Rect struct {
+	MinX float64
+	MinY float64
+	MaxX float64
+	MaxY float64
+}
+
+// Contains indica si el punto está dentro del rectángulo.
+func (r Rect) Contains(p Point) bool {
+	return p.X >= r.MinX && p.X <= r.MaxX && p.Y >= r.MinY && p.Y <= r.MaxY
+}
+
+// Intersects indica si dos rectángulos comparten al menos un punto.
+func (r Rect) Intersects(other Rect) bool {
+	return r.MinX <= other.MaxX && other.MinX <= r.MaxX &&
+		r.MinY <= other.MaxY && other.MinY <= r.MaxY
+}
+
+type quadNode struct {
+	bounds   Rect         // región que cubre el nodo
+	points   []Point      // puntos del nodo, sólo si es hoja
+	children *[4]quadNode // cuadrantes NO, NE, SO, SE; nil si es hoja
+}
+
+// QuadTree es un quadtree de puntos. Cada región guarda hasta `capacity` puntos;
+// al superarla se subdivide en cuatro cuadrantes iguales.
+type QuadTree struct {
+	root     quadNode
+	capacity int
+	size     int
+}
+
+// NewQuadTree crea un quadtree vacío que cubre la región indicada.
+//
+// Uso:
+//
+//	qt, _ := quadtree.NewQuadTree(quadtree.Rect{0, 0, 100, 100}, 4)
+//
+// Parámetros:
+//   - `bounds` región que cubre el árbol.
+//   - `capacity` cantidad de puntos que admite cada región antes de subdividirse.
+//
+// Retorna:
+//   - un puntero a un quadtree vacío.
+//   - un error si la región o la capacidad son inválidas.
+func NewQuadTree(bounds Rect, capacity int) (*QuadTree, error) {
+	if bounds.MinX > bounds.MaxX || bounds.MinY > bounds.MaxY {
+		return nil, errors.New("región inválida")
+	}
+	if capacity < 1 {
+		return nil, errors.New("capacidad inválida")
+	}
+
+	return &QuadTree{root: quadNode{bounds: bounds}, capacity: capacity}, nil
+}
+
+// Size retorna la cantidad de puntos almacenados.
+func (qt *QuadTree) Size() int {
+	return qt.size
+}
+
+// IsEmpty indica si el árbol no tiene puntos.
+func (qt *QuadTree) IsEmpty() bool {
+	return qt.size == 0
+}
+
+// Insert agrega un punto al árbol.
+//
+// Uso:
+//
+//	err := qt.Insert(quadtree.Point{X: 10, Y: 20})
+//
+// Parámetros:
+//   - `p` punto a agregar.
+//
+// Retorna:
+//   - un error si el punto está fuera de la región del árbol.
+func (qt *QuadTree) Insert(p Point) error {
+	if !qt.root.bounds.Contains(p) {
+		return errors.New("punto fuera de la región")
+	}
+	qt.root.insert(p, qt.capacity, 0)
+	qt.size++
+
+	return nil
+}
+
+// QueryRegion retorna todos los puntos contenidos en la región indicada.
+//
+// Uso:
+//
+//	puntos := qt.QueryRegion(quadtree.Rect{0, 0, 50, 50})
+//
+// Parámetros:
+//   - `region` región de consulta.
+//
+// Retorna:
+//   - los puntos dentro de la región.
+func (qt *QuadTree) QueryRegion(region Rect) []Point {
+	result := make([]Point, 0)
+	qt.root.query(region, &result)
+
+	return result
+}
+
+func (n *quadNode) insert(p Point, capacity int, depth int) {
+	if n.children == nil {
+		if len(n.points) < capacity || depth == maxDepth {
+			n.points = append(n.points, p)
+
+			return
+		}
+		n.subdivide(capacity, depth)
+	}
+	n.childFor(p).insert(p, capacity, depth+1)
+}
+
+func (n *quadNode) subdivide(capacity int, depth int) {
+	b := n.bounds
+	midX := (b.MinX + b.MaxX) / 2
+	midY := (b.MinY + b.MaxY) / 2
+	n.children = &[4]quadNode{
+		{bounds: Rect{b.MinX, midY, midX, b.MaxY}},
+		{bounds: Rect{midX, midY, b.MaxX, b.MaxY}},
+		{bounds: Rect{b.MinX, b.MinY, midX, midY}},
+		{bounds: Rect{midX, b.MinY, b.MaxX, midY}},
+	}
+
+	points := n.points
+	n.points = nil
+	for _, p := range points {
+		n.childFor(p).insert(p, capacity, depth+1)
+	}
+}
+
+// childFor retorna el cuadrante al que pertenece el punto. Los puntos sobre
+// las líneas de corte van al cuadrante de coordenadas mayores.
+func (n *quadNode) childFor(p Point) *quadNode {
+	midX := (n.bounds.MinX + n.bounds.MaxX) / 2
+	midY := (n.bounds.MinY + n.bounds.MaxY) / 2
+	index := 0
+	if p.X >= midX {
+		index++
+	}
+	if p.Y < midY {
+		index += 2
+	}
+
+	return &n.children[index]
+}
+
+func (n *quadNode) query(region Rect, result *[]Point) {
+	if !n.bounds.Intersects(region) {
+		return
+	}
+	for _, p := range n.points {
+		if region.Contains(p) {
+			*result = append(*result, p)
+		}
+	}
+	if n.children != nil {
+		for i := range n.children {
+			n.children[i].query(region, result)
+		}
+	}
+}
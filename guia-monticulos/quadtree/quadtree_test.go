@@ -0,0 +1,75 @@
+package quadtree
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQuadTreeInvalido(t *testing.T) {
+	_, err := NewQuadTree(Rect{10, 0, 0, 10}, 4)
+	assert.EqualError(t, err, "región inválida")
+
+	_, err = NewQuadTree(Rect{0, 0, 10, 10}, 0)
+	assert.EqualError(t, err, "capacidad inválida")
+}
+
+func TestQuadTreeInsertFueraDeRegion(t *testing.T) {
+	qt, _ := NewQuadTree(Rect{0, 0, 10, 10}, 4)
+
+	assert.EqualError(t, qt.Insert(Point{11, 5}), "punto fuera de la región")
+	assert.True(t, qt.IsEmpty())
+}
+
+func TestQuadTreeSubdivide(t *testing.T) {
+	qt, _ := NewQuadTree(Rect{0, 0, 100, 100}, 2)
+
+	_ = qt.Insert(Point{10, 10})
+	_ = qt.Insert(Point{90, 90})
+	assert.Nil(t, qt.root.children)
+
+	_ = qt.Insert(Point{10, 90})
+	assert.NotNil(t, qt.root.children)
+	assert.Empty(t, qt.root.points)
+	assert.Equal(t, 3, qt.Size())
+}
+
+func TestQuadTreeQueryRegion(t *testing.T) {
+	qt, _ := NewQuadTree(Rect{0, 0, 100, 100}, 1)
+	for _, p := range []Point{{10, 10}, {20, 20}, {30, 80}, {75, 25}, {50, 50}} {
+		_ = qt.Insert(p)
+	}
+
+	assert.ElementsMatch(t, []Point{{10, 10}, {20, 20}, {50, 50}}, qt.QueryRegion(Rect{0, 0, 50, 50}))
+	assert.Empty(t, qt.QueryRegion(Rect{80, 80, 100, 100}))
+}
+
+func TestQuadTreePuntosRepetidos(t *testing.T) {
+	qt, _ := NewQuadTree(Rect{0, 0, 1, 1}, 1)
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, qt.Insert(Point{0.5, 0.5}))
+	}
+
+	assert.Len(t, qt.QueryRegion(Rect{0, 0, 1, 1}), 100)
+}
+
+func TestQuadTreeContraFuerzaBruta(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	qt, _ := NewQuadTree(Rect{0, 0, 1000, 1000}, 4)
+	puntos := make([]Point, 0)
+	for i := 0; i < 500; i++ {
+		p := Point{r.Float64() * 1000, r.Float64() * 1000}
+		puntos = append(puntos, p)
+		_ = qt.Insert(p)
+	}
+
+	region := Rect{120, 300, 640, 710}
+	esperado := make([]Point, 0)
+	for _, p := range puntos {
+		if region.Contains(p) {
+			esperado = append(esperado, p)
+		}
+	}
+	assert.ElementsMatch(t, esperado, qt.QueryRegion(region))
+}
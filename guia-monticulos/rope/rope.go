@@ -0,0 +1,293 @@
+// Package rope provee un rope para manipular textos grandes.
+//
+// Un rope representa un texto como un árbol binario cuyas hojas guardan
+// fragmentos cortos. Los nodos son inmutables, por lo que Concat y Split
+// comparten subárboles en lugar de copiar texto.
+package rope
+
+import (
+	"errors"
+	"strings"
+)
+
+// leafSize es la cantidad máxima de caracteres por hoja al construir un rope.
+const leafSize = 16
+
+type ropeNode struct {
+	leaf   []rune    // fragmento de texto, sólo en las hojas
+	left   *ropeNode // primera parte del texto, sólo en nodos internos
+	right  *ropeNode // segunda parte del texto, sólo en nodos internos
+	length int       // cantidad de caracteres del subárbol
+	height int       // altura del subárbol; las hojas tienen altura 0
+}
+
+// Rope es un texto que admite concatenación, corte, inserción y borrado en
+// O(log n). Los índices se cuentan en caracteres (runas), no en bytes.
+type Rope struct {
+	root *ropeNode
+}
+
+// NewRope crea un rope con el texto indicado. O(n)
+//
+// Uso:
+//
+//	r := rope.NewRope("hola mundo")
+//
+// Parámetros:
+//   - `s` texto inicial.
+//
+// Retorna:
+//   - un puntero a un rope.
+func NewRope(s string) *Rope {
+	return &Rope{root: build([]rune(s))}
+}
+
+// Len retorna la cantidad de caracteres del texto.
+func (r *Rope) Len() int {
+	return r.root.len()
+}
+
+// String retorna el texto completo. O(n)
+func (r *Rope) String() string {
+	var sb strings.Builder
+	r.root.write(&sb)
+
+	return sb.String()
+}
+
+// Index retorna el carácter de la posición indicada. O(log n)
+//
+// Uso:
+//
+//	c, err := r.Index(3)
+//
+// Parámetros:
+//   - `i` posición del carácter.
+//
+// Retorna:
+//   - el carácter de la posición.
+//   - un error si la posición está fuera de rango.
+func (r *Rope) Index(i int) (rune, error) {
+	if i < 0 || i >= r.Len() {
+		return 0, errors.New("índice fuera de rango")
+	}
+	n := r.root
+	for n.leaf == nil {
+		if i < n.left.len() {
+			n = n.left
+		} else {
+			i -= n.left.len()
+			n = n.right
+		}
+	}
+
+	return n.leaf[i], nil
+}
+
+// Concat retorna un rope nuevo con el texto de r seguido del de other.
+// Ninguno de los dos se modifica. O(log n)
+//
+// Uso:
+//
+//	r3 := r1.Concat(r2)
+//
+// Parámetros:
+//   - `other` rope a concatenar al final.
+//
+// Retorna:
+//   - un puntero al rope concatenado.
+func (r *Rope) Concat(other *Rope) *Rope {
+	return &Rope{root: join(r.root, other.root)}
+}
+
+// Split parte el texto en dos ropes nuevos: los primeros i caracteres y el
+// resto. El rope original no se modifica. O(log n)
+//
+// Uso:
+//
+//	izq, der, err := r.Split(4)
+//
+// Parámetros:
+//   - `i` cantidad de caracteres del primer rope.
+//
+// Retorna:
+//   - un puntero al rope con los primeros i caracteres.
+//   - un puntero al rope con el resto del texto.
+//   - un error si la posición está fuera de rango.
+func (r *Rope) Split(i int) (*Rope, *Rope, error) {
+	if i < 0 || i > r.Len() {
+		return nil, nil, errors.New("índice fuera de rango")
+	}
+	left, right := split(r.root, i)
+
+	return &Rope{root: left}, &Rope{root: right}, nil
+}
+
+// Insert inserta un texto a partir de la posición indicada. O(log n + m)
+//
+// Uso:
+//
+//	err := r.Insert(5, "querido ")
+//
+// Parámetros:
+//   - `i` posición en la que comienza el texto insertado.
+//   - `s` texto a insertar.
+//
+// Retorna:
+//   - un error si la posición está fuera de rango.
+func (r *Rope) Insert(i int, s string) error {
+	if i < 0 || i > r.Len() {
+		return errors.New("índice fuera de rango")
+	}
+	left, right := split(r.root, i)
+	r.root = join(join(left, build([]rune(s))), right)
+
+	return nil
+}
+
+// Delete borra n caracteres a partir de la posición indicada. O(log n)
+//
+// Uso:
+//
+//	err := r.Delete(5, 8)
+//
+// Parámetros:
+//   - `i` posición del primer carácter a borrar.
+//   - `n` cantidad de caracteres a borrar.
+//
+// Retorna:
+//   - un error si el rango está fuera del texto.
+func (r *Rope) Delete(i, n int) error {
+	if i < 0 || n < 0 || i+n > r.Len() {
+		return errors.New("índice fuera de rango")
+	}
+	left, rest := split(r.root, i)
+	_, right := split(rest, n)
+	r.root = join(left, right)
+
+	return nil
+}
+
+// build arma un árbol balanceado con hojas de a lo sumo leafSize caracteres.
+func build(text []rune) *ropeNode {
+	if len(text) == 0 {
+		return nil
+	}
+	if len(text) <= leafSize {
+		leaf := make([]rune, len(text))
+		copy(leaf, text)
+
+		return &ropeNode{leaf: leaf, length: len(leaf)}
+	}
+	mid := len(text) / 2
+
+	return newInternal(build(text[:mid]), build(text[mid:]))
+}
+
+func newInternal(left, right *ropeNode) *ropeNode {
+	return &ropeNode{
+		left:   left,
+		right:  right,
+		length: left.len() + right.len(),
+		height: 1 + max(left.getHeight(), right.getHeight()),
+	}
+}
+
+func (n *ropeNode) len() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.length
+}
+
+func (n *ropeNode) getHeight() int {
+	if n == nil {
+		return -1
+	}
+
+	return n.height
+}
+
+func (n *ropeNode) write(sb *strings.Builder) {
+	if n == nil {
+		return
+	}
+	if n.leaf != nil {
+		sb.WriteString(string(n.leaf))
+
+		return
+	}
+	n.left.write(sb)
+	n.right.write(sb)
+}
+
+// join concatena dos árboles manteniendo el balance de un AVL: desciende por
+// el lado del árbol más alto hasta encontrar un subárbol de altura similar al
+// otro y rebalancea en el camino de vuelta.
+func join(left, right *ropeNode) *ropeNode {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+
+	switch hl, hr := left.getHeight(), right.getHeight(); {
+	case hl > hr+1:
+		return balance(left.left, join(left.right, right))
+	case hr > hl+1:
+		return balance(join(left, right.left), right.right)
+	}
+
+	return newInternal(left, right)
+}
+
+// balance crea un nodo interno con los hijos dados, aplicando las rotaciones
+// necesarias si sus alturas difieren en más de uno.
+func balance(left, right *ropeNode) *ropeNode {
+	switch hl, hr := left.getHeight(), right.getHeight(); {
+	case hl > hr+1:
+		if left.left.getHeight() < left.right.getHeight() {
+			// rotación doble: izquierda sobre el hijo y derecha sobre el nodo
+			lr := left.right
+
+			return newInternal(newInternal(left.left, lr.left), newInternal(lr.right, right))
+		}
+
+		return newInternal(left.left, newInternal(left.right, right))
+	case hr > hl+1:
+		if right.right.getHeight() < right.left.getHeight() {
+			rl := right.left
+
+			return newInternal(newInternal(left, rl.left), newInternal(rl.right, right.right))
+		}
+
+		return newInternal(newInternal(left, right.left), right.right)
+	}
+
+	return newInternal(left, right)
+}
+
+func split(n *ropeNode, i int) (*ropeNode, *ropeNode) {
+	if n == nil {
+		return nil, nil
+	}
+	if n.leaf != nil {
+		return build(n.leaf[:i]), build(n.leaf[i:])
+	}
+
+	leftLen := n.left.len()
+	switch {
+	case i < leftLen:
+		ll, lr := split(n.left, i)
+
+		return ll, join(lr, n.right)
+	case i > leftLen:
+		rl, rr := split(n.right, i-leftLen)
+
+		return join(n.left, rl), rr
+	}
+
+	return n.left, n.right
+}
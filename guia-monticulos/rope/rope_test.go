@@ -0,0 +1,97 @@
+package rope
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// verificarBalance comprueba que el árbol cumpla la condición de balance de un AVL.
+func verificarBalance(t *testing.T, n *ropeNode) {
+	if n == nil || n.leaf != nil {
+		return
+	}
+	diff := n.left.getHeight() - n.right.getHeight()
+	assert.True(t, diff >= -1 && diff <= 1, "desbalance %d", diff)
+	assert.Equal(t, n.left.len()+n.right.len(), n.length)
+	verificarBalance(t, n.left)
+	verificarBalance(t, n.right)
+}
+
+func TestNewRopeVacio(t *testing.T) {
+	r := NewRope("")
+
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, "", r.String())
+	_, err := r.Index(0)
+	assert.EqualError(t, err, "índice fuera de rango")
+}
+
+func TestRopeIndexConCaracteresMultibyte(t *testing.T) {
+	r := NewRope("árbol de expresión ñandú")
+
+	c, err := r.Index(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 'á', c)
+	c, _ = r.Index(23)
+	assert.Equal(t, 'ú', c)
+	assert.Equal(t, 24, r.Len())
+}
+
+func TestRopeConcatNoModificaLosOriginales(t *testing.T) {
+	a := NewRope("hola ")
+	b := NewRope("mundo")
+	c := a.Concat(b)
+
+	assert.Equal(t, "hola mundo", c.String())
+	assert.Equal(t, "hola ", a.String())
+	assert.Equal(t, "mundo", b.String())
+}
+
+func TestRopeSplit(t *testing.T) {
+	r := NewRope("el veloz murciélago hindú comía feliz cardillo y kiwi")
+
+	izq, der, err := r.Split(19)
+	assert.NoError(t, err)
+	assert.Equal(t, "el veloz murciélago", izq.String())
+	assert.Equal(t, " hindú comía feliz cardillo y kiwi", der.String())
+
+	_, _, err = r.Split(100)
+	assert.Error(t, err)
+}
+
+func TestRopeInsertYDelete(t *testing.T) {
+	r := NewRope("hola mundo")
+
+	assert.NoError(t, r.Insert(5, "querido "))
+	assert.Equal(t, "hola querido mundo", r.String())
+	assert.NoError(t, r.Delete(0, 5))
+	assert.Equal(t, "querido mundo", r.String())
+	assert.Error(t, r.Delete(10, 10))
+	assert.Error(t, r.Insert(-1, "x"))
+}
+
+func TestRopeContraStringsMantieneBalance(t *testing.T) {
+	rnd := rand.New(rand.NewSource(5))
+	r := NewRope("")
+	esperado := ""
+
+	for i := 0; i < 500; i++ {
+		if len(esperado) > 0 && rnd.Intn(3) == 0 {
+			pos := rnd.Intn(len(esperado))
+			n := rnd.Intn(len(esperado) - pos + 1)
+			assert.NoError(t, r.Delete(pos, n))
+			esperado = esperado[:pos] + esperado[pos+n:]
+		} else {
+			pos := rnd.Intn(len(esperado) + 1)
+			texto := strings.Repeat(string(rune('a'+i%26)), rnd.Intn(40))
+			assert.NoError(t, r.Insert(pos, texto))
+			esperado = esperado[:pos] + texto + esperado[pos:]
+		}
+	}
+
+	assert.Equal(t, esperado, r.String())
+	verificarBalance(t, r.root)
+}
@@ -0,0 +1,267 @@
+// Package skiplist provee una skip list genérica ordenada por clave.
+package skiplist
+
+import (
+	"cmp"
+	"math/rand"
+	"time"
+)
+
+const (
+	// MaxLevel es la cantidad máxima de niveles de la lista.
+	MaxLevel = 32
+	// probabilidad de que un nodo suba un nivel más
+	p = 0.5
+)
+
+type skipNode[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*skipNode[K, V] // siguiente nodo en cada nivel
+}
+
+// Entry es un par clave-valor de la lista.
+type Entry[K cmp.Ordered, V any] struct {
+	Key   K
+	Value V
+}
+
+// SkipList es una lista ordenada por clave que admite claves repetidas. Cada
+// nodo participa de una cantidad aleatoria de niveles, de modo que las
+// búsquedas cuestan O(log n) en promedio sin necesidad de rebalancear.
+//
+// Las entradas con la misma clave se mantienen en el orden en que se
+// insertaron, por lo que con Insert y PopMin sirve como cola de prioridad
+// estable: a igual prioridad sale primero la que llegó antes.
+type SkipList[K cmp.Ordered, V any] struct {
+	head  *skipNode[K, V] // centinela presente en todos los niveles
+	level int             // cantidad de niveles en uso
+	size  int
+	rand  *rand.Rand
+}
+
+// NewSkipList crea una skip list vacía.
+//
+// Uso:
+//
+//	sl := skiplist.NewSkipList[int, string]()
+//
+// Retorna:
+//   - un puntero a una skip list vacía.
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewSkipListWithSeed[K, V](time.Now().UnixNano())
+}
+
+// NewSkipListWithSeed crea una skip list vacía cuyos niveles se sortean con la
+// semilla indicada, para obtener estructuras reproducibles.
+//
+// Uso:
+//
+//	sl := skiplist.NewSkipListWithSeed[int, string](42)
+//
+// Parámetros:
+//   - `seed` semilla del generador de niveles.
+//
+// Retorna:
+//   - un puntero a una skip list vacía.
+func NewSkipListWithSeed[K cmp.Ordered, V any](seed int64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:  &skipNode[K, V]{next: make([]*skipNode[K, V], MaxLevel)},
+		level: 1,
+		rand:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Size retorna la cantidad de entradas de la lista.
+func (sl *SkipList[K, V]) Size() int {
+	return sl.size
+}
+
+// IsEmpty indica si la lista no tiene entradas.
+func (sl *SkipList[K, V]) IsEmpty() bool {
+	return sl.size == 0
+}
+
+// Insert agrega una entrada con la clave y el valor dados. Si la clave ya
+// existía, la nueva entrada queda después de las que ya tenían esa clave.
+// O(log n) en promedio.
+//
+// Uso:
+//
+//	sl.Insert(10, "diez")
+//
+// Parámetros:
+//   - `key` clave a insertar.
+//   - `value` valor asociado.
+func (sl *SkipList[K, V]) Insert(key K, value V) {
+	update := sl.predecessors(key, true)
+	level := sl.randomLevel()
+	if level > sl.level {
+		for i := sl.level; i < level; i++ {
+			update[i] = sl.head
+		}
+		sl.level = level
+	}
+
+	node := &skipNode[K, V]{key: key, value: value, next: make([]*skipNode[K, V], level)}
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+	sl.size++
+}
+
+// Delete elimina la primera entrada insertada con la clave. O(log n) en
+// promedio.
+//
+// Uso:
+//
+//	removed := sl.Delete(10)
+//
+// Parámetros:
+//   - `key` clave a eliminar.
+//
+// Retorna:
+//   - true si la clave estaba en la lista.
+func (sl *SkipList[K, V]) Delete(key K) bool {
+	update := sl.predecessors(key, false)
+	node := update[0].next[0]
+	if node == nil || node.key != key {
+		return false
+	}
+
+	sl.unlink(node, update)
+
+	return true
+}
+
+// Search busca el valor de la primera entrada insertada con la clave. O(log n)
+// en promedio.
+//
+// Uso:
+//
+//	if v, ok := sl.Search(10); ok {
+//		fmt.Println(v)
+//	}
+//
+// Parámetros:
+//   - `key` clave a buscar.
+//
+// Retorna:
+//   - el valor asociado a la clave.
+//   - true si la clave estaba en la lista.
+func (sl *SkipList[K, V]) Search(key K) (V, bool) {
+	node := sl.predecessors(key, false)[0].next[0]
+	if node == nil || node.key != key {
+		var value V
+
+		return value, false
+	}
+
+	return node.value, true
+}
+
+// Range retorna, ordenadas, las entradas con clave en el rango cerrado
+// [from, to]. O(log n + k), con k la cantidad de resultados.
+//
+// Uso:
+//
+//	entradas := sl.Range(10, 20)
+//
+// Parámetros:
+//   - `from` clave inicial (inclusive).
+//   - `to` clave final (inclusive).
+//
+// Retorna:
+//   - las entradas dentro del rango.
+func (sl *SkipList[K, V]) Range(from, to K) []Entry[K, V] {
+	result := make([]Entry[K, V], 0)
+	for n := sl.predecessors(from, false)[0].next[0]; n != nil && n.key <= to; n = n.next[0] {
+		result = append(result, Entry[K, V]{Key: n.key, Value: n.value})
+	}
+
+	return result
+}
+
+// Min retorna la entrada de menor clave. O(1)
+//
+// Uso:
+//
+//	if e, ok := sl.Min(); ok {
+//		fmt.Println(e.Key)
+//	}
+//
+// Retorna:
+//   - la entrada de menor clave.
+//   - false si la lista está vacía.
+func (sl *SkipList[K, V]) Min() (Entry[K, V], bool) {
+	n := sl.head.next[0]
+	if n == nil {
+		return Entry[K, V]{}, false
+	}
+
+	return Entry[K, V]{Key: n.key, Value: n.value}, true
+}
+
+// PopMin elimina y retorna la entrada de menor clave; entre varias con la
+// misma clave, la que se insertó primero. O(1) en promedio.
+//
+// Uso:
+//
+//	for e, ok := sl.PopMin(); ok; e, ok = sl.PopMin() {
+//		fmt.Println(e.Key)
+//	}
+//
+// Retorna:
+//   - la entrada de menor clave.
+//   - false si la lista está vacía.
+func (sl *SkipList[K, V]) PopMin() (Entry[K, V], bool) {
+	n := sl.head.next[0]
+	if n == nil {
+		return Entry[K, V]{}, false
+	}
+
+	update := make([]*skipNode[K, V], len(n.next))
+	for i := range update {
+		update[i] = sl.head
+	}
+	sl.unlink(n, update)
+
+	return Entry[K, V]{Key: n.key, Value: n.value}, true
+}
+
+// predecessors retorna, para cada nivel, el último nodo con clave menor a key,
+// o menor o igual si `inclusive` es true.
+func (sl *SkipList[K, V]) predecessors(key K, inclusive bool) []*skipNode[K, V] {
+	update := make([]*skipNode[K, V], MaxLevel)
+	node := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && (node.next[i].key < key || inclusive && node.next[i].key == key) {
+			node = node.next[i]
+		}
+		update[i] = node
+	}
+
+	return update
+}
+
+// unlink quita el nodo de la lista. update[i] debe ser su predecesor en cada
+// nivel en el que participa.
+func (sl *SkipList[K, V]) unlink(node *skipNode[K, V], update []*skipNode[K, V]) {
+	for i := 0; i < len(node.next); i++ {
+		update[i].next[i] = node.next[i]
+	}
+	for sl.level > 1 && sl.head.next[sl.level-1] == nil {
+		sl.level--
+	}
+	sl.size--
+}
+
+func (sl *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < MaxLevel && sl.rand.Float64() < p {
+		level++
+	}
+
+	return level
+}
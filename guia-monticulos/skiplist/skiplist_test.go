@@ -0,0 +1,158 @@
+package skiplist
+
+import (
+	"cmp"
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"untref/ayp2/monticulo/heap"
+	"untref/ayp2/monticulo/heap/heaptest"
+)
+
+func TestNewSkipList(t *testing.T) {
+	sl := NewSkipList[int, string]()
+
+	assert.True(t, sl.IsEmpty())
+	_, ok := sl.Min()
+	assert.False(t, ok)
+}
+
+func TestSkipListInsertYSearch(t *testing.T) {
+	sl := NewSkipListWithSeed[int, string](1)
+	sl.Insert(20, "veinte")
+	sl.Insert(10, "diez")
+	sl.Insert(30, "treinta")
+	sl.Insert(10, "DIEZ")
+
+	assert.Equal(t, 4, sl.Size())
+	v, ok := sl.Search(10)
+	assert.True(t, ok)
+	assert.Equal(t, "diez", v)
+	_, ok = sl.Search(15)
+	assert.False(t, ok)
+
+	min, _ := sl.Min()
+	assert.Equal(t, Entry[int, string]{10, "diez"}, min)
+	assert.Equal(t, []Entry[int, string]{{10, "diez"}, {10, "DIEZ"}}, sl.Range(10, 10))
+}
+
+func TestSkipListDelete(t *testing.T) {
+	sl := NewSkipListWithSeed[int, int](2)
+	for i := 0; i < 100; i++ {
+		sl.Insert(i, i*i)
+	}
+
+	for i := 0; i < 100; i += 2 {
+		assert.True(t, sl.Delete(i))
+	}
+	assert.False(t, sl.Delete(0))
+	assert.Equal(t, 50, sl.Size())
+
+	_, ok := sl.Search(4)
+	assert.False(t, ok)
+	v, ok := sl.Search(5)
+	assert.True(t, ok)
+	assert.Equal(t, 25, v)
+}
+
+func TestSkipListRange(t *testing.T) {
+	sl := NewSkipListWithSeed[string, int](3)
+	for i, k := range []string{"d", "a", "f", "b", "e", "c"} {
+		sl.Insert(k, i)
+	}
+
+	claves := make([]string, 0)
+	for _, e := range sl.Range("b", "e") {
+		claves = append(claves, e.Key)
+	}
+	assert.Equal(t, []string{"b", "c", "d", "e"}, claves)
+	assert.Empty(t, sl.Range("x", "z"))
+}
+
+func TestSkipListContraMap(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	sl := NewSkipListWithSeed[int, int](4)
+	// valores de cada clave, en orden de inserción
+	referencia := make(map[int][]int)
+
+	for i := 0; i < 2000; i++ {
+		k := r.Intn(300)
+		if r.Intn(3) == 0 {
+			assert.Equal(t, len(referencia[k]) > 0, sl.Delete(k))
+			if len(referencia[k]) > 0 {
+				referencia[k] = referencia[k][1:]
+			}
+		} else {
+			sl.Insert(k, i)
+			referencia[k] = append(referencia[k], i)
+		}
+	}
+
+	claves := make([]int, 0, len(referencia))
+	for k := range referencia {
+		claves = append(claves, k)
+	}
+	sort.Ints(claves)
+	esperadas := make([]Entry[int, int], 0)
+	for _, k := range claves {
+		for _, v := range referencia[k] {
+			esperadas = append(esperadas, Entry[int, int]{Key: k, Value: v})
+		}
+	}
+
+	assert.Equal(t, esperadas, sl.Range(0, 300))
+	assert.Equal(t, len(esperadas), sl.Size())
+}
+
+func TestSkipListPopMin(t *testing.T) {
+	sl := NewSkipListWithSeed[int, string](5)
+	sl.Insert(3, "c")
+	sl.Insert(1, "a")
+	sl.Insert(3, "d")
+	sl.Insert(2, "b")
+
+	for _, esperada := range []Entry[int, string]{{1, "a"}, {2, "b"}, {3, "c"}, {3, "d"}} {
+		e, ok := sl.PopMin()
+		assert.True(t, ok)
+		assert.Equal(t, esperada, e)
+	}
+	_, ok := sl.PopMin()
+	assert.False(t, ok)
+	assert.True(t, sl.IsEmpty())
+}
+
+// colaSkipList adapta la skip list a heaptest.PriorityQueue.
+type colaSkipList struct {
+	sl *SkipList[int, struct{}]
+}
+
+func (c colaSkipList) Insert(element int) {
+	c.sl.Insert(element, struct{}{})
+}
+
+func (c colaSkipList) Remove() (int, error) {
+	e, ok := c.sl.PopMin()
+	if !ok {
+		return 0, errors.New("skip list vacía")
+	}
+
+	return e.Key, nil
+}
+
+func (c colaSkipList) Size() int {
+	return c.sl.Size()
+}
+
+func TestSkipListComoColaDePrioridad(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		assert.NoError(t, heaptest.StressTest(heap.NewMinHeap[int](), 5000, seed))
+		assert.NoError(t, heaptest.StressTest(colaSkipList{NewSkipListWithSeed[int, struct{}](seed)}, 5000, seed))
+
+		ops := heaptest.OperacionesAleatorias(2000, seed)
+		assert.NoError(t, heaptest.EjecutarContraOraculo[int](colaSkipList{NewSkipListWithSeed[int, struct{}](seed)}, cmp.Compare[int], ops))
+	}
+}
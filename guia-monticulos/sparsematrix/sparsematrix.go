@@ -0,0 +1,215 @@
+// Package sparsematrix provee matrices ralas, en las que sólo se almacenan
+// los elementos distintos de cero.
+package sparsematrix
+
+import "errors"
+
+type coord struct {
+	row int
+	col int
+}
+
+// SparseMatrix es una matriz rala de números reales representada con un mapa
+// de coordenadas a valores. Las posiciones ausentes valen cero.
+type SparseMatrix struct {
+	rows   int
+	cols   int
+	values map[coord]float64
+}
+
+// NewSparseMatrix crea una matriz nula de las dimensiones indicadas.
+//
+// Uso:
+//
+//	m, _ := sparsematrix.NewSparseMatrix(1000, 1000)
+//
+// Parámetros:
+//   - `rows` cantidad de filas.
+//   - `cols` cantidad de columnas.
+//
+// Retorna:
+//   - un puntero a la matriz.
+//   - un error si alguna dimensión no es positiva.
+func NewSparseMatrix(rows, cols int) (*SparseMatrix, error) {
+	if rows < 1 || cols < 1 {
+		return nil, errors.New("dimensiones inválidas")
+	}
+
+	return &SparseMatrix{rows: rows, cols: cols, values: make(map[coord]float64)}, nil
+}
+
+// Rows retorna la cantidad de filas.
+func (m *SparseMatrix) Rows() int {
+	return m.rows
+}
+
+// Cols retorna la cantidad de columnas.
+func (m *SparseMatrix) Cols() int {
+	return m.cols
+}
+
+// NonZeros retorna la cantidad de elementos distintos de cero.
+func (m *SparseMatrix) NonZeros() int {
+	return len(m.values)
+}
+
+// Set asigna un valor a una posición. Asignar cero libera la posición. O(1)
+//
+// Uso:
+//
+//	err := m.Set(3, 7, 2.5)
+//
+// Parámetros:
+//   - `row` fila.
+//   - `col` columna.
+//   - `value` valor a asignar.
+//
+// Retorna:
+//   - un error si la posición está fuera de la matriz.
+func (m *SparseMatrix) Set(row, col int, value float64) error {
+	if !m.contains(row, col) {
+		return errors.New("posición fuera de rango")
+	}
+	m.set(coord{row, col}, value)
+
+	return nil
+}
+
+// Get retorna el valor de una posición. O(1)
+//
+// Uso:
+//
+//	v, err := m.Get(3, 7)
+//
+// Parámetros:
+//   - `row` fila.
+//   - `col` columna.
+//
+// Retorna:
+//   - el valor de la posición.
+//   - un error si la posición está fuera de la matriz.
+func (m *SparseMatrix) Get(row, col int) (float64, error) {
+	if !m.contains(row, col) {
+		return 0, errors.New("posición fuera de rango")
+	}
+
+	return m.values[coord{row, col}], nil
+}
+
+// Add retorna la suma de dos matrices de iguales dimensiones. O(nz)
+//
+// Uso:
+//
+//	suma, err := a.Add(b)
+//
+// Parámetros:
+//   - `other` matriz a sumar.
+//
+// Retorna:
+//   - un puntero a la matriz suma.
+//   - un error si las dimensiones no coinciden.
+func (m *SparseMatrix) Add(other *SparseMatrix) (*SparseMatrix, error) {
+	if m.rows != other.rows || m.cols != other.cols {
+		return nil, errors.New("dimensiones incompatibles")
+	}
+	result := m.copy()
+	for c, v := range other.values {
+		result.set(c, result.values[c]+v)
+	}
+
+	return result, nil
+}
+
+// Multiply retorna el producto de dos matrices. Sólo se combinan pares de
+// elementos no nulos cuya columna y fila coinciden.
+//
+// Uso:
+//
+//	producto, err := a.Multiply(b)
+//
+// Parámetros:
+//   - `other` matriz por la que se multiplica a derecha.
+//
+// Retorna:
+//   - un puntero a la matriz producto.
+//   - un error si las columnas de m no coinciden con las filas de other.
+func (m *SparseMatrix) Multiply(other *SparseMatrix) (*SparseMatrix, error) {
+	if m.cols != other.rows {
+		return nil, errors.New("dimensiones incompatibles")
+	}
+
+	// elementos no nulos de other agrupados por fila
+	byRow := make(map[int][]coord)
+	for c := range other.values {
+		byRow[c.row] = append(byRow[c.row], c)
+	}
+
+	result, _ := NewSparseMatrix(m.rows, other.cols)
+	for a, va := range m.values {
+		for _, b := range byRow[a.col] {
+			target := coord{a.row, b.col}
+			result.values[target] += va * other.values[b]
+		}
+	}
+	// quitar los ceros que hayan surgido por cancelación
+	for c, v := range result.values {
+		if v == 0 {
+			delete(result.values, c)
+		}
+	}
+
+	return result, nil
+}
+
+// Transpose retorna la matriz traspuesta. O(nz)
+//
+// Uso:
+//
+//	t := m.Transpose()
+//
+// Retorna:
+//   - un puntero a la matriz traspuesta.
+func (m *SparseMatrix) Transpose() *SparseMatrix {
+	result, _ := NewSparseMatrix(m.cols, m.rows)
+	for c, v := range m.values {
+		result.values[coord{c.col, c.row}] = v
+	}
+
+	return result
+}
+
+// Equals indica si dos matrices tienen las mismas dimensiones y valores.
+func (m *SparseMatrix) Equals(other *SparseMatrix) bool {
+	if m.rows != other.rows || m.cols != other.cols || len(m.values) != len(other.values) {
+		return false
+	}
+	for c, v := range m.values {
+		if other.values[c] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *SparseMatrix) contains(row, col int) bool {
+	return row >= 0 && row < m.rows && col >= 0 && col < m.cols
+}
+
+func (m *SparseMatrix) set(c coord, value float64) {
+	if value == 0 {
+		delete(m.values, c)
+
+		return
+	}
+	m.values[c] = value
+}
+
+func (m *SparseMatrix) copy() *SparseMatrix {
+	result, _ := NewSparseMatrix(m.rows, m.cols)
+	for c, v := range m.values {
+		result.values[c] = v
+	}
+
+	return result
+}
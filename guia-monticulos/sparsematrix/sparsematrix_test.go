@@ -0,0 +1,86 @@
+package sparsematrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func desdeDensa(t *testing.T, densa [][]float64) *SparseMatrix {
+	m, err := NewSparseMatrix(len(densa), len(densa[0]))
+	assert.NoError(t, err)
+	for i, fila := range densa {
+		for j, v := range fila {
+			assert.NoError(t, m.Set(i, j, v))
+		}
+	}
+
+	return m
+}
+
+func TestNewSparseMatrixInvalida(t *testing.T) {
+	_, err := NewSparseMatrix(0, 3)
+	assert.EqualError(t, err, "dimensiones inválidas")
+}
+
+func TestSparseMatrixSetYGet(t *testing.T) {
+	m, _ := NewSparseMatrix(1000, 1000)
+
+	assert.NoError(t, m.Set(10, 999, 3.5))
+	v, err := m.Get(10, 999)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.5, v)
+	v, _ = m.Get(0, 0)
+	assert.Equal(t, 0.0, v)
+	assert.Equal(t, 1, m.NonZeros())
+
+	assert.NoError(t, m.Set(10, 999, 0))
+	assert.Equal(t, 0, m.NonZeros())
+
+	assert.EqualError(t, m.Set(1000, 0, 1), "posición fuera de rango")
+	_, err = m.Get(-1, 0)
+	assert.Error(t, err)
+}
+
+func TestSparseMatrixAdd(t *testing.T) {
+	a := desdeDensa(t, [][]float64{{1, 0, 2}, {0, 0, 3}})
+	b := desdeDensa(t, [][]float64{{-1, 4, 0}, {0, 0, 1}})
+
+	suma, err := a.Add(b)
+	assert.NoError(t, err)
+	assert.True(t, suma.Equals(desdeDensa(t, [][]float64{{0, 4, 2}, {0, 0, 4}})))
+	assert.Equal(t, 3, suma.NonZeros())
+
+	_, err = a.Add(a.Transpose())
+	assert.EqualError(t, err, "dimensiones incompatibles")
+}
+
+func TestSparseMatrixMultiply(t *testing.T) {
+	a := desdeDensa(t, [][]float64{{1, 0, 2}, {0, 3, 0}})
+	b := desdeDensa(t, [][]float64{{4, 0}, {0, 5}, {6, 0}})
+
+	producto, err := a.Multiply(b)
+	assert.NoError(t, err)
+	assert.True(t, producto.Equals(desdeDensa(t, [][]float64{{16, 0}, {0, 15}})))
+
+	_, err = a.Multiply(a)
+	assert.EqualError(t, err, "dimensiones incompatibles")
+}
+
+func TestSparseMatrixMultiplyDescartaCancelaciones(t *testing.T) {
+	a := desdeDensa(t, [][]float64{{1, 1}})
+	b := desdeDensa(t, [][]float64{{1}, {-1}})
+
+	producto, _ := a.Multiply(b)
+	assert.Equal(t, 0, producto.NonZeros())
+}
+
+func TestSparseMatrixTranspose(t *testing.T) {
+	a := desdeDensa(t, [][]float64{{1, 0, 2}, {0, 3, 0}})
+	tr := a.Transpose()
+
+	assert.Equal(t, 3, tr.Rows())
+	assert.Equal(t, 2, tr.Cols())
+	assert.True(t, tr.Equals(desdeDensa(t, [][]float64{{1, 0}, {0, 3}, {2, 0}})))
+	assert.True(t, tr.Transpose().Equals(a))
+}
@@ -0,0 +1,102 @@
+// Package sparsetable provee una sparse table para consultas de mínimo en rango
+// (RMQ) sobre arreglos estáticos.
+package sparsetable
+
+import (
+	"cmp"
+	"errors"
+)
+
+// SparseTable responde consultas de mínimo en un rango de un arreglo que no
+// cambia después de construida. A diferencia de un segment tree no admite
+// actualizaciones, pero cada consulta cuesta O(1).
+type SparseTable[T cmp.Ordered] struct {
+	// tabla[k][i] contiene el mínimo de arr[i : i+2^k]
+	tabla [][]T
+	// logs[n] contiene el piso de log2(n)
+	logs []int
+}
+
+// NewSparseTable construye una sparse table a partir de un arreglo. O(n log n)
+//
+// Uso:
+//
+//	st := sparsetable.NewSparseTable([]int{5, 2, 4, 7, 1, 3})
+//
+// Parámetros:
+//   - `arr` arreglo sobre el que se harán las consultas. Se copia, por lo que
+//     modificarlo luego no afecta a la tabla.
+//
+// Retorna:
+//   - un puntero a una sparse table.
+func NewSparseTable[T cmp.Ordered](arr []T) *SparseTable[T] {
+	n := len(arr)
+	logs := make([]int, n+1)
+	for i := 2; i <= n; i++ {
+		logs[i] = logs[i/2] + 1
+	}
+
+	niveles := 0
+	if n > 0 {
+		niveles = logs[n] + 1
+	}
+
+	tabla := make([][]T, niveles)
+	if niveles > 0 {
+		tabla[0] = make([]T, n)
+		copy(tabla[0], arr)
+	}
+	for k := 1; k < niveles; k++ {
+		largo := n - (1 << k) + 1
+		tabla[k] = make([]T, largo)
+		mitad := 1 << (k - 1)
+		for i := 0; i < largo; i++ {
+			tabla[k][i] = minimo(tabla[k-1][i], tabla[k-1][i+mitad])
+		}
+	}
+
+	return &SparseTable[T]{tabla: tabla, logs: logs}
+}
+
+// Size retorna la cantidad de elementos del arreglo original.
+//
+// Uso:
+//
+//	size := st.Size()
+//
+// Retorna:
+//   - la cantidad de elementos del arreglo original.
+func (st *SparseTable[T]) Size() int {
+	return len(st.logs) - 1
+}
+
+// Query retorna el mínimo del rango cerrado [i, j]. O(1)
+//
+// Uso:
+//
+//	min, err := st.Query(1, 4)
+//
+// Parámetros:
+//   - `i` índice inicial del rango (inclusive).
+//   - `j` índice final del rango (inclusive).
+//
+// Retorna:
+//   - el mínimo del rango.
+//   - un error si el rango es inválido.
+func (st *SparseTable[T]) Query(i, j int) (T, error) {
+	var min T
+	if i < 0 || j >= st.Size() || i > j {
+		return min, errors.New("rango inválido")
+	}
+	k := st.logs[j-i+1]
+
+	return minimo(st.tabla[k][i], st.tabla[k][j-(1<<k)+1]), nil
+}
+
+func minimo[T cmp.Ordered](a, b T) T {
+	if b < a {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,62 @@
+package sparsetable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseTableVacia(t *testing.T) {
+	st := NewSparseTable([]int{})
+	assert.Equal(t, 0, st.Size())
+
+	_, err := st.Query(0, 0)
+	assert.EqualError(t, err, "rango inválido")
+}
+
+func TestSparseTableUnElemento(t *testing.T) {
+	st := NewSparseTable([]int{42})
+
+	min, err := st.Query(0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, min)
+}
+
+func TestSparseTableQueryContraFuerzaBruta(t *testing.T) {
+	arr := []int{5, 2, 4, 7, 1, 3, 9, 8, 6, 0, 11}
+	st := NewSparseTable(arr)
+
+	for i := 0; i < len(arr); i++ {
+		for j := i; j < len(arr); j++ {
+			esperado := arr[i]
+			for _, v := range arr[i : j+1] {
+				if v < esperado {
+					esperado = v
+				}
+			}
+			min, err := st.Query(i, j)
+			assert.NoError(t, err)
+			assert.Equal(t, esperado, min, "rango [%d, %d]", i, j)
+		}
+	}
+}
+
+func TestSparseTableRangoInvalido(t *testing.T) {
+	st := NewSparseTable([]int{3, 1, 2})
+
+	_, err := st.Query(-1, 1)
+	assert.Error(t, err)
+	_, err = st.Query(2, 1)
+	assert.Error(t, err)
+	_, err = st.Query(0, 3)
+	assert.Error(t, err)
+}
+
+func TestSparseTableNoDependeDelArregloOriginal(t *testing.T) {
+	arr := []string{"d", "b", "c"}
+	st := NewSparseTable(arr)
+	arr[1] = "z"
+
+	min, _ := st.Query(0, 2)
+	assert.Equal(t, "b", min)
+}